@@ -3,6 +3,7 @@ package baseapp
 import (
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/gogo/protobuf/grpc"
@@ -14,8 +15,10 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/simulate"
 )
 
 const (
@@ -43,15 +46,16 @@ type (
 // BaseApp reflects the ABCI application implementation.
 type BaseApp struct { // nolint: maligned
 	// initialized on creation
-	logger          log.Logger
-	name            string               // application name from abci.Info
-	db              dbm.DB               // common DB backend
-	cms             sdk.CommitMultiStore // Main (uncached) state
-	storeLoader     StoreLoader          // function to handle store loading, may be overridden with SetStoreLoader()
-	router          sdk.Router           // handle any kind of message
-	queryRouter     sdk.QueryRouter      // router for redirecting query calls
-	grpcQueryRouter *GRPCQueryRouter     // router for redirecting gRPC query calls
-	txDecoder       sdk.TxDecoder        // unmarshal []byte into sdk.Tx
+	logger           log.Logger
+	name             string               // application name from abci.Info
+	db               dbm.DB               // common DB backend
+	cms              sdk.CommitMultiStore // Main (uncached) state
+	storeLoader      StoreLoader          // function to handle store loading, may be overridden with SetStoreLoader()
+	router           sdk.Router           // handle any kind of message
+	queryRouter      sdk.QueryRouter      // router for redirecting query calls
+	grpcQueryRouter  *GRPCQueryRouter     // router for redirecting gRPC query calls
+	msgServiceRouter *MsgServiceRouter    // router for redirecting Msgs to their Msg service
+	txDecoder        sdk.TxDecoder        // unmarshal []byte into sdk.Tx
 
 	anteHandler    sdk.AnteHandler  // ante handler for fee and auth
 	initChainer    sdk.InitChainer  // initialize state with validators and state blob
@@ -71,6 +75,10 @@ type BaseApp struct { // nolint: maligned
 	// an inter-block write-through cache provided to the context during deliverState
 	interBlockCache sdk.MultiStorePersistentCache
 
+	// abciListeners for hooking into the ABCI message processing of the BaseApp
+	// and exposing the requests and responses to external consumers
+	abciListeners []ABCIListener
+
 	// absent validators from begin block
 	voteInfos []abci.VoteInfo
 
@@ -82,6 +90,10 @@ type BaseApp struct { // nolint: maligned
 	// transaction. This is mainly used for DoS and spam prevention.
 	minGasPrices sdk.DecCoins
 
+	// The number of goroutines used to verify signatures in CheckTx. Values
+	// lower than two verify signatures sequentially.
+	sigVerifyWorkers int
+
 	// flag for sealing options and parameters to a BaseApp
 	sealed bool
 
@@ -107,16 +119,17 @@ func NewBaseApp(
 	name string, logger log.Logger, db dbm.DB, txDecoder sdk.TxDecoder, options ...func(*BaseApp),
 ) *BaseApp {
 	app := &BaseApp{
-		logger:          logger,
-		name:            name,
-		db:              db,
-		cms:             store.NewCommitMultiStore(db),
-		storeLoader:     DefaultStoreLoader,
-		router:          NewRouter(),
-		queryRouter:     NewQueryRouter(),
-		grpcQueryRouter: NewGRPCQueryRouter(),
-		txDecoder:       txDecoder,
-		fauxMerkleMode:  false,
+		logger:           logger,
+		name:             name,
+		db:               db,
+		cms:              store.NewCommitMultiStore(db),
+		storeLoader:      DefaultStoreLoader,
+		router:           NewRouter(),
+		queryRouter:      NewQueryRouter(),
+		grpcQueryRouter:  NewGRPCQueryRouter(),
+		msgServiceRouter: NewMsgServiceRouter(),
+		txDecoder:        txDecoder,
+		fauxMerkleMode:   false,
 	}
 
 	for _, option := range options {
@@ -129,6 +142,8 @@ func NewBaseApp(
 
 	app.runTxRecoveryMiddleware = newDefaultRecoveryMiddleware()
 
+	simulate.RegisterSimulateServiceServer(app.grpcQueryRouter, simulateServer{app: app})
+
 	return app
 }
 
@@ -164,6 +179,9 @@ func (app *BaseApp) MountStores(keys ...sdk.StoreKey) {
 		case *sdk.TransientStoreKey:
 			app.MountStore(key, sdk.StoreTypeTransient)
 
+		case *sdk.MemoryStoreKey:
+			app.MountStore(key, sdk.StoreTypeMemory)
+
 		default:
 			panic("Unrecognized store key type " + reflect.TypeOf(key).Name())
 		}
@@ -249,6 +267,11 @@ func (app *BaseApp) LastBlockHeight() int64 {
 	return app.cms.LastCommitID().Version
 }
 
+// CommitMultiStore returns the root multi-store of the application.
+func (app *BaseApp) CommitMultiStore() sdk.CommitMultiStore {
+	return app.cms
+}
+
 func (app *BaseApp) init() error {
 	if app.sealed {
 		panic("cannot call initFromMainStore: baseapp already sealed")
@@ -265,6 +288,10 @@ func (app *BaseApp) setMinGasPrices(gasPrices sdk.DecCoins) {
 	app.minGasPrices = gasPrices
 }
 
+func (app *BaseApp) setSigVerifyWorkers(workers int) {
+	app.sigVerifyWorkers = workers
+}
+
 func (app *BaseApp) setHaltHeight(haltHeight uint64) {
 	app.haltHeight = haltHeight
 }
@@ -294,6 +321,9 @@ func (app *BaseApp) QueryRouter() sdk.QueryRouter { return app.queryRouter }
 // GRPCQueryRouter returns the GRPCQueryRouter of a BaseApp.
 func (app *BaseApp) GRPCQueryRouter() grpc.Server { return app.grpcQueryRouter }
 
+// MsgServiceRouter returns the MsgServiceRouter of a BaseApp.
+func (app *BaseApp) MsgServiceRouter() grpc.Server { return app.msgServiceRouter }
+
 // Seal seals a BaseApp. It prohibits any further modifications to a BaseApp.
 func (app *BaseApp) Seal() { app.sealed = true }
 
@@ -302,13 +332,15 @@ func (app *BaseApp) IsSealed() bool { return app.sealed }
 
 // setCheckState sets the BaseApp's checkState with a cache-wrapped multi-store
 // (i.e. a CacheMultiStore) and a new Context with the cache-wrapped multi-store,
-// provided header, and minimum gas prices set. It is set on InitChain and reset
-// on Commit.
+// provided header, minimum gas prices and signature verification workers set.
+// It is set on InitChain and reset on Commit.
 func (app *BaseApp) setCheckState(header abci.Header) {
 	ms := app.cms.CacheMultiStore()
 	app.checkState = &state{
-		ms:  ms,
-		ctx: sdk.NewContext(ms, header, true, app.logger).WithMinGasPrices(app.minGasPrices),
+		ms: ms,
+		ctx: sdk.NewContext(ms, header, true, app.logger).
+			WithMinGasPrices(app.minGasPrices).
+			WithSigVerifyWorkers(app.sigVerifyWorkers),
 	}
 }
 
@@ -596,6 +628,18 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 		}
 	}
 
+	// Surface the priority assigned by the AnteHandler so that a priority
+	// mempool can order transactions by it rather than by arrival time. A zero
+	// priority is omitted as it is equivalent to FIFO ordering.
+	if err == nil && (mode == runTxModeCheck || mode == runTxModeReCheck) && ctx.Priority() != 0 {
+		result.Events = append(result.Events, sdk.Events{
+			sdk.NewEvent(
+				sdk.EventTypeTx,
+				sdk.NewAttribute(sdk.AttributeKeyPriority, strconv.FormatInt(ctx.Priority(), 10)),
+			),
+		}.ToABCIEvents()...)
+	}
+
 	return gInfo, result, err
 }
 
@@ -618,11 +662,18 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			break
 		}
 
-		msgRoute := msg.Route()
-		handler := app.router.Route(ctx, msgRoute)
+		// Msgs accepted by a Msg service take precedence over the legacy
+		// route based handlers
+		var handler sdk.Handler
+		if msgHandler := app.msgServiceRouter.Handler(msg); msgHandler != nil {
+			handler = msgHandler
+		} else {
+			msgRoute := msg.Route()
+			handler = app.router.Route(ctx, msgRoute)
 
-		if handler == nil {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
+			if handler == nil {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
+			}
 		}
 
 		msgResult, err := handler(ctx, msg)
@@ -630,6 +681,15 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
 
+		telemetry.IncrCounterWithLabels(
+			[]string{"tx", "msg", "count"},
+			1,
+			[]telemetry.Label{
+				telemetry.NewLabel(telemetry.MetricLabelNameModule, msg.Route()),
+				telemetry.NewLabel("type", msg.Type()),
+			},
+		)
+
 		msgEvents := sdk.Events{
 			sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type())),
 		}
@@ -24,6 +24,7 @@ import (
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/simulate"
 )
 
 var (
@@ -166,6 +167,51 @@ func TestLoadVersion(t *testing.T) {
 	testLoadVersionHelper(t, app, int64(2), commitID2)
 }
 
+func TestRollbackToVersion(t *testing.T) {
+	logger := defaultLogger()
+	pruningOpt := SetPruning(store.PruneNothing)
+	db := dbm.NewMemDB()
+	name := t.Name()
+	capKey := sdk.NewKVStoreKey("main")
+	key := []byte("key")
+
+	newApp := func() *BaseApp {
+		app := NewBaseApp(name, logger, db, nil, pruningOpt)
+		app.MountStores(capKey)
+		err := app.LoadLatestVersion()
+		require.Nil(t, err)
+		return app
+	}
+
+	// execute a block writing its height to the store, collect commit ID
+	executeBlock := func(app *BaseApp, height int64) sdk.CommitID {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		app.deliverState.ctx.KVStore(capKey).Set(key, []byte{byte(height)})
+		res := app.Commit()
+		return sdk.CommitID{Version: height, Hash: res.Data}
+	}
+
+	app := newApp()
+	executeBlock(app, 1)
+	commitID2 := executeBlock(app, 2)
+	commitID3 := executeBlock(app, 3)
+
+	// roll back the last height and reload the app at the previous one
+	err := app.CommitMultiStore().RollbackToVersion(2)
+	require.Nil(t, err)
+
+	app = newApp()
+	testLoadVersionHelper(t, app, int64(2), commitID2)
+	require.Equal(t, []byte{2}, app.cms.GetKVStore(capKey).Get(key))
+
+	// re-executing the rolled back block yields the same result
+	require.Equal(t, commitID3, executeBlock(app, 3))
+
+	app = newApp()
+	testLoadVersionHelper(t, app, int64(3), commitID3)
+	require.Equal(t, []byte{3}, app.cms.GetKVStore(capKey).Get(key))
+}
+
 func useDefaultLoader(app *BaseApp) {
 	app.SetStoreLoader(DefaultStoreLoader)
 }
@@ -189,7 +235,7 @@ func initStore(t *testing.T, db dbm.DB, storeKey string, k, v []byte) {
 
 func checkStore(t *testing.T, db dbm.DB, ver int64, storeKey string, k, v []byte) {
 	rs := rootmulti.NewStore(db)
-	rs.SetPruning(store.PruneSyncable)
+	rs.SetPruning(store.PruneDefault)
 	key := sdk.NewKVStoreKey(storeKey)
 	rs.MountStoreWithDB(key, store.StoreTypeIAVL, nil)
 	err := rs.LoadLatestVersion()
@@ -255,7 +301,7 @@ func TestSetLoader(t *testing.T) {
 
 func TestAppVersionSetterGetter(t *testing.T) {
 	logger := defaultLogger()
-	pruningOpt := SetPruning(store.PruneSyncable)
+	pruningOpt := SetPruning(store.PruneDefault)
 	db := dbm.NewMemDB()
 	name := t.Name()
 	app := NewBaseApp(name, logger, db, nil, pruningOpt)
@@ -307,10 +353,7 @@ func TestLoadVersionInvalid(t *testing.T) {
 
 func TestLoadVersionPruning(t *testing.T) {
 	logger := log.NewNopLogger()
-	pruningOptions := store.PruningOptions{
-		KeepEvery:     2,
-		SnapshotEvery: 6,
-	}
+	pruningOptions := store.NewPruningOptions(2, 3, 1)
 	pruningOpt := SetPruning(pruningOptions)
 	db := dbm.NewMemDB()
 	name := t.Name()
@@ -331,58 +374,34 @@ func TestLoadVersionPruning(t *testing.T) {
 	require.Equal(t, int64(0), lastHeight)
 	require.Equal(t, emptyCommitID, lastID)
 
-	// execute a block
-	header := abci.Header{Height: 1}
-	app.BeginBlock(abci.RequestBeginBlock{Header: header})
-	res := app.Commit()
+	// Commit seven blocks, of which 7 (latest) is kept in addition to 6, 5
+	// (keep recent) and 3 (keep every).
+	var lastCommitID sdk.CommitID
+	for i := int64(1); i <= 7; i++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: i}})
+		res := app.Commit()
+		lastCommitID = sdk.CommitID{Version: i, Hash: res.Data}
+	}
 
-	// execute a block, collect commit ID
-	header = abci.Header{Height: 2}
-	app.BeginBlock(abci.RequestBeginBlock{Header: header})
-	res = app.Commit()
-	commitID2 := sdk.CommitID{Version: 2, Hash: res.Data}
+	for _, v := range []int64{1, 2, 4} {
+		_, err = app.cms.CacheMultiStoreWithVersion(v)
+		require.Error(t, err, "expected height %d to be pruned", v)
+	}
 
-	// execute a block
-	header = abci.Header{Height: 3}
-	app.BeginBlock(abci.RequestBeginBlock{Header: header})
-	res = app.Commit()
-	commitID3 := sdk.CommitID{Version: 3, Hash: res.Data}
+	for _, v := range []int64{3, 5, 6, 7} {
+		_, err = app.cms.CacheMultiStoreWithVersion(v)
+		require.NoError(t, err, "expected height %d to be kept", v)
+	}
 
-	// reload with LoadLatestVersion, check it loads last flushed version
+	// reload with LoadLatestVersion, check it loads the latest version
 	app = NewBaseApp(name, logger, db, nil, pruningOpt)
 	app.MountStores(capKey)
 
 	err = app.LoadLatestVersion()
 	require.Nil(t, err)
-	testLoadVersionHelper(t, app, int64(2), commitID2)
-
-	// re-execute block 3 and check it is same CommitID
-	header = abci.Header{Height: 3}
-	app.BeginBlock(abci.RequestBeginBlock{Header: header})
-	res = app.Commit()
-	recommitID3 := sdk.CommitID{Version: 3, Hash: res.Data}
-	require.Equal(t, commitID3, recommitID3, "Commits of identical blocks not equal after reload")
-
-	// execute a block, collect commit ID
-	header = abci.Header{Height: 4}
-	app.BeginBlock(abci.RequestBeginBlock{Header: header})
-	res = app.Commit()
-	commitID4 := sdk.CommitID{Version: 4, Hash: res.Data}
-
-	// execute a block
-	header = abci.Header{Height: 5}
-	app.BeginBlock(abci.RequestBeginBlock{Header: header})
-	res = app.Commit()
-
-	// reload with LoadLatestVersion, check it loads last flushed version
-	app = NewBaseApp(name, logger, db, nil, pruningOpt)
-	app.MountStores(capKey)
-	err = app.LoadLatestVersion()
-	require.Nil(t, err)
-	testLoadVersionHelper(t, app, int64(4), commitID4)
+	testLoadVersionHelper(t, app, int64(7), lastCommitID)
 
-	// reload with LoadVersion of previous flushed version
-	// and check it fails since previous flush should be pruned
+	// loading a pruned version fails
 	app = NewBaseApp(name, logger, db, nil, pruningOpt)
 	app.MountStores(capKey)
 	err = app.LoadVersion(2)
@@ -821,6 +840,45 @@ func TestCheckTx(t *testing.T) {
 	require.Nil(t, storedBytes)
 }
 
+// Test that the priority assigned by the AnteHandler is returned in the
+// CheckTx response events.
+func TestCheckTxPriority(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx.WithPriority(tx.(txTest).Counter), nil
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return &sdk.Result{}, nil
+		}))
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	txBytes, err := codec.MarshalBinaryBare(newTxCounter(7, 0))
+	require.NoError(t, err)
+
+	res := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Len(t, res.Events, 1)
+	require.Equal(t, sdk.EventTypeTx, res.Events[0].Type)
+	require.Equal(t, sdk.AttributeKeyPriority, string(res.Events[0].Attributes[0].Key))
+	require.Equal(t, "7", string(res.Events[0].Attributes[0].Value))
+
+	// a zero priority is not reported
+	txBytes, err = codec.MarshalBinaryBare(newTxCounter(0, 0))
+	require.NoError(t, err)
+
+	res = app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Empty(t, res.Events)
+}
+
 // Test that successive DeliverTx can see each others' effects
 // on the store, both within and across blocks.
 func TestDeliverTx(t *testing.T) {
@@ -1016,6 +1074,27 @@ func TestSimulateTx(t *testing.T) {
 		require.Equal(t, result.Events, simRes.Result.Events)
 		require.True(t, bytes.Equal(result.Data, simRes.Result.Data))
 
+		// simulate through the gRPC SimulateService, which like all gRPC
+		// queries requires committed state to query against
+		if app.LastBlockHeight() > 0 {
+			reqBz, err := (&simulate.SimulateRequest{TxBytes: txBytes}).Marshal()
+			require.NoError(t, err)
+
+			grpcQueryResult := app.Query(abci.RequestQuery{
+				Path: "/cosmos_sdk.simulate.v1.SimulateService/Simulate",
+				Data: reqBz,
+			})
+			require.True(t, grpcQueryResult.IsOK(), grpcQueryResult.Log)
+
+			var grpcSimRes simulate.SimulateResponse
+			require.NoError(t, grpcSimRes.Unmarshal(grpcQueryResult.Value))
+
+			require.Equal(t, gInfo, *grpcSimRes.GasInfo)
+			require.Equal(t, result.Log, grpcSimRes.Result.Log)
+			require.Equal(t, result.Events, grpcSimRes.Result.Events)
+			require.True(t, bytes.Equal(result.Data, grpcSimRes.Result.Data))
+		}
+
 		app.EndBlock(abci.RequestEndBlock{})
 		app.Commit()
 	}
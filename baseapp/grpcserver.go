@@ -0,0 +1,81 @@
+package baseapp
+
+import (
+	"context"
+	"strconv"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GRPCBlockHeightHeader is the gRPC metadata key used by clients to query
+// state at a given height and by the server to report the height a query was
+// executed at.
+const GRPCBlockHeightHeader = "x-cosmos-block-height"
+
+// RegisterGRPCServer registers all the gRPC services registered on the
+// GRPCQueryRouter with the given gRPC server. Each call is executed against a
+// cached, read-only context of the latest committed state, or of the height
+// provided through the GRPCBlockHeightHeader metadata.
+func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
+	// define an interceptor which replaces the gRPC context with an
+	// sdk.Context wrapping the state at the requested height
+	interceptor := func(grpcCtx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		var height int64
+
+		if md, ok := metadata.FromIncomingContext(grpcCtx); ok {
+			if heightHeaders := md.Get(GRPCBlockHeightHeader); len(heightHeaders) > 0 {
+				var err error
+
+				height, err = strconv.ParseInt(heightHeaders[0], 10, 64)
+				if err != nil || height < 0 {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid %s header: %s", GRPCBlockHeightHeader, heightHeaders[0])
+				}
+			}
+		}
+
+		sdkCtx, err := app.createQueryContext(abci.RequestQuery{Height: height})
+		if err != nil {
+			return nil, err
+		}
+
+		// report the height the query was executed at
+		if height == 0 {
+			height = app.LastBlockHeight()
+		}
+		if err := grpc.SetHeader(grpcCtx, metadata.Pairs(GRPCBlockHeightHeader, strconv.FormatInt(height, 10))); err != nil {
+			return nil, err
+		}
+
+		return handler(sdk.WrapSDKContext(sdkCtx), req)
+	}
+
+	for _, data := range app.grpcQueryRouter.serviceData {
+		desc := data.serviceDesc
+		methods := make([]grpc.MethodDesc, len(desc.Methods))
+
+		for i, method := range desc.Methods {
+			methodHandler := method.Handler
+			methods[i] = grpc.MethodDesc{
+				MethodName: method.MethodName,
+				Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+					return methodHandler(srv, ctx, dec, interceptor)
+				},
+			}
+		}
+
+		server.RegisterService(&grpc.ServiceDesc{
+			ServiceName: desc.ServiceName,
+			HandlerType: desc.HandlerType,
+			Methods:     methods,
+			Streams:     desc.Streams,
+			Metadata:    desc.Metadata,
+		}, data.handler)
+	}
+}
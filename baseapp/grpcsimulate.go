@@ -0,0 +1,42 @@
+package baseapp
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/simulate"
+)
+
+// simulateServer implements the gRPC SimulateService on top of the BaseApp
+type simulateServer struct {
+	app *BaseApp
+}
+
+var _ simulate.SimulateServiceServer = simulateServer{}
+
+// Simulate implements the SimulateService.Simulate RPC method. It runs the
+// transaction in simulation mode against the latest committed state; no state
+// changes are persisted.
+func (s simulateServer) Simulate(_ context.Context, req *simulate.SimulateRequest) (*simulate.SimulateResponse, error) {
+	if req == nil || len(req.TxBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty tx bytes")
+	}
+
+	tx, err := s.app.txDecoder(req.TxBytes)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to decode tx")
+	}
+
+	gasInfo, res, err := s.app.Simulate(req.TxBytes, tx)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to simulate tx")
+	}
+
+	return &simulate.SimulateResponse{
+		GasInfo: &gasInfo,
+		Result:  res,
+	}, nil
+}
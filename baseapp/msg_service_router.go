@@ -0,0 +1,89 @@
+package baseapp
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgServiceRouter routes Msgs to the protobuf Msg service method that
+// accepts them as request type
+type MsgServiceRouter struct {
+	routes map[string]MsgServiceHandler
+}
+
+var _ gogogrpc.Server = &MsgServiceRouter{}
+
+// NewMsgServiceRouter creates a new MsgServiceRouter
+func NewMsgServiceRouter() *MsgServiceRouter {
+	return &MsgServiceRouter{
+		routes: map[string]MsgServiceHandler{},
+	}
+}
+
+// MsgServiceHandler defines a function type which handles a Msg using the
+// Msg service it is registered for
+type MsgServiceHandler = func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error)
+
+// Handler returns the MsgServiceHandler for the given Msg or nil if no Msg
+// service accepts it
+func (msr *MsgServiceRouter) Handler(msg sdk.Msg) MsgServiceHandler {
+	return msr.routes[proto.MessageName(msg)]
+}
+
+// RegisterService implements the gRPC Server.RegisterService method. sd is a
+// gRPC service description, handler is an object which implements that gRPC
+// service. Every method of the service is routed by the proto name of its
+// request type, which must implement sdk.Msg.
+func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler interface{}) {
+	for _, method := range sd.Methods {
+		fqMethod := fmt.Sprintf("/%s/%s", sd.ServiceName, method.MethodName)
+		methodHandler := method.Handler
+
+		// call the method handler with a decoder that only records the type
+		// of the request it is asked to fill
+		var requestTypeName string
+		_, _ = methodHandler(nil, context.Background(), func(i interface{}) error {
+			msg, ok := i.(sdk.Msg)
+			if !ok {
+				panic(fmt.Errorf("request type of %s does not implement sdk.Msg", fqMethod))
+			}
+
+			requestTypeName = proto.MessageName(msg)
+			return errRequestTypeRecorded
+		}, nil)
+
+		if _, found := msr.routes[requestTypeName]; found {
+			panic(fmt.Errorf("msg service %s has already been registered for %s", fqMethod, requestTypeName))
+		}
+
+		msr.routes[requestTypeName] = func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			// call the method handler from the service description with the
+			// handler object and a decoder that copies the Msg into the request
+			res, err := methodHandler(handler, sdk.WrapSDKContext(ctx), func(i interface{}) error {
+				reflect.ValueOf(i).Elem().Set(reflect.ValueOf(msg).Elem())
+				return nil
+			}, nil)
+			if err != nil {
+				return nil, err
+			}
+
+			resMsg, ok := res.(proto.Message)
+			if !ok {
+				return nil, fmt.Errorf("response of %s is not a proto message: %T", fqMethod, res)
+			}
+
+			return sdk.WrapServiceResult(ctx, resMsg, nil)
+		}
+	}
+}
+
+var errRequestTypeRecorded = fmt.Errorf("request type recorded")
@@ -28,6 +28,12 @@ func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setMinGasPrices(gasPrices) }
 }
 
+// SetSigVerifyWorkers returns an option that sets the number of goroutines used
+// to verify tx signatures in CheckTx.
+func SetSigVerifyWorkers(workers int) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setSigVerifyWorkers(workers) }
+}
+
 // SetHaltHeight returns a BaseApp option function that sets the halt block height.
 func SetHaltHeight(blockHeight uint64) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setHaltHeight(blockHeight) }
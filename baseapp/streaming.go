@@ -0,0 +1,51 @@
+package baseapp
+
+import (
+	"io"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ABCIListener interface used to hook into the ABCI message processing of the BaseApp
+type ABCIListener interface {
+	// ListenBeginBlock updates the streaming service with the latest BeginBlock messages
+	ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error
+	// ListenDeliverTx updates the streaming service with the latest DeliverTx messages
+	ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+	// ListenEndBlock updates the streaming service with the latest EndBlock messages
+	ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
+	// ListenCommit updates the streaming service with the latest Commit response.
+	// The state changes of the block are flushed to the WriteListeners right
+	// before it is called.
+	ListenCommit(ctx sdk.Context, res abci.ResponseCommit) error
+}
+
+// StreamingService interface for registering WriteListeners with the BaseApp
+// and updating the service with the ABCI messages using the hooks
+type StreamingService interface {
+	// Listeners returns the streaming service's listeners for the BaseApp to register
+	Listeners() map[sdk.StoreKey][]sdk.WriteListener
+
+	ABCIListener
+	io.Closer
+}
+
+// SetStreamingService is used to set a streaming service into the BaseApp
+// hooks and load the listeners into the multistore
+func (app *BaseApp) SetStreamingService(s StreamingService) {
+	if app.sealed {
+		panic("SetStreamingService() on sealed BaseApp")
+	}
+
+	// add the listeners for each StoreKey
+	for key, lis := range s.Listeners() {
+		app.cms.AddListeners(key, lis)
+	}
+
+	// register the StreamingService within the BaseApp
+	// BaseApp will pass BeginBlock, DeliverTx, EndBlock and Commit requests and
+	// responses to the streaming service
+	app.abciListeners = append(app.abciListeners, s)
+}
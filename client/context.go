@@ -46,6 +46,7 @@ type Context struct {
 	Offline          bool
 	Indent           bool
 	SkipConfirm      bool
+	SignModeStr      string
 	TxGenerator      TxGenerator
 	AccountRetriever AccountRetriever
 
@@ -124,6 +125,7 @@ func (ctx Context) InitWithInputAndFrom(input io.Reader, from string) Context {
 	ctx.Offline = offline
 	ctx.Indent = viper.GetBool(flags.FlagIndentResponse)
 	ctx.SkipConfirm = viper.GetBool(flags.FlagSkipConfirmation)
+	ctx.SignModeStr = viper.GetString(flags.FlagSignMode)
 
 	homedir := viper.GetString(flags.FlagHome)
 	genOnly := viper.GetBool(flags.FlagGenerateOnly)
@@ -273,6 +275,13 @@ func (ctx Context) WithGenerateOnly(generateOnly bool) Context {
 	return ctx
 }
 
+// WithSignModeStr returns a copy of the context with an updated SignMode
+// value.
+func (ctx Context) WithSignModeStr(signModeStr string) Context {
+	ctx.SignModeStr = signModeStr
+	return ctx
+}
+
 // WithSimulation returns a copy of the context with updated Simulate value
 func (ctx Context) WithSimulation(simulate bool) Context {
 	ctx.Simulate = simulate
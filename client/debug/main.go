@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 func Cmd(cdc *codec.Codec) *cobra.Command {
@@ -28,6 +29,7 @@ func Cmd(cdc *codec.Codec) *cobra.Command {
 	cmd.AddCommand(PubkeyCmd(cdc))
 	cmd.AddCommand(AddrCmd())
 	cmd.AddCommand(RawBytesCmd())
+	cmd.AddCommand(TxCmd(cdc))
 
 	return cmd
 }
@@ -192,3 +194,50 @@ $ %s debug raw-bytes [72 101 108 108 111 44 32 112 108 97 121 103 114 111 117 11
 		},
 	}
 }
+
+func TxCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tx [tx-bytes]",
+		Short: "Decode an amino-encoded transaction from hex or base64",
+		Long: fmt.Sprintf(`Decode an amino-encoded transaction from hex or base64 and print it as JSON.
+
+Example:
+$ %s debug tx 7A7A3C8D...
+			`, version.ClientName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBytes, err := getBytesFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			var stdTx authtypes.StdTx
+			if err := cdc.UnmarshalBinaryBare(txBytes, &stdTx); err != nil {
+				return fmt.Errorf("failed to decode transaction: %w", err)
+			}
+
+			bz, err := cdc.MarshalJSONIndent(stdTx, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+}
+
+// getBytesFromString decodes the given string from hex, and then base64 if
+// it is not valid hex.
+func getBytesFromString(str string) ([]byte, error) {
+	if bz, err := hex.DecodeString(str); err == nil {
+		return bz, nil
+	}
+
+	bz, err := base64.StdEncoding.DecodeString(str)
+	if err != nil {
+		return nil, fmt.Errorf("'%s' invalid; expected hex or base64", str)
+	}
+
+	return bz, nil
+}
@@ -70,6 +70,15 @@ const (
 	FlagPage               = "page"
 	FlagLimit              = "limit"
 	FlagUnsafeCORS         = "unsafe-cors"
+	FlagSignMode           = "sign-mode"
+	FlagTimeoutHeight      = "timeout-height"
+)
+
+// List of sign modes known to the --sign-mode flag. The CLI builds StdTx's,
+// whose signatures can only be made in amino JSON mode, so it can't produce
+// SIGN_MODE_TEXTUAL or SIGN_MODE_DIRECT signatures.
+const (
+	SignModeLegacyAminoJSON = "amino-json"
 )
 
 // LineBreak can be included in a command list to provide a blank line
@@ -122,6 +131,8 @@ func PostCommands(cmds ...*cobra.Command) []*cobra.Command {
 		c.Flags().Bool(FlagOffline, false, "Offline mode (does not allow any online functionality")
 		c.Flags().BoolP(FlagSkipConfirmation, "y", false, "Skip tx broadcasting prompt confirmation")
 		c.Flags().String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+		c.Flags().String(FlagSignMode, "", "Choose sign mode (amino-json), this is an advanced feature")
+		c.Flags().Uint64(FlagTimeoutHeight, 0, "Set a block timeout height to prevent the tx from being committed past a certain height")
 
 		// --gas can accept integers and "simulate"
 		c.Flags().Var(&GasFlagVar, "gas", fmt.Sprintf(
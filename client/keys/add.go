@@ -51,7 +51,9 @@ and a bip32 HD path to derive a specific account. The key will be stored under t
 and encrypted with the given password. The only input that is required is the encryption password.
 
 If run with -i, it will prompt the user for BIP44 path, BIP39 mnemonic, and passphrase.
-The flag --recover allows one to recover a key from a seed passphrase.
+The flag --recover allows one to recover a key from a seed passphrase. Any
+account of the seed can be recovered with --account and --index, or with a full
+--hd-path. The size of a generated mnemonic can be set with --entropy-size.
 If run with --dry-run, a key would be generated (or recovered) but not stored to the
 local keystore.
 Use the --pubkey flag to add arbitrary public keys to the keystore for constructing
@@ -78,6 +80,7 @@ the flag --nosort is set.
 	cmd.Flags().Uint32(flagCoinType, sdk.GetConfig().GetCoinType(), "coin type number for HD derivation")
 	cmd.Flags().Uint32(flagAccount, 0, "Account number for HD derivation")
 	cmd.Flags().Uint32(flagIndex, 0, "Address index number for HD derivation")
+	cmd.Flags().Int(flagEntropySize, mnemonicEntropySize, "Bits of entropy of a generated mnemonic; 128 bits gives 12 words and 256 bits gives 24 words")
 	cmd.Flags().Bool(flags.FlagIndentResponse, false, "Add indent to JSON response")
 	cmd.Flags().String(flagKeyAlgo, string(hd.Secp256k1Type), "Key signing algorithm to generate keys for")
 
@@ -237,8 +240,13 @@ func RunAddCmd(cmd *cobra.Command, args []string, kb keyring.Keyring, inBuf *buf
 	}
 
 	if len(mnemonic) == 0 {
+		entropySize := viper.GetInt(flagEntropySize)
+		if err := validateEntropySize(entropySize); err != nil {
+			return err
+		}
+
 		// read entropy seed straight from crypto.Rand and convert to mnemonic
-		entropySeed, err := bip39.NewEntropy(mnemonicEntropySize)
+		entropySeed, err := bip39.NewEntropy(entropySize)
 		if err != nil {
 			return err
 		}
@@ -31,6 +31,7 @@ func Test_runAddCmdBasic(t *testing.T) {
 	mockIn.Reset("y\n")
 	// set algo flag value to the default
 	viper.Set(flagKeyAlgo, string(hd.Secp256k1Type))
+	viper.Set(flagEntropySize, mnemonicEntropySize)
 
 	kb, err := keyring.New(sdk.KeyringServiceName(), viper.GetString(flags.FlagKeyringBackend), kbHome, mockIn)
 	require.NoError(t, err)
@@ -54,4 +55,10 @@ func Test_runAddCmdBasic(t *testing.T) {
 
 	viper.Set(flags.FlagDryRun, true)
 	require.NoError(t, runAddCmd(cmd, []string{"keyname4"}))
+
+	// test --entropy-size
+	viper.Set(flagEntropySize, 100)
+	require.Error(t, runAddCmd(cmd, []string{"keyname5"}))
+	viper.Set(flagEntropySize, 128)
+	require.NoError(t, runAddCmd(cmd, []string{"keyname5"}))
 }
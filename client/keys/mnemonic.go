@@ -13,6 +13,7 @@ import (
 
 const (
 	flagUserEntropy = "unsafe-entropy"
+	flagEntropySize = "entropy-size"
 
 	mnemonicEntropySize = 256
 )
@@ -26,6 +27,7 @@ func MnemonicKeyCommand() *cobra.Command {
 		RunE:  runMnemonicCmd,
 	}
 	cmd.Flags().Bool(flagUserEntropy, false, "Prompt the user to supply their own entropy, instead of relying on the system")
+	cmd.Flags().Int(flagEntropySize, mnemonicEntropySize, "Bits of entropy of the mnemonic; 128 bits gives 12 words and 256 bits gives 24 words")
 	return cmd
 }
 
@@ -34,6 +36,11 @@ func runMnemonicCmd(cmd *cobra.Command, args []string) error {
 
 	userEntropy, _ := flags.GetBool(flagUserEntropy)
 
+	entropySize, _ := flags.GetInt(flagEntropySize)
+	if err := validateEntropySize(entropySize); err != nil {
+		return err
+	}
+
 	var entropySeed []byte
 
 	if userEntropy {
@@ -56,11 +63,11 @@ func runMnemonicCmd(cmd *cobra.Command, args []string) error {
 
 		// hash input entropy to get entropy seed
 		hashedEntropy := sha256.Sum256([]byte(inputEntropy))
-		entropySeed = hashedEntropy[:]
+		entropySeed = hashedEntropy[:entropySize/8]
 	} else {
 		// read entropy seed straight from crypto.Rand
 		var err error
-		entropySeed, err = bip39.NewEntropy(mnemonicEntropySize)
+		entropySeed, err = bip39.NewEntropy(entropySize)
 		if err != nil {
 			return err
 		}
@@ -74,3 +81,13 @@ func runMnemonicCmd(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// validateEntropySize checks that bits is a BIP-39 entropy size, i.e. a
+// multiple of 32 between 128 and 256.
+func validateEntropySize(bits int) error {
+	if bits%32 != 0 || bits < 128 || bits > 256 {
+		return fmt.Errorf("invalid entropy size %d: must be a multiple of 32 between 128 and 256", bits)
+	}
+
+	return nil
+}
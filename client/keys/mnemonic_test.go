@@ -48,3 +48,15 @@ func Test_RunMnemonicCmdUser(t *testing.T) {
 	mockIn.Reset(fakeEntropy)
 	require.NoError(t, runMnemonicCmd(cmdUser, []string{}))
 }
+
+func Test_RunMnemonicCmdEntropySize(t *testing.T) {
+	cmd := MnemonicKeyCommand()
+	_, mockOut, _ := tests.ApplyMockIO(cmd)
+
+	require.NoError(t, cmd.Flags().Set(flagEntropySize, "128"))
+	require.NoError(t, runMnemonicCmd(cmd, []string{}))
+	require.Len(t, strings.Fields(mockOut.String()), 12)
+
+	require.NoError(t, cmd.Flags().Set(flagEntropySize, "100"))
+	require.EqualError(t, runMnemonicCmd(cmd, []string{}), "invalid entropy size 100: must be a multiple of 32 between 128 and 256")
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/pkg/errors"
 
 	abci "github.com/tendermint/tendermint/abci/types"
-	"github.com/tendermint/tendermint/crypto/merkle"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	tmliteErr "github.com/tendermint/tendermint/lite/errors"
 	tmliteProxy "github.com/tendermint/tendermint/lite/proxy"
@@ -157,28 +156,13 @@ func (ctx Context) verifyProof(queryPath string, resp abci.ResponseQuery) error
 		return err
 	}
 
-	// TODO: Instead of reconstructing, stash on Context field?
-	prt := rootmulti.DefaultProofRuntime()
-
 	// TODO: Better convention for path?
 	storeName, err := parseQueryStorePath(queryPath)
 	if err != nil {
 		return err
 	}
 
-	kp := merkle.KeyPath{}
-	kp = kp.AppendKey([]byte(storeName), merkle.KeyEncodingURL)
-	kp = kp.AppendKey(resp.Key, merkle.KeyEncodingURL)
-
-	if resp.Value == nil {
-		err = prt.VerifyAbsence(resp.Proof, commit.Header.AppHash, kp.String())
-		if err != nil {
-			return errors.Wrap(err, "failed to prove merkle proof")
-		}
-		return nil
-	}
-
-	if err := prt.VerifyValue(resp.Proof, commit.Header.AppHash, kp.String(), resp.Value); err != nil {
+	if err := rootmulti.VerifyProof(resp.Proof, commit.Header.AppHash, storeName, resp.Key, resp.Value); err != nil {
 		return errors.Wrap(err, "failed to prove merkle proof")
 	}
 
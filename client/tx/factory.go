@@ -24,6 +24,7 @@ type Factory struct {
 	simulateAndExecute bool
 	chainID            string
 	memo               string
+	timeoutHeight      uint64
 	fees               sdk.Coins
 	gasPrices          sdk.DecCoins
 }
@@ -48,6 +49,7 @@ func NewFactoryFromCLI(input io.Reader) Factory {
 		simulateAndExecute: flags.GasFlagVar.Simulate,
 		chainID:            viper.GetString(flags.FlagChainID),
 		memo:               viper.GetString(flags.FlagMemo),
+		timeoutHeight:      viper.GetUint64(flags.FlagTimeoutHeight),
 	}
 
 	f = f.WithFees(viper.GetString(flags.FlagFees))
@@ -63,6 +65,7 @@ func (f Factory) GasAdjustment() float64                    { return f.gasAdjust
 func (f Factory) Keybase() keyring.Keyring                  { return f.keybase }
 func (f Factory) ChainID() string                           { return f.chainID }
 func (f Factory) Memo() string                              { return f.memo }
+func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
 func (f Factory) Fees() sdk.Coins                           { return f.fees }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
@@ -135,6 +138,12 @@ func (f Factory) WithMemo(memo string) Factory {
 	return f
 }
 
+// WithTimeoutHeight returns a copy of the Factory with an updated timeout height.
+func (f Factory) WithTimeoutHeight(height uint64) Factory {
+	f.timeoutHeight = height
+	return f
+}
+
 // WithAccountNumber returns a copy of the Factory with an updated account number.
 func (f Factory) WithAccountNumber(accnum uint64) Factory {
 	f.accountNumber = accnum
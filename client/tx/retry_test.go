@@ -0,0 +1,115 @@
+package tx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// mockAccountRetriever returns seq, advancing it by step on every query to
+// mimic concurrent senders.
+type mockAccountRetriever struct {
+	seq, step uint64
+}
+
+func (mar *mockAccountRetriever) EnsureExists(client.NodeQuerier, sdk.AccAddress) error {
+	return nil
+}
+
+func (mar *mockAccountRetriever) GetAccountNumberSequence(client.NodeQuerier, sdk.AccAddress) (uint64, uint64, error) {
+	seq := mar.seq
+	mar.seq += mar.step
+	return 1, seq, nil
+}
+
+func TestSignAndBroadcastSequenceRetry(t *testing.T) {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	cryptocodec.RegisterCrypto(cdc)
+	types.RegisterCodec(cdc)
+	banktypes.RegisterCodec(cdc)
+
+	kb := keyring.NewInMemory()
+	info, _, err := kb.NewMnemonic("test", keyring.English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+
+	unauthorized := sdk.TxResponse{
+		Codespace: sdkerrors.RootCodespace,
+		Code:      sdkerrors.ErrUnauthorized.ABCICode(),
+	}
+
+	testCases := []struct {
+		name          string
+		mode          string
+		chainSeq      uint64
+		seqStep       uint64
+		responses     []sdk.TxResponse
+		expBroadcasts int
+		expCode       uint32
+	}{
+		{"success", flags.BroadcastSync, 5, 0, []sdk.TxResponse{{}}, 1, 0},
+		{"stale sequence", flags.BroadcastSync, 7, 0, []sdk.TxResponse{unauthorized, {}}, 2, 0},
+		{"stale sequence block mode", flags.BroadcastBlock, 7, 0, []sdk.TxResponse{unauthorized, {}}, 2, 0},
+		{"async mode", flags.BroadcastAsync, 7, 0, []sdk.TxResponse{unauthorized}, 1, unauthorized.Code},
+		{"not a sequence error", flags.BroadcastSync, 5, 0, []sdk.TxResponse{unauthorized}, 1, unauthorized.Code},
+		{
+			"other error", flags.BroadcastSync, 7, 0,
+			[]sdk.TxResponse{{Codespace: sdkerrors.RootCodespace, Code: sdkerrors.ErrInsufficientFunds.ABCICode()}},
+			1, sdkerrors.ErrInsufficientFunds.ABCICode(),
+		},
+		{
+			"retries exhausted", flags.BroadcastSync, 7, 1,
+			[]sdk.TxResponse{unauthorized, unauthorized, unauthorized, unauthorized},
+			maxSequenceRetries + 1, unauthorized.Code,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			ar := &mockAccountRetriever{seq: tc.chainSeq, step: tc.seqStep}
+			txf := Factory{}.
+				WithTxGenerator(types.StdTxGenerator{Cdc: cdc}).
+				WithAccountRetriever(ar).
+				WithKeybase(kb).
+				WithChainID("test-chain").
+				WithAccountNumber(1).
+				WithSequence(5)
+
+			clientCtx := client.Context{}.
+				WithFromName(info.GetName()).
+				WithFromAddress(info.GetAddress()).
+				WithBroadcastMode(tc.mode)
+
+			msg := banktypes.NewMsgSend(info.GetAddress(), info.GetAddress(), nil)
+			tx, err := BuildUnsignedTx(txf, msg)
+			require.NoError(t, err)
+
+			var broadcasts [][]byte
+			res, err := signAndBroadcast(clientCtx, txf, tx, func(txBytes []byte) (sdk.TxResponse, error) {
+				broadcasts = append(broadcasts, txBytes)
+				return tc.responses[len(broadcasts)-1], nil
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expCode, res.Code)
+			require.Len(t, broadcasts, tc.expBroadcasts)
+
+			// a re-signed transaction must differ from the original one
+			if len(broadcasts) > 1 {
+				require.NotEqual(t, broadcasts[0], broadcasts[1])
+			}
+		})
+	}
+}
@@ -108,13 +108,8 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		}
 	}
 
-	txBytes, err := Sign(txf, clientCtx.GetFromName(), clientkeys.DefaultKeyPass, tx)
-	if err != nil {
-		return err
-	}
-
 	// broadcast to a Tendermint node
-	res, err := clientCtx.BroadcastTx(txBytes)
+	res, err := signAndBroadcast(clientCtx, txf, tx, clientCtx.BroadcastTx)
 	if err != nil {
 		return err
 	}
@@ -122,6 +117,56 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 	return clientCtx.Println(res)
 }
 
+// maxSequenceRetries defines the number of times a transaction is re-signed and
+// re-broadcasted after being rejected due to an account sequence mismatch.
+const maxSequenceRetries = 3
+
+// signAndBroadcast signs the given transaction and broadcasts it using the
+// provided broadcast function. When the broadcast mode reports CheckTx results
+// (sync or block) and the transaction is rejected because it was signed with a
+// stale account sequence, the sequence is queried again and the transaction is
+// re-signed and re-broadcasted, up to maxSequenceRetries times.
+func signAndBroadcast(
+	clientCtx client.Context, txf Factory, tx client.TxBuilder, broadcast func([]byte) (sdk.TxResponse, error),
+) (sdk.TxResponse, error) {
+
+	for retries := 0; ; retries++ {
+		txBytes, err := Sign(txf, clientCtx.GetFromName(), clientkeys.DefaultKeyPass, tx)
+		if err != nil {
+			return sdk.TxResponse{}, err
+		}
+
+		res, err := broadcast(txBytes)
+		if err != nil || retries == maxSequenceRetries || !canRetrySequence(clientCtx, res) {
+			return res, err
+		}
+
+		_, seq, err := txf.accountRetriever.GetAccountNumberSequence(clientCtx, clientCtx.GetFromAddress())
+		if err != nil || seq == txf.Sequence() {
+			// the rejection was not caused by a stale sequence
+			return res, nil
+		}
+
+		_, _ = fmt.Fprintf(os.Stderr, "account sequence mismatch, retrying with sequence %d\n", seq)
+		txf = txf.WithSequence(seq)
+	}
+}
+
+// canRetrySequence returns true if the broadcast response may stem from a
+// transaction signed with an incorrect account sequence. As the sequence is part
+// of the sign bytes, a mismatch surfaces as a signature verification failure.
+func canRetrySequence(clientCtx client.Context, res sdk.TxResponse) bool {
+	if clientCtx.BroadcastMode != flags.BroadcastSync && clientCtx.BroadcastMode != flags.BroadcastBlock {
+		return false
+	}
+
+	if res.Codespace != sdkerrors.RootCodespace {
+		return false
+	}
+
+	return res.Code == sdkerrors.ErrUnauthorized.ABCICode() || res.Code == sdkerrors.ErrInvalidSequence.ABCICode()
+}
+
 // WriteGeneratedTxResponse writes a generated unsigned transaction to the
 // provided http.ResponseWriter. It will simulate gas costs if requested by the
 // BaseReq. Upon any error, the error will be written to the http.ResponseWriter.
@@ -215,6 +260,7 @@ func BuildUnsignedTx(txf Factory, msgs ...sdk.Msg) (client.TxBuilder, error) {
 
 	tx := txf.txGenerator.NewTx()
 	tx.SetMemo(txf.memo)
+	tx.SetTimeoutHeight(txf.timeoutHeight)
 
 	if err := tx.SetFee(clientFee); err != nil {
 		return nil, err
@@ -277,8 +323,13 @@ func CalculateGas(
 // PrepareFactory ensures the account defined by ctx.GetFromAddress() exists and
 // if the account number and/or the account sequence number are zero (not set),
 // they will be queried for and set on the provided Factory. A new Factory with
-// the updated fields will be returned.
+// the updated fields will be returned. In offline mode no queries are performed
+// and the account number and sequence must be provided explicitly.
 func PrepareFactory(clientCtx client.Context, txf Factory) (Factory, error) {
+	if clientCtx.Offline {
+		return txf, nil
+	}
+
 	from := clientCtx.GetFromAddress()
 
 	if err := txf.accountRetriever.EnsureExists(clientCtx, from); err != nil {
@@ -43,6 +43,7 @@ type (
 		SetFee(Fee) error
 		GetMemo() string
 		SetMemo(string)
+		SetTimeoutHeight(uint64)
 
 		// CanonicalSignBytes returns the canonical sign bytes to sign over, given a
 		// chain ID, along with an account and sequence number.
@@ -42,6 +42,10 @@ type InterfaceRegistry interface {
 	// Ex:
 	//  registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSend{}, &MsgMultiSend{})
 	RegisterImplementations(iface interface{}, impls ...proto.Message)
+
+	// Resolve returns a new zero value of the concrete type registered for
+	// typeURL, regardless of the interface it was registered against.
+	Resolve(typeURL string) (proto.Message, error)
 }
 
 // UnpackInterfacesMessage is meant to extend protobuf types (which implement
@@ -71,6 +75,7 @@ type UnpackInterfacesMessage interface {
 type interfaceRegistry struct {
 	interfaceNames map[string]reflect.Type
 	interfaceImpls map[reflect.Type]interfaceMap
+	typeURLMap     map[string]reflect.Type
 }
 
 type interfaceMap = map[string]reflect.Type
@@ -80,6 +85,7 @@ func NewInterfaceRegistry() InterfaceRegistry {
 	return &interfaceRegistry{
 		interfaceNames: map[string]reflect.Type{},
 		interfaceImpls: map[reflect.Type]interfaceMap{},
+		typeURLMap:     map[string]reflect.Type{},
 	}
 }
 
@@ -105,7 +111,9 @@ func (registry *interfaceRegistry) RegisterImplementations(iface interface{}, im
 			panic(fmt.Errorf("type %T doesn't actually implement interface %+v", impl, ityp))
 		}
 
-		imap["/"+proto.MessageName(impl)] = implType
+		typeURL := "/" + proto.MessageName(impl)
+		imap[typeURL] = implType
+		registry.typeURLMap[typeURL] = implType
 	}
 
 	registry.interfaceImpls[ityp] = imap
@@ -164,6 +172,20 @@ func (registry *interfaceRegistry) UnpackAny(any *Any, iface interface{}) error
 	return nil
 }
 
+func (registry *interfaceRegistry) Resolve(typeURL string) (proto.Message, error) {
+	typ, found := registry.typeURLMap[typeURL]
+	if !found {
+		return nil, fmt.Errorf("unable to resolve type URL %s", typeURL)
+	}
+
+	msg, ok := reflect.New(typ.Elem()).Interface().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("can't resolve type URL %s", typeURL)
+	}
+
+	return msg, nil
+}
+
 // UnpackInterfaces is a convenience function that calls UnpackInterfaces
 // on x if x implements UnpackInterfacesMessage
 func UnpackInterfaces(x interface{}, unpacker AnyUnpacker) error {
@@ -80,6 +80,17 @@ func TestRegister(t *testing.T) {
 	})
 }
 
+func TestResolve(t *testing.T) {
+	registry := NewTestInterfaceRegistry()
+
+	msg, err := registry.Resolve("/cosmos_sdk.codec.v1.Dog")
+	require.NoError(t, err)
+	require.Equal(t, &testdata.Dog{}, msg)
+
+	_, err = registry.Resolve("/cosmos_sdk.codec.v1.Unknown")
+	require.Error(t, err)
+}
+
 func TestUnpackInterfaces(t *testing.T) {
 	registry := NewTestInterfaceRegistry()
 
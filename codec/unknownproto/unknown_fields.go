@@ -0,0 +1,214 @@
+/*
+Package unknownproto implements the detection of fields which are present in
+protobuf encoded bytes but unknown to the message they are decoded into.
+
+Following ADR 020, field numbers with bit 11 set (i.e. 1024-2047, 3072-4095, ...)
+are non-critical: clients may add them without expecting every node to
+understand them. Any other unknown field is critical and must be rejected, as
+it would otherwise be silently dropped while still being covered by the
+signature.
+*/
+package unknownproto
+
+import (
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+const (
+	bit11NonCritical = 1 << 10
+
+	anyTypeName = ".google.protobuf.Any"
+)
+
+// Resolver resolves the concrete message type of an Any's type URL.
+type Resolver interface {
+	Resolve(typeURL string) (proto.Message, error)
+}
+
+// descriptorIface is implemented by all gogoproto generated messages.
+type descriptorIface interface {
+	proto.Message
+	Descriptor() ([]byte, []int)
+}
+
+// RejectUnknownFields returns an error if bz contains a field which is not
+// declared by msg, recursing into nested messages and into the values of Any's,
+// which are resolved with resolver. If allowUnknownNonCriticals is true, unknown
+// non-critical fields are skipped and reported by the returned boolean instead.
+func RejectUnknownFields(bz []byte, msg proto.Message, allowUnknownNonCriticals bool, resolver Resolver) (hasUnknownNonCriticals bool, err error) {
+	fields, err := fieldsOf(msg)
+	if err != nil {
+		return false, err
+	}
+
+	for len(bz) > 0 {
+		tag, n := binary.Uvarint(bz)
+		if n <= 0 {
+			return hasUnknownNonCriticals, fmt.Errorf("invalid field tag in %s", proto.MessageName(msg))
+		}
+		bz = bz[n:]
+
+		fieldNum, wireType := int32(tag>>3), int(tag&0x7)
+		if fieldNum <= 0 {
+			return hasUnknownNonCriticals, fmt.Errorf("invalid field number %d in %s", fieldNum, proto.MessageName(msg))
+		}
+
+		value, rest, err := consumeField(bz, wireType)
+		if err != nil {
+			return hasUnknownNonCriticals, fmt.Errorf("field %d of %s: %w", fieldNum, proto.MessageName(msg), err)
+		}
+		bz = rest
+
+		field, ok := fields[fieldNum]
+		if !ok {
+			if !allowUnknownNonCriticals || fieldNum&bit11NonCritical == 0 {
+				return hasUnknownNonCriticals, fmt.Errorf(
+					"unknown field %d with wire type %d in %s", fieldNum, wireType, proto.MessageName(msg),
+				)
+			}
+
+			hasUnknownNonCriticals = true
+			continue
+		}
+
+		if field.GetType() != descriptor.FieldDescriptorProto_TYPE_MESSAGE {
+			continue
+		}
+
+		if wireType != proto.WireBytes {
+			return hasUnknownNonCriticals, fmt.Errorf(
+				"field %d of %s is a message but has wire type %d", fieldNum, proto.MessageName(msg), wireType,
+			)
+		}
+
+		nested, err := nestedMessage(field.GetTypeName(), value, resolver)
+		if err != nil {
+			return hasUnknownNonCriticals, err
+		}
+
+		if any, ok := nested.(*types.Any); ok {
+			nested, err = resolver.Resolve(any.TypeUrl)
+			if err != nil {
+				return hasUnknownNonCriticals, err
+			}
+
+			value = any.Value
+		}
+
+		nestedUnknown, err := RejectUnknownFields(value, nested, allowUnknownNonCriticals, resolver)
+		hasUnknownNonCriticals = hasUnknownNonCriticals || nestedUnknown
+		if err != nil {
+			return hasUnknownNonCriticals, err
+		}
+	}
+
+	return hasUnknownNonCriticals, nil
+}
+
+// RejectUnknownFieldsStrict is like RejectUnknownFields but also rejects
+// unknown non-critical fields.
+func RejectUnknownFieldsStrict(bz []byte, msg proto.Message, resolver Resolver) error {
+	_, err := RejectUnknownFields(bz, msg, false, resolver)
+	return err
+}
+
+// nestedMessage returns a new message of the given fully qualified type name.
+// Any's are decoded from bz so that their value can be resolved by the caller.
+func nestedMessage(typeName string, bz []byte, resolver Resolver) (proto.Message, error) {
+	if typeName == anyTypeName {
+		if resolver == nil {
+			return nil, fmt.Errorf("cannot check the value of an Any without a resolver")
+		}
+
+		any := &types.Any{}
+		if err := proto.Unmarshal(bz, any); err != nil {
+			return nil, err
+		}
+
+		return any, nil
+	}
+
+	typ := proto.MessageType(strings.TrimPrefix(typeName, "."))
+	if typ == nil {
+		return nil, fmt.Errorf("unknown message type %s", typeName)
+	}
+
+	return reflect.New(typ.Elem()).Interface().(proto.Message), nil
+}
+
+// consumeField splits bz into the value of a field of the given wire type and
+// the remaining bytes. For length-delimited fields the length prefix is
+// stripped from the returned value.
+func consumeField(bz []byte, wireType int) (value, rest []byte, err error) {
+	switch wireType {
+	case proto.WireVarint:
+		_, n := binary.Uvarint(bz)
+		if n <= 0 {
+			return nil, nil, fmt.Errorf("invalid varint")
+		}
+
+		return bz[:n], bz[n:], nil
+
+	case proto.WireFixed64:
+		if len(bz) < 8 {
+			return nil, nil, fmt.Errorf("unexpected end of fixed64")
+		}
+
+		return bz[:8], bz[8:], nil
+
+	case proto.WireFixed32:
+		if len(bz) < 4 {
+			return nil, nil, fmt.Errorf("unexpected end of fixed32")
+		}
+
+		return bz[:4], bz[4:], nil
+
+	case proto.WireBytes:
+		length, n := binary.Uvarint(bz)
+		if n <= 0 || length > uint64(len(bz)-n) {
+			return nil, nil, fmt.Errorf("invalid length-delimited value")
+		}
+
+		end := n + int(length)
+		return bz[n:end], bz[end:], nil
+
+	default:
+		return nil, nil, fmt.Errorf("unsupported wire type %d", wireType)
+	}
+}
+
+// fieldsCache caches the declared fields of each message type, indexed by
+// their number, as extracting them requires decompressing file descriptors.
+var fieldsCache sync.Map // map[reflect.Type]map[int32]*descriptor.FieldDescriptorProto
+
+func fieldsOf(msg proto.Message) (map[int32]*descriptor.FieldDescriptorProto, error) {
+	typ := reflect.TypeOf(msg)
+	if fields, ok := fieldsCache.Load(typ); ok {
+		return fields.(map[int32]*descriptor.FieldDescriptorProto), nil
+	}
+
+	dmsg, ok := msg.(descriptorIface)
+	if !ok {
+		return nil, fmt.Errorf("%T does not provide a descriptor", msg)
+	}
+
+	_, md := descriptor.ForMessage(dmsg)
+
+	fields := make(map[int32]*descriptor.FieldDescriptorProto, len(md.Field))
+	for _, field := range md.Field {
+		fields[field.GetNumber()] = field
+	}
+
+	fieldsCache.Store(typ, fields)
+
+	return fields, nil
+}
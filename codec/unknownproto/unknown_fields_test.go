@@ -0,0 +1,91 @@
+package unknownproto_test
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec/testdata"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
+)
+
+// withVarintField appends a varint field with the given number to bz.
+func withVarintField(bz []byte, fieldNum int) []byte {
+	bz = append(bz, proto.EncodeVarint(uint64(fieldNum)<<3|proto.WireVarint)...)
+	return append(bz, proto.EncodeVarint(1)...)
+}
+
+// withBytesField appends a length-delimited field with the given number to bz.
+func withBytesField(bz []byte, fieldNum int, value []byte) []byte {
+	bz = append(bz, proto.EncodeVarint(uint64(fieldNum)<<3|proto.WireBytes)...)
+	bz = append(bz, proto.EncodeVarint(uint64(len(value)))...)
+	return append(bz, value...)
+}
+
+func TestRejectUnknownFields(t *testing.T) {
+	registry := types.NewInterfaceRegistry()
+	registry.RegisterInterface("Animal", (*testdata.Animal)(nil), &testdata.Dog{})
+	registry.RegisterImplementations((*testdata.HasAnimalI)(nil), &testdata.HasAnimal{})
+
+	dogBz, err := (&testdata.Dog{Name: "Spot"}).Marshal()
+	require.NoError(t, err)
+
+	// hasHasAnimal wraps the given dog bytes in an Any, itself nested in another Any
+	hasHasAnimal := func(dogBz []byte) []byte {
+		hasAnimal := &testdata.HasAnimal{Animal: &types.Any{TypeUrl: "/cosmos_sdk.codec.v1.Dog", Value: dogBz}, X: 1}
+		hasAnimalBz, err := hasAnimal.Marshal()
+		require.NoError(t, err)
+
+		bz, err := (&testdata.HasHasAnimal{
+			HasAnimal: &types.Any{TypeUrl: "/cosmos_sdk.codec.v1.HasAnimal", Value: hasAnimalBz},
+		}).Marshal()
+		require.NoError(t, err)
+
+		return bz
+	}
+
+	testCases := []struct {
+		name           string
+		bz             []byte
+		allowNonCrit   bool
+		expNonCritical bool
+		expErr         bool
+	}{
+		{"known fields", hasHasAnimal(dogBz), false, false, false},
+		{"unknown critical field", withVarintField(hasHasAnimal(dogBz), 2), true, false, true},
+		{"unknown critical field in nested Any", hasHasAnimal(withVarintField(dogBz, 3)), true, false, true},
+		{"unknown critical field above 1024", withVarintField(hasHasAnimal(dogBz), 2048), true, false, true},
+		{"unknown non-critical field", withVarintField(hasHasAnimal(dogBz), 1031), true, true, false},
+		{"unknown non-critical field in nested Any", hasHasAnimal(withBytesField(dogBz, 1024, []byte("x"))), true, true, false},
+		{"unknown non-critical field rejected", withVarintField(hasHasAnimal(dogBz), 1031), false, false, true},
+		{"unresolvable Any", withBytesField(nil, 1, []byte("\n\x08/unknown")), true, false, true},
+		{"truncated field", hasHasAnimal(dogBz)[:5], true, false, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			hasNonCritical, err := unknownproto.RejectUnknownFields(tc.bz, &testdata.HasHasAnimal{}, tc.allowNonCrit, registry)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expNonCritical, hasNonCritical)
+		})
+	}
+}
+
+func TestRejectUnknownFieldsStrict(t *testing.T) {
+	registry := types.NewInterfaceRegistry()
+
+	bz, err := (&testdata.Dog{Name: "Spot"}).Marshal()
+	require.NoError(t, err)
+
+	require.NoError(t, unknownproto.RejectUnknownFieldsStrict(bz, &testdata.Dog{}, registry))
+	require.Error(t, unknownproto.RejectUnknownFieldsStrict(withVarintField(bz, 1031), &testdata.Dog{}, registry))
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/tendermint/tendermint/crypto/sr25519"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 )
 
@@ -27,6 +28,8 @@ func RegisterCrypto(cdc *codec.Codec) {
 		sr25519.PubKeyAminoName, nil)
 	cdc.RegisterConcrete(secp256k1.PubKeySecp256k1{},
 		secp256k1.PubKeyAminoName, nil)
+	cdc.RegisterConcrete(secp256r1.PubKeySecp256r1{},
+		secp256r1.PubKeyAminoName, nil)
 	cdc.RegisterConcrete(multisig.PubKeyMultisigThreshold{},
 		multisig.PubKeyAminoRoute, nil)
 
@@ -37,6 +40,8 @@ func RegisterCrypto(cdc *codec.Codec) {
 		sr25519.PrivKeyAminoName, nil)
 	cdc.RegisterConcrete(secp256k1.PrivKeySecp256k1{},
 		secp256k1.PrivKeyAminoName, nil)
+	cdc.RegisterConcrete(secp256r1.PrivKeySecp256r1{},
+		secp256r1.PrivKeyAminoName, nil)
 }
 
 // PrivKeyFromBytes unmarshals private key bytes and returns a PrivKey
@@ -123,6 +123,8 @@ type Options struct {
 	SupportedAlgos SigningAlgoList
 	// supported signing algorithms for Ledger
 	SupportedAlgosLedger SigningAlgoList
+	// bits of entropy used to generate new mnemonics
+	MnemonicEntropySize int
 }
 
 // NewInMemory creates a transient keyring useful for testing
@@ -177,6 +179,7 @@ func newKeystore(kr keyring.Keyring, opts ...Option) keystore {
 	options := Options{
 		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
 		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
+		MnemonicEntropySize:  defaultEntropySize,
 	}
 
 	for _, optionFn := range opts {
@@ -468,9 +471,10 @@ func (ks keystore) NewMnemonic(uid string, language Language, hdPath string, alg
 		return nil, "", ErrUnsupportedSigningAlgo
 	}
 
-	// Default number of words (24): This generates a mnemonic directly from the
-	// number of words by reading system entropy.
-	entropy, err := bip39.NewEntropy(defaultEntropySize)
+	// The number of words defaults to 24 and depends on the entropy size option:
+	// This generates a mnemonic directly from the number of words by reading
+	// system entropy.
+	entropy, err := bip39.NewEntropy(ks.options.MnemonicEntropySize)
 	if err != nil {
 		return nil, "", err
 	}
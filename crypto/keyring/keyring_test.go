@@ -47,6 +47,26 @@ func TestNewKeyring(t *testing.T) {
 	require.Equal(t, "foo", info.GetName())
 }
 
+func TestNewMnemonicEntropySize(t *testing.T) {
+	kb := NewInMemory()
+	_, mnemonic, err := kb.NewMnemonic("default", English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+	require.Len(t, strings.Fields(mnemonic), 24)
+
+	kb = NewInMemory(func(options *Options) {
+		options.MnemonicEntropySize = 128
+	})
+	_, mnemonic, err = kb.NewMnemonic("short", English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+	require.Len(t, strings.Fields(mnemonic), 12)
+
+	kb = NewInMemory(func(options *Options) {
+		options.MnemonicEntropySize = 100
+	})
+	_, _, err = kb.NewMnemonic("invalid", English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.Error(t, err)
+}
+
 func TestKeyManagementKeyRing(t *testing.T) {
 	dir, cleanup := tests.NewTestCaseDir(t)
 	t.Cleanup(cleanup)
@@ -0,0 +1,224 @@
+// Package secp256r1 implements the NIST P-256 (secp256r1) curve keys used by
+// hardware backed signers, such as the secure enclaves of mobile devices.
+package secp256r1
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"math/big"
+
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+const (
+	PrivKeyAminoName = "cosmos-sdk/PrivKeySecp256r1"
+	PubKeyAminoName  = "cosmos-sdk/PubKeySecp256r1"
+
+	// PubKeySecp256r1Size is the size of a compressed public key: one byte for
+	// the parity of the y-coordinate followed by the 32 byte x-coordinate.
+	PubKeySecp256r1Size = 33
+
+	// SignatureSize is the size of a signature, which is the concatenation of
+	// the 32 byte big-endian r and s values.
+	SignatureSize = 64
+
+	fieldSize = 32
+)
+
+var cdc = amino.NewCodec()
+
+func init() {
+	cdc.RegisterInterface((*crypto.PubKey)(nil), nil)
+	cdc.RegisterConcrete(PubKeySecp256r1{},
+		PubKeyAminoName, nil)
+
+	cdc.RegisterInterface((*crypto.PrivKey)(nil), nil)
+	cdc.RegisterConcrete(PrivKeySecp256r1{},
+		PrivKeyAminoName, nil)
+}
+
+var (
+	curve = elliptic.P256()
+
+	// halfOrder is used to reject malleable signatures with a high s value
+	halfOrder = new(big.Int).Rsh(curve.Params().N, 1)
+)
+
+//-------------------------------------
+
+var _ crypto.PrivKey = PrivKeySecp256r1{}
+
+// PrivKeySecp256r1 implements crypto.PrivKey. It is the 32 byte big-endian
+// private scalar.
+type PrivKeySecp256r1 [fieldSize]byte
+
+// GenPrivKey generates a new secp256r1 private key using OS randomness.
+func GenPrivKey() PrivKeySecp256r1 {
+	return genPrivKey(crypto.CReader())
+}
+
+func genPrivKey(rand io.Reader) PrivKeySecp256r1 {
+	key, err := ecdsa.GenerateKey(curve, rand)
+	if err != nil {
+		panic(err)
+	}
+
+	var privKey PrivKeySecp256r1
+	putInt(privKey[:], key.D)
+
+	return privKey
+}
+
+// Bytes marshals the private key using amino encoding.
+func (privKey PrivKeySecp256r1) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(privKey)
+}
+
+// Sign returns the signature of the SHA-256 digest of msg. The s value is
+// normalized to the lower half of the curve order.
+func (privKey PrivKeySecp256r1) Sign(msg []byte) ([]byte, error) {
+	digest := sha256.Sum256(msg)
+
+	r, s, err := ecdsa.Sign(crypto.CReader(), privKey.toECDSA(), digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Cmp(halfOrder) > 0 {
+		s.Sub(curve.Params().N, s)
+	}
+
+	sig := make([]byte, SignatureSize)
+	putInt(sig[:fieldSize], r)
+	putInt(sig[fieldSize:], s)
+
+	return sig, nil
+}
+
+// PubKey returns the compressed public key of the private key.
+func (privKey PrivKeySecp256r1) PubKey() crypto.PubKey {
+	key := privKey.toECDSA()
+
+	var pubKey PubKeySecp256r1
+	pubKey[0] = 0x02 | byte(key.Y.Bit(0))
+	putInt(pubKey[1:], key.X)
+
+	return pubKey
+}
+
+// Equals runs in constant time based on length of the keys.
+func (privKey PrivKeySecp256r1) Equals(other crypto.PrivKey) bool {
+	if otherKey, ok := other.(PrivKeySecp256r1); ok {
+		return subtle.ConstantTimeCompare(privKey[:], otherKey[:]) == 1
+	}
+	return false
+}
+
+func (privKey PrivKeySecp256r1) toECDSA() *ecdsa.PrivateKey {
+	key := &ecdsa.PrivateKey{D: new(big.Int).SetBytes(privKey[:])}
+	key.Curve = curve
+	key.X, key.Y = curve.ScalarBaseMult(privKey[:])
+
+	return key
+}
+
+//-------------------------------------
+
+var _ crypto.PubKey = PubKeySecp256r1{}
+
+// PubKeySecp256r1 implements crypto.PubKey. It is the compressed form of the
+// public key: a 0x02 or 0x03 byte depending on the parity of the y-coordinate,
+// followed by the x-coordinate.
+type PubKeySecp256r1 [PubKeySecp256r1Size]byte
+
+// Address returns the first 20 bytes of the SHA-256 hash of the public key.
+func (pubKey PubKeySecp256r1) Address() crypto.Address {
+	return crypto.Address(tmhash.SumTruncated(pubKey[:]))
+}
+
+// Bytes returns the public key marshaled with amino encoding.
+func (pubKey PubKeySecp256r1) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(pubKey)
+}
+
+// VerifyBytes verifies a 64 byte r || s signature of the SHA-256 digest of
+// msg. Signatures with a high s value are rejected to prevent malleability.
+func (pubKey PubKeySecp256r1) VerifyBytes(msg []byte, sig []byte) bool {
+	if len(sig) != SignatureSize {
+		return false
+	}
+
+	key, ok := pubKey.toECDSA()
+	if !ok {
+		return false
+	}
+
+	r := new(big.Int).SetBytes(sig[:fieldSize])
+	s := new(big.Int).SetBytes(sig[fieldSize:])
+	if s.Cmp(halfOrder) > 0 {
+		return false
+	}
+
+	digest := sha256.Sum256(msg)
+	return ecdsa.Verify(key, digest[:], r, s)
+}
+
+func (pubKey PubKeySecp256r1) String() string {
+	return fmt.Sprintf("PubKeySecp256r1{%X}", pubKey[:])
+}
+
+func (pubKey PubKeySecp256r1) Equals(other crypto.PubKey) bool {
+	if otherKey, ok := other.(PubKeySecp256r1); ok {
+		return bytes.Equal(pubKey[:], otherKey[:])
+	}
+	return false
+}
+
+// toECDSA decompresses the public key. It returns false if the key is not a
+// point on the curve.
+func (pubKey PubKeySecp256r1) toECDSA() (*ecdsa.PublicKey, bool) {
+	if pubKey[0] != 0x02 && pubKey[0] != 0x03 {
+		return nil, false
+	}
+
+	params := curve.Params()
+	x := new(big.Int).SetBytes(pubKey[1:])
+	if x.Cmp(params.P) >= 0 {
+		return nil, false
+	}
+
+	// y² = x³ - 3x + b
+	y := new(big.Int).Mul(x, x)
+	y.Mul(y, x)
+	threeX := new(big.Int).Lsh(x, 1)
+	threeX.Add(threeX, x)
+	y.Sub(y, threeX)
+	y.Add(y, params.B)
+	y.Mod(y, params.P)
+
+	if y.ModSqrt(y, params.P) == nil {
+		return nil, false
+	}
+	if y.Bit(0) != uint(pubKey[0]&1) {
+		y.Sub(params.P, y)
+	}
+
+	if !curve.IsOnCurve(x, y) {
+		return nil, false
+	}
+
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, true
+}
+
+// putInt writes x to dst as a zero padded big-endian integer.
+func putInt(dst []byte, x *big.Int) {
+	bz := x.Bytes()
+	copy(dst[len(dst)-len(bz):], bz)
+}
@@ -0,0 +1,104 @@
+package secp256r1_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	privKey := secp256r1.GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	msg := []byte("hello world")
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, secp256r1.SignatureSize)
+
+	require.True(t, pubKey.VerifyBytes(msg, sig))
+	require.False(t, pubKey.VerifyBytes([]byte("hello world!"), sig))
+	require.False(t, pubKey.VerifyBytes(msg, sig[:secp256r1.SignatureSize-1]))
+	require.False(t, secp256r1.GenPrivKey().PubKey().VerifyBytes(msg, sig))
+
+	// the high s form of the same signature is rejected
+	n := elliptic.P256().Params().N
+	s := new(big.Int).SetBytes(sig[32:])
+	highS := new(big.Int).Sub(n, s).Bytes()
+	malleated := append([]byte{}, sig[:32]...)
+	malleated = append(malleated, make([]byte, 32-len(highS))...)
+	malleated = append(malleated, highS...)
+	require.False(t, pubKey.VerifyBytes(msg, malleated))
+}
+
+func TestVerifyStandardSignature(t *testing.T) {
+	// signatures produced by any P-256 implementation verify against the
+	// compressed public key
+	key, err := ecdsa.GenerateKey(elliptic.P256(), crypto.CReader())
+	require.NoError(t, err)
+
+	var pubKey secp256r1.PubKeySecp256r1
+	pubKey[0] = 0x02 | byte(key.Y.Bit(0))
+	x := key.X.Bytes()
+	copy(pubKey[1+32-len(x):], x)
+
+	msg := []byte("secure enclave")
+	digest := sha256.Sum256(msg)
+	r, s, err := ecdsa.Sign(crypto.CReader(), key, digest[:])
+	require.NoError(t, err)
+
+	if s.Cmp(new(big.Int).Rsh(elliptic.P256().Params().N, 1)) > 0 {
+		s.Sub(elliptic.P256().Params().N, s)
+	}
+
+	sig := make([]byte, secp256r1.SignatureSize)
+	copy(sig[32-len(r.Bytes()):32], r.Bytes())
+	copy(sig[64-len(s.Bytes()):], s.Bytes())
+	require.True(t, pubKey.VerifyBytes(msg, sig))
+}
+
+func TestInvalidPubKey(t *testing.T) {
+	sig, err := secp256r1.GenPrivKey().Sign([]byte("msg"))
+	require.NoError(t, err)
+
+	var pubKey secp256r1.PubKeySecp256r1
+	require.False(t, pubKey.VerifyBytes([]byte("msg"), sig))
+
+	pubKey[0] = 0x04
+	require.False(t, pubKey.VerifyBytes([]byte("msg"), sig))
+}
+
+func TestEquals(t *testing.T) {
+	privKey := secp256r1.GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	require.True(t, privKey.Equals(privKey))
+	require.False(t, privKey.Equals(secp256r1.GenPrivKey()))
+	require.False(t, privKey.Equals(secp256k1.GenPrivKey()))
+
+	require.True(t, pubKey.Equals(privKey.PubKey()))
+	require.False(t, pubKey.Equals(secp256r1.GenPrivKey().PubKey()))
+	require.False(t, pubKey.Equals(secp256k1.GenPrivKey().PubKey()))
+	require.Len(t, pubKey.Address(), 20)
+}
+
+func TestAminoRoundTrip(t *testing.T) {
+	privKey := secp256r1.GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	var decodedPubKey crypto.PubKey
+	require.NoError(t, legacy.Cdc.UnmarshalBinaryBare(pubKey.Bytes(), &decodedPubKey))
+	require.Equal(t, pubKey, decodedPubKey)
+
+	var decodedPrivKey crypto.PrivKey
+	require.NoError(t, legacy.Cdc.UnmarshalBinaryBare(privKey.Bytes(), &decodedPrivKey))
+	require.Equal(t, privKey, decodedPrivKey)
+}
@@ -103,7 +103,7 @@ func (pk PubKeyMultisigThreshold) VerifyMultisignature(getSignBytes GetSignBytes
 					return err
 				}
 				if !pk.PubKeys[i].VerifyBytes(msg, si.Signature) {
-					return err
+					return fmt.Errorf("unable to verify signature at index %d", i)
 				}
 			case *signing.MultiSignatureData:
 				nestedMultisigPk, ok := pk.PubKeys[i].(PubKey)
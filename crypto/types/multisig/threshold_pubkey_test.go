@@ -131,6 +131,27 @@ func TestThresholdMultisigDuplicateSignatures(t *testing.T) {
 	require.Error(t, multisigKey.VerifyMultisignature(signBytesFn, multisignature))
 }
 
+func TestThresholdMultisigInvalidSignature(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	pubkeys, sigs := generatePubKeysAndSignatures(3, msg)
+	_, wrongSigs := generatePubKeysAndSignatures(3, msg)
+	multisigKey := multisig.NewPubKeyMultisigThreshold(2, pubkeys)
+	signBytesFn := func(mode signing.SignMode) ([]byte, error) { return msg, nil }
+
+	multisignature := multisig.NewMultisig(3)
+	require.NoError(t, multisig.AddSignatureFromPubKey(multisignature, sigs[0], pubkeys[0], pubkeys))
+	require.NoError(t, multisig.AddSignatureFromPubKey(multisignature, wrongSigs[1], pubkeys[1], pubkeys))
+	require.Error(t, multisigKey.VerifyMultisignature(signBytesFn, multisignature))
+
+	// replacing the bad signature makes the multisig valid
+	require.NoError(t, multisig.AddSignatureFromPubKey(multisignature, sigs[1], pubkeys[1], pubkeys))
+	require.NoError(t, multisigKey.VerifyMultisignature(signBytesFn, multisignature))
+
+	// signatures over a different message are rejected
+	otherMsgFn := func(mode signing.SignMode) ([]byte, error) { return []byte{5, 6, 7, 8}, nil }
+	require.Error(t, multisigKey.VerifyMultisignature(otherMsgFn, multisignature))
+}
+
 // TODO: Fully replace this test with table driven tests
 func TestMultiSigPubKeyEquality(t *testing.T) {
 	msg := []byte{1, 2, 3, 4}
@@ -185,6 +206,10 @@ func TestMultiSigMigration(t *testing.T) {
 	pkSet, sigs := generatePubKeysAndSignatures(2, msg)
 	multisignature := multisig.NewMultisig(2)
 
+	// replace the second key with one we hold the private key for
+	privKey := secp256k1.GenPrivKey()
+	pkSet[1] = privKey.PubKey()
+
 	multisigKey := multisig.NewPubKeyMultisigThreshold(2, pkSet)
 	signBytesFn := func(mode signing.SignMode) ([]byte, error) { return msg, nil }
 
@@ -193,7 +218,9 @@ func TestMultiSigMigration(t *testing.T) {
 	err := multisig.AddSignatureFromPubKey(multisignature, sigs[0], pkSet[0], pkSet)
 
 	// create a StdSignature for msg, and convert it to sigV2
-	sig := authtypes.StdSignature{PubKey: pkSet[1].Bytes(), Signature: msg}
+	stdSigBz, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	sig := authtypes.StdSignature{PubKey: pkSet[1].Bytes(), Signature: stdSigBz}
 	sigV2, err := authtypes.StdSignatureToSignatureV2(cdc, sig)
 	require.NoError(t, multisig.AddSignatureV2(multisignature, sigV2, pkSet))
 
@@ -0,0 +1,143 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+// GRPCGatewayPrefix is the path prefix under which the API server serves the
+// REST translation of the registered gRPC services.
+const GRPCGatewayPrefix = "/grpc"
+
+var _ gogogrpc.Server = (*Server)(nil)
+
+// RegisterService implements the gogogrpc.Server interface, which lets the
+// application register its gRPC query services with the API server, e.g. with
+// BaseApp.RegisterGRPCServer. Every unary method of the service is then
+// served at POST /grpc/<service>/<method>: the request body is the Proto3
+// JSON encoded request, and the response the Proto3 JSON encoded response.
+// As for the gRPC server, a height can be queried with the height query
+// parameter, and the height the query was executed at is reported in the
+// x-cosmos-block-height header.
+func (s *Server) RegisterService(sd *grpc.ServiceDesc, handler interface{}) {
+	for _, method := range sd.Methods {
+		fqName := fmt.Sprintf("/%s/%s", sd.ServiceName, method.MethodName)
+		path := GRPCGatewayPrefix + fqName
+
+		s.Router.HandleFunc(path, gatewayHandler(fqName, method.Handler, handler)).Methods(http.MethodPost)
+	}
+}
+
+// methodHandler is the type of the handler of a unary gRPC method, see
+// grpc.MethodDesc.
+type methodHandler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error)
+
+func gatewayHandler(fqName string, handler methodHandler, srv interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		ctx := r.Context()
+		if height := r.FormValue("height"); height != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(baseapp.GRPCBlockHeightHeader, height))
+		}
+
+		stream := &gatewayStream{method: fqName}
+		ctx = grpc.NewContextWithServerTransportStream(ctx, stream)
+
+		dec := func(req interface{}) error {
+			if len(bytes.TrimSpace(body)) == 0 {
+				return nil
+			}
+
+			msg, ok := req.(proto.Message)
+			if !ok {
+				return status.Errorf(codes.Internal, "cannot decode request of type %T", req)
+			}
+
+			if err := jsonpb.Unmarshal(bytes.NewReader(body), msg); err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid request: %s", err)
+			}
+
+			return nil
+		}
+
+		res, err := handler(srv, ctx, dec, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, httpStatusFromError(err), err.Error())
+			return
+		}
+
+		msg, ok := res.(proto.Message)
+		if !ok {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("cannot encode response of type %T", res))
+			return
+		}
+
+		bz, err := codec.ProtoMarshalJSON(msg)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		if heights := stream.header.Get(baseapp.GRPCBlockHeightHeader); len(heights) > 0 {
+			w.Header().Set(baseapp.GRPCBlockHeightHeader, heights[0])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(bz)
+	}
+}
+
+// httpStatusFromError maps the gRPC status code of an error returned by a
+// gRPC method handler to an HTTP status code.
+func httpStatusFromError(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	}
+
+	return http.StatusInternalServerError
+}
+
+// gatewayStream implements grpc.ServerTransportStream in order to collect the
+// headers set by the gRPC method handlers.
+type gatewayStream struct {
+	method string
+	header metadata.MD
+}
+
+var _ grpc.ServerTransportStream = (*gatewayStream)(nil)
+
+func (s *gatewayStream) Method() string { return s.method }
+
+func (s *gatewayStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *gatewayStream) SendHeader(md metadata.MD) error { return s.SetHeader(md) }
+
+func (s *gatewayStream) SetTrailer(metadata.MD) error { return nil }
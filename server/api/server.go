@@ -0,0 +1,116 @@
+package api
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/handlers"
+	"github.com/gorilla/mux"
+	"github.com/rakyll/statik/fs"
+	"github.com/tendermint/tendermint/libs/log"
+	tmrpcserver "github.com/tendermint/tendermint/rpc/jsonrpc/server"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	// unnamed import of statik for swagger UI support
+	_ "github.com/cosmos/cosmos-sdk/client/lcd/statik"
+)
+
+// Server defines the server's API interface. It serves the REST routes
+// registered by the application from within the node process.
+type Server struct {
+	Router    *mux.Router
+	ClientCtx client.Context
+
+	logger   log.Logger
+	metrics  *telemetry.Metrics
+	listener net.Listener
+}
+
+// New returns a new API Server. The client.Context is expected to point to
+// the node's local RPC client.
+func New(clientCtx client.Context, logger log.Logger) *Server {
+	return &Server{
+		Router:    mux.NewRouter(),
+		ClientCtx: clientCtx,
+		logger:    logger,
+	}
+}
+
+// SetTelemetry sets the metrics served by the API server at /metrics. The
+// format query parameter selects the format of the response, see
+// telemetry.Metrics.Gather.
+func (s *Server) SetTelemetry(m *telemetry.Metrics) {
+	s.metrics = m
+	s.Router.HandleFunc("/metrics", s.metricsHandler).Methods(http.MethodGet)
+}
+
+func (s *Server) metricsHandler(w http.ResponseWriter, r *http.Request) {
+	format := strings.TrimSpace(r.FormValue("format"))
+
+	gr, err := s.metrics.Gather(format)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("failed to gather metrics: %s", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", gr.ContentType)
+	_, _ = w.Write(gr.Metrics)
+}
+
+// Start starts the API server. Internally, the API server leverages Tendermint's
+// JSON RPC server. Configuration options are provided via config.APIConfig
+// and are delegated to the Tendermint JSON RPC server. Note, this creates a
+// blocking process.
+func (s *Server) Start(cfg config.Config) error {
+	if cfg.API.Swagger {
+		s.registerSwaggerUI()
+	}
+
+	tmCfg := tmrpcserver.DefaultConfig()
+	tmCfg.MaxOpenConnections = int(cfg.API.MaxOpenConnections)
+	tmCfg.ReadTimeout = time.Duration(cfg.API.RPCReadTimeout) * time.Second
+	tmCfg.WriteTimeout = time.Duration(cfg.API.RPCWriteTimeout) * time.Second
+	tmCfg.MaxBodyBytes = int64(cfg.API.RPCMaxBodyBytes)
+
+	listener, err := tmrpcserver.Listen(cfg.API.Address, tmCfg)
+	if err != nil {
+		return err
+	}
+
+	s.listener = listener
+
+	var h http.Handler = s.Router
+	if cfg.API.EnableUnsafeCORS {
+		h = handlers.CORS()(h)
+	}
+
+	s.logger.Info(fmt.Sprintf("starting API server on %s...", cfg.API.Address))
+
+	return tmrpcserver.Serve(s.listener, h, s.logger, tmCfg)
+}
+
+// Close closes the API server.
+func (s *Server) Close() error {
+	if s.listener == nil {
+		return nil
+	}
+
+	return s.listener.Close()
+}
+
+func (s *Server) registerSwaggerUI() {
+	statikFS, err := fs.New()
+	if err != nil {
+		panic(err)
+	}
+
+	staticServer := http.FileServer(statikFS)
+	s.Router.PathPrefix("/").Handler(staticServer)
+}
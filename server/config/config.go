@@ -4,7 +4,8 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -35,14 +36,83 @@ type BaseConfig struct {
 	// InterBlockCache enables inter-block caching.
 	InterBlockCache bool `mapstructure:"inter-block-cache"`
 
-	Pruning              string `mapstructure:"pruning"`
-	PruningKeepEvery     string `mapstructure:"pruning-keep-every"`
-	PruningSnapshotEvery string `mapstructure:"pruning-snapshot-every"`
+	// SigVerifyWorkers defines the number of goroutines used to verify tx
+	// signatures in CheckTx. Values lower than two verify them sequentially.
+	SigVerifyWorkers int `mapstructure:"sig-verify-workers"`
+
+	Pruning           string `mapstructure:"pruning"`
+	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
+	PruningKeepEvery  string `mapstructure:"pruning-keep-every"`
+	PruningInterval   string `mapstructure:"pruning-interval"`
+}
+
+// APIConfig defines the API listener configuration.
+type APIConfig struct {
+	// Enable defines if the API server should be enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// Swagger defines if swagger documentation should automatically be registered.
+	Swagger bool `mapstructure:"swagger"`
+
+	// EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk)
+	EnableUnsafeCORS bool `mapstructure:"enabled-unsafe-cors"`
+
+	// Address defines the API server to listen on
+	Address string `mapstructure:"address"`
+
+	// MaxOpenConnections defines the number of maximum open connections
+	MaxOpenConnections uint `mapstructure:"max-open-connections"`
+
+	// RPCReadTimeout defines the Tendermint RPC read timeout (in seconds)
+	RPCReadTimeout uint `mapstructure:"rpc-read-timeout"`
+
+	// RPCWriteTimeout defines the Tendermint RPC write timeout (in seconds)
+	RPCWriteTimeout uint `mapstructure:"rpc-write-timeout"`
+
+	// RPCMaxBodyBytes defines the Tendermint maximum response body (in bytes)
+	RPCMaxBodyBytes uint `mapstructure:"rpc-max-body-bytes"`
+}
+
+// GRPCConfig defines the gRPC server configuration.
+type GRPCConfig struct {
+	// Enable defines if the gRPC server should be enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// Address defines the gRPC server address to bind to.
+	Address string `mapstructure:"address"`
+}
+
+// StreamingConfig defines the state streaming configuration.
+type StreamingConfig struct {
+	// Service defines the streaming service registered with the BaseApp. An
+	// empty value disables streaming and "file" enables the file streaming
+	// service. No gRPC sink is provided.
+	Service string `mapstructure:"service"`
+
+	// Keys defines the names of the KVStores whose state changes are streamed.
+	// The "*" wildcard streams every KVStore.
+	Keys []string `mapstructure:"keys"`
+
+	// FileWriteDir defines the directory the file streaming service writes the
+	// block files to. A relative path is resolved against the node's home.
+	FileWriteDir string `mapstructure:"file-write-dir"`
 }
 
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
+
+	// API defines the configuration of the API server embedded in the node
+	API APIConfig `mapstructure:"api"`
+
+	// GRPC defines the configuration of the gRPC server embedded in the node
+	GRPC GRPCConfig `mapstructure:"grpc"`
+
+	// Telemetry defines the configuration of the application metrics
+	Telemetry telemetry.Config `mapstructure:"telemetry"`
+
+	// Streaming defines the configuration of the state streaming service
+	Streaming StreamingConfig `mapstructure:"streaming"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -75,12 +145,36 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 // DefaultConfig returns server's default configuration.
 func DefaultConfig() *Config {
 	return &Config{
-		BaseConfig{
-			MinGasPrices:         defaultMinGasPrices,
-			InterBlockCache:      true,
-			Pruning:              store.PruningStrategySyncable,
-			PruningKeepEvery:     "0",
-			PruningSnapshotEvery: "0",
+		BaseConfig: BaseConfig{
+			MinGasPrices:      defaultMinGasPrices,
+			InterBlockCache:   true,
+			Pruning:           storetypes.PruningOptionDefault,
+			PruningKeepRecent: "0",
+			PruningKeepEvery:  "0",
+			PruningInterval:   "0",
+		},
+		API: APIConfig{
+			Enable:             false,
+			Swagger:            false,
+			Address:            "tcp://0.0.0.0:1317",
+			MaxOpenConnections: 1000,
+			RPCReadTimeout:     10,
+			RPCWriteTimeout:    0,
+			RPCMaxBodyBytes:    1000000,
+		},
+		GRPC: GRPCConfig{
+			Enable:  true,
+			Address: "0.0.0.0:9090",
+		},
+		Telemetry: telemetry.Config{
+			Enabled:           false,
+			PrometheusEnabled: false,
+			GlobalLabels:      [][]string{},
+		},
+		Streaming: StreamingConfig{
+			Service:      "",
+			Keys:         []string{"*"},
+			FileWriteDir: "data/streaming",
 		},
 	}
 }
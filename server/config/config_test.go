@@ -1,8 +1,12 @@
 package config
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,6 +15,10 @@ import (
 func TestDefaultConfig(t *testing.T) {
 	cfg := DefaultConfig()
 	require.True(t, cfg.GetMinGasPrices().IsZero())
+	require.False(t, cfg.API.Enable)
+	require.Equal(t, "tcp://0.0.0.0:1317", cfg.API.Address)
+	require.True(t, cfg.GRPC.Enable)
+	require.Equal(t, "0.0.0.0:9090", cfg.GRPC.Address)
 }
 
 func TestSetMinimumFees(t *testing.T) {
@@ -18,3 +26,52 @@ func TestSetMinimumFees(t *testing.T) {
 	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("foo", 5)})
 	require.Equal(t, "5.000000000000000000foo", cfg.MinGasPrices)
 }
+
+func TestTelemetryConfigFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	defer viper.Reset()
+
+	cfg := DefaultConfig()
+	require.False(t, cfg.Telemetry.Enabled)
+
+	cfg.Telemetry.Enabled = true
+	cfg.Telemetry.ServiceName = "simd"
+	cfg.Telemetry.GlobalLabels = [][]string{{"chain_id", "test-chain"}, {"region", "eu"}}
+
+	path := filepath.Join(dir, "app.toml")
+	WriteConfigFile(path, cfg)
+
+	viper.SetConfigFile(path)
+	require.NoError(t, viper.ReadInConfig())
+
+	parsed, err := ParseConfig()
+	require.NoError(t, err)
+	require.Equal(t, cfg.Telemetry, parsed.Telemetry)
+}
+
+func TestStreamingConfigFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	defer viper.Reset()
+
+	cfg := DefaultConfig()
+	require.Empty(t, cfg.Streaming.Service)
+	require.Equal(t, []string{"*"}, cfg.Streaming.Keys)
+
+	cfg.Streaming.Service = "file"
+	cfg.Streaming.Keys = []string{"bank", "staking"}
+	cfg.Streaming.FileWriteDir = "/var/lib/simd/streaming"
+
+	path := filepath.Join(dir, "app.toml")
+	WriteConfigFile(path, cfg)
+
+	viper.SetConfigFile(path)
+	require.NoError(t, viper.ReadInConfig())
+
+	parsed, err := ParseConfig()
+	require.NoError(t, err)
+	require.Equal(t, cfg.Streaming, parsed.Streaming)
+}
@@ -34,16 +34,116 @@ halt-time = {{ .BaseConfig.HaltTime }}
 # InterBlockCache enables inter-block caching.
 inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 
-# Pruning sets the pruning strategy: syncable, nothing, everything, custom
-# syncable: only those states not needed for state syncing will be deleted (keeps last 100 + every 10000th)
+# SigVerifyWorkers defines the number of goroutines used to verify tx
+# signatures in CheckTx. Values lower than two verify them sequentially.
+sig-verify-workers = {{ .BaseConfig.SigVerifyWorkers }}
+
+# Pruning sets the pruning strategy: default, nothing, everything, custom
+# default: the last 362880 states are kept in addition to every 100th state; pruning at 10 block intervals
 # nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
-# everything: all saved states will be deleted, storing only the current state
-# custom: allows fine-grained control through the pruning-keep-every and pruning-snapshot-every options.
+# everything: all saved states will be deleted, storing only the current state; pruning at 10 block intervals
+# custom: allow pruning options to be manually specified through 'pruning-keep-recent', 'pruning-keep-every', and 'pruning-interval'
+# syncable: deprecated, same as default
 pruning = "{{ .BaseConfig.Pruning }}"
 
 # These are applied if and only if the pruning strategy is custom.
+pruning-keep-recent = "{{ .BaseConfig.PruningKeepRecent }}"
 pruning-keep-every = "{{ .BaseConfig.PruningKeepEvery }}"
-pruning-snapshot-every = "{{ .BaseConfig.PruningSnapshotEvery }}"
+pruning-interval = "{{ .BaseConfig.PruningInterval }}"
+
+###############################################################################
+###                         Telemetry Configuration                         ###
+###############################################################################
+
+[telemetry]
+
+# ServiceName is the prefix of all the metric names.
+service-name = "{{ .Telemetry.ServiceName }}"
+
+# Enabled defines if the application collects metrics. The metrics are kept in
+# memory and served by the API server, if enabled, at /metrics.
+enabled = {{ .Telemetry.Enabled }}
+
+# EnableHostnameLabel defines if all the metrics are labeled with the hostname
+# of the node.
+enable-hostname-label = {{ .Telemetry.EnableHostnameLabel }}
+
+# PrometheusEnabled defines if the metrics can be gathered in the Prometheus
+# exposition format, at /metrics?format=prometheus.
+prometheus-enabled = {{ .Telemetry.PrometheusEnabled }}
+
+# GlobalLabels defines the name and value pairs of the labels attached to all
+# the metrics.
+#
+# Example:
+# [["chain_id", "cosmoshub-1"]]
+global-labels = [{{ range $k, $v := .Telemetry.GlobalLabels }}
+  ["{{index $v 0 }}", "{{ index $v 1}}"],{{ end }}
+]
+
+###############################################################################
+###                           API Configuration                             ###
+###############################################################################
+
+[api]
+
+# Enable defines if the API server should be enabled.
+enable = {{ .API.Enable }}
+
+# Swagger defines if swagger documentation should automatically be registered.
+swagger = {{ .API.Swagger }}
+
+# Address defines the API server to listen on.
+address = "{{ .API.Address }}"
+
+# MaxOpenConnections defines the number of maximum open connections.
+max-open-connections = {{ .API.MaxOpenConnections }}
+
+# RPCReadTimeout defines the Tendermint RPC read timeout (in seconds).
+rpc-read-timeout = {{ .API.RPCReadTimeout }}
+
+# RPCWriteTimeout defines the Tendermint RPC write timeout (in seconds).
+rpc-write-timeout = {{ .API.RPCWriteTimeout }}
+
+# RPCMaxBodyBytes defines the Tendermint maximum response body (in bytes).
+rpc-max-body-bytes = {{ .API.RPCMaxBodyBytes }}
+
+# EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk).
+enabled-unsafe-cors = {{ .API.EnableUnsafeCORS }}
+
+###############################################################################
+###                           gRPC Configuration                            ###
+###############################################################################
+
+[grpc]
+
+# Enable defines if the gRPC server should be enabled.
+enable = {{ .GRPC.Enable }}
+
+# Address defines the gRPC server address to bind to.
+address = "{{ .GRPC.Address }}"
+
+###############################################################################
+###                        Streaming Configuration                          ###
+###############################################################################
+
+[streaming]
+
+# Service defines the streaming service used to stream the ABCI messages and
+# state changes of every block to external consumers such as indexers:
+# "": streaming is disabled
+# file: every block is written to a block-{height} file in file-write-dir
+#
+# Only the file streaming service is available; there is no gRPC sink.
+service = "{{ .Streaming.Service }}"
+
+# Keys defines the names of the KVStores whose state changes are streamed.
+# Use "*" to stream every KVStore.
+keys = [{{ range .Streaming.Keys }}"{{ . }}", {{ end }}]
+
+# FileWriteDir defines the directory the file streaming service writes the
+# block files to. A relative path is resolved against the node's home.
+file-write-dir = "{{ .Streaming.FileWriteDir }}"
 `
 
 var configTemplate *template.Template
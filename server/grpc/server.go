@@ -0,0 +1,58 @@
+package grpc
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// Registrar is implemented by applications that can register their gRPC
+// services, such as the module query services, with a gRPC server.
+type Registrar interface {
+	RegisterGRPCServer(gogogrpc.Server)
+}
+
+// TxServiceRegistrar is implemented by applications that serve the tx gRPC
+// Service, which broadcasts and queries transactions through the node. Unlike
+// the module query services, the tx Service is registered with the gRPC
+// server only, so that it cannot be reached through ABCI Query.
+type TxServiceRegistrar interface {
+	RegisterTxService(gogogrpc.Server, client.Context)
+}
+
+// StartGRPCServer starts a gRPC server on the given address serving all the
+// services registered by the application, and the tx Service if the
+// application registers it. Note, the server is started in a separate
+// goroutine.
+func StartGRPCServer(app Registrar, clientCtx client.Context, address string) (*grpc.Server, error) {
+	grpcSrv := grpc.NewServer()
+	app.RegisterGRPCServer(grpcSrv)
+
+	if registrar, ok := app.(TxServiceRegistrar); ok {
+		registrar.RegisterTxService(grpcSrv, clientCtx)
+	}
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		if err := grpcSrv.Serve(listener); err != nil {
+			errCh <- fmt.Errorf("failed to serve: %w", err)
+		}
+	}()
+
+	select {
+	case err := <-errCh:
+		return nil, err
+	case <-time.After(5 * time.Second): // assume server started successfully
+		return grpcSrv, nil
+	}
+}
@@ -0,0 +1,64 @@
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestGRPCServer(t *testing.T) {
+	app := simapp.Setup(false)
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	app.Commit()
+
+	grpcSrv := grpc.NewServer()
+	app.RegisterGRPCServer(grpcSrv)
+
+	listener := bufconn.Listen(1024 * 1024)
+	go func() { _ = grpcSrv.Serve(listener) }()
+	defer grpcSrv.Stop()
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	queryClient := banktypes.NewQueryClient(conn)
+
+	// query the latest state
+	var header metadata.MD
+	res, err := queryClient.Balance(context.Background(), &banktypes.QueryBalanceRequest{Address: addr, Denom: sdk.DefaultBondDenom}, grpc.Header(&header))
+	require.NoError(t, err)
+	require.True(t, res.Balance.IsZero())
+	require.Equal(t, []string{"1"}, header.Get(baseapp.GRPCBlockHeightHeader))
+
+	// query an explicit height
+	ctx := metadata.AppendToOutgoingContext(context.Background(), baseapp.GRPCBlockHeightHeader, "1")
+	_, err = queryClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: addr, Denom: sdk.DefaultBondDenom})
+	require.NoError(t, err)
+
+	// invalid and unknown heights are rejected
+	ctx = metadata.AppendToOutgoingContext(context.Background(), baseapp.GRPCBlockHeightHeader, "foo")
+	_, err = queryClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: addr, Denom: sdk.DefaultBondDenom})
+	require.Error(t, err)
+
+	ctx = metadata.AppendToOutgoingContext(context.Background(), baseapp.GRPCBlockHeightHeader, "100")
+	_, err = queryClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: addr, Denom: sdk.DefaultBondDenom})
+	require.Error(t, err)
+}
@@ -83,6 +83,10 @@ func (ms multiStore) LoadVersion(ver int64) error {
 	panic("not implemented")
 }
 
+func (ms multiStore) RollbackToVersion(version int64) error {
+	panic("not implemented")
+}
+
 func (ms multiStore) GetKVStore(key sdk.StoreKey) sdk.KVStore {
 	return ms.kv[key]
 }
@@ -99,6 +103,14 @@ func (ms multiStore) SetInterBlockCache(_ sdk.MultiStorePersistentCache) {
 	panic("not implemented")
 }
 
+func (ms multiStore) AddListeners(_ sdk.StoreKey, _ []sdk.WriteListener) {
+	panic("not implemented")
+}
+
+func (ms multiStore) ListeningEnabled(_ sdk.StoreKey) bool {
+	panic("not implemented")
+}
+
 var _ sdk.KVStore = kvStore{}
 
 type kvStore struct {
@@ -2,29 +2,41 @@ package server
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/types"
 )
 
-// GetPruningOptionsFromFlags parses start command flags and returns the correct PruningOptions.
-// flagPruning prevails over flagPruningKeepEvery and flagPruningSnapshotEvery.
-// Default option is PruneSyncable.
+// GetPruningOptionsFromFlags parses command flags and returns the correct
+// PruningOptions. If a pruning strategy is provided, that will be parsed and
+// returned, otherwise, it is assumed custom pruning options are provided. The
+// deprecated "syncable" strategy is mapped to the default one.
 func GetPruningOptionsFromFlags() (store.PruningOptions, error) {
-	strategy := viper.GetString(flagPruning)
+	strategy := strings.ToLower(viper.GetString(flagPruning))
+
 	switch strategy {
-	case "syncable", "nothing", "everything":
-		return store.NewPruningOptionsFromString(viper.GetString(flagPruning)), nil
+	case types.PruningOptionDefault, types.PruningOptionNothing, types.PruningOptionEverything:
+		return types.NewPruningOptionsFromString(strategy), nil
 
-	case "custom":
-		opts := store.PruningOptions{
-			KeepEvery:     viper.GetInt64(flagPruningKeepEvery),
-			SnapshotEvery: viper.GetInt64(flagPruningSnapshotEvery),
-		}
-		if !opts.IsValid() {
-			return opts, fmt.Errorf("invalid granular options")
+	case types.PruningOptionSyncable:
+		// the syncable strategy was removed, keep existing configurations
+		// working with the closest remaining one
+		return types.PruneDefault, nil
+
+	case types.PruningOptionCustom:
+		opts := types.NewPruningOptions(
+			viper.GetUint64(flagPruningKeepRecent),
+			viper.GetUint64(flagPruningKeepEvery),
+			viper.GetUint64(flagPruningInterval),
+		)
+
+		if err := opts.Validate(); err != nil {
+			return opts, fmt.Errorf("invalid custom pruning options: %w", err)
 		}
+
 		return opts, nil
 
 	default:
@@ -6,39 +6,53 @@ import (
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
-	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/types"
 )
 
 func TestGetPruningOptionsFromFlags(t *testing.T) {
 	tests := []struct {
 		name            string
 		initParams      func()
-		expectedOptions store.PruningOptions
+		expectedOptions types.PruningOptions
 		wantErr         bool
 	}{
 		{
 			name: "pruning",
 			initParams: func() {
-				viper.Set(flagPruning, store.PruningStrategyNothing)
+				viper.Set(flagPruning, types.PruningOptionNothing)
 			},
-			expectedOptions: store.PruneNothing,
+			expectedOptions: types.PruneNothing,
 		},
 		{
 			name: "granular pruning",
 			initParams: func() {
-				viper.Set(flagPruning, "custom")
-				viper.Set(flagPruningSnapshotEvery, 1234)
+				viper.Set(flagPruning, types.PruningOptionCustom)
+				viper.Set(flagPruningKeepRecent, 1234)
 				viper.Set(flagPruningKeepEvery, 4321)
+				viper.Set(flagPruningInterval, 10)
 			},
-			expectedOptions: store.PruningOptions{
-				SnapshotEvery: 1234,
-				KeepEvery:     4321,
+			expectedOptions: types.NewPruningOptions(1234, 4321, 10),
+		},
+		{
+			name: "invalid granular pruning",
+			initParams: func() {
+				viper.Set(flagPruning, types.PruningOptionCustom)
+				viper.Set(flagPruningKeepRecent, 1234)
+				viper.Set(flagPruningKeepEvery, 4321)
+			},
+			wantErr: true,
+		},
+		{
+			name: "deprecated syncable pruning",
+			initParams: func() {
+				viper.Set(flagPruning, types.PruningOptionSyncable)
 			},
+			expectedOptions: types.PruneDefault,
 		},
 		{
 			name:            "default",
 			initParams:      func() {},
-			expectedOptions: store.PruneSyncable,
+			expectedOptions: types.PruneDefault,
 		},
 	}
 
@@ -46,7 +60,7 @@ func TestGetPruningOptionsFromFlags(t *testing.T) {
 		tt := tt
 		t.Run(tt.name, func(j *testing.T) {
 			viper.Reset()
-			viper.SetDefault(flagPruning, "syncable")
+			viper.SetDefault(flagPruning, types.PruningOptionDefault)
 			tt.initParams()
 			opts, err := GetPruningOptionsFromFlags()
 			if tt.wantErr {
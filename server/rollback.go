@@ -0,0 +1,162 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/node"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RollbackCmd creates a command to roll back the Tendermint and application
+// state by one height.
+func RollbackCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rollback",
+		Short: "Rollback Cosmos SDK and Tendermint state by one height",
+		Long: `A state rollback is performed to recover from an incorrect application state transition,
+when Tendermint has persisted an incorrect app hash and is thus unable to make
+progress. Rollback overwrites a state at height n with the state at height n - 1.
+The application also rolls back to height n - 1. No blocks are removed, so upon
+restarting Tendermint the transactions in block n will be re-executed against the
+application.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(flags.FlagHome))
+
+			db, err := openDB(config.RootDir)
+			if err != nil {
+				return err
+			}
+
+			app, ok := appCreator(ctx.Logger, db, nil).(interface {
+				CommitMultiStore() sdk.CommitMultiStore
+			})
+			if !ok {
+				return errors.New("the application does not expose its multi-store and cannot be rolled back")
+			}
+
+			// rollback tendermint state
+			height, hash, err := rollbackState(config)
+			if err != nil {
+				return fmt.Errorf("failed to rollback tendermint state: %w", err)
+			}
+
+			// rollback the multistore
+			if err := app.CommitMultiStore().RollbackToVersion(height); err != nil {
+				return fmt.Errorf("failed to rollback to version: %w", err)
+			}
+
+			fmt.Printf("Rolled back state to height %d and hash %X\n", height, hash)
+			return nil
+		},
+	}
+}
+
+// rollbackState overwrites the Tendermint state at height n with the state at
+// height n - 1, and returns the resulting height and app hash. Blocks are kept,
+// so that block n is replayed against the application on restart. If the state
+// is one height behind the block store, i.e. block n was saved but never
+// applied, the state is left untouched.
+func rollbackState(config *tmcfg.Config) (int64, []byte, error) {
+	blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		return 0, nil, err
+	}
+	defer blockStoreDB.Close()
+
+	stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: config})
+	if err != nil {
+		return 0, nil, err
+	}
+	defer stateDB.Close()
+
+	blockStore := store.NewBlockStore(blockStoreDB)
+
+	invalidState := sm.LoadState(stateDB)
+	if invalidState.IsEmpty() {
+		return 0, nil, errors.New("no state found")
+	}
+
+	height := blockStore.Height()
+
+	// the block at height n was saved but the state was not updated yet, so
+	// only the application has to be rolled back
+	if height == invalidState.LastBlockHeight+1 {
+		return invalidState.LastBlockHeight, invalidState.AppHash, nil
+	}
+
+	if height != invalidState.LastBlockHeight {
+		return 0, nil, fmt.Errorf(
+			"statestore height (%d) is not one below or equal to blockstore height (%d)",
+			invalidState.LastBlockHeight, height,
+		)
+	}
+
+	rollbackHeight := invalidState.LastBlockHeight - 1
+	rollbackBlock := blockStore.LoadBlockMeta(rollbackHeight)
+	if rollbackBlock == nil {
+		return 0, nil, fmt.Errorf("block at height %d not found", rollbackHeight)
+	}
+
+	// the app hash and last results hash of a block are only agreed upon in
+	// the following block
+	latestBlock := blockStore.LoadBlockMeta(invalidState.LastBlockHeight)
+	if latestBlock == nil {
+		return 0, nil, fmt.Errorf("block at height %d not found", invalidState.LastBlockHeight)
+	}
+
+	previousLastValidatorSet, err := sm.LoadValidators(stateDB, rollbackHeight)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	previousParams, err := sm.LoadConsensusParams(stateDB, rollbackHeight+1)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	valChangeHeight := invalidState.LastHeightValidatorsChanged
+	// this can only happen if the validator set changed since the last block
+	if valChangeHeight > rollbackHeight {
+		valChangeHeight = rollbackHeight + 1
+	}
+
+	paramsChangeHeight := invalidState.LastHeightConsensusParamsChanged
+	// this can only happen if params changed from the last block
+	if paramsChangeHeight > rollbackHeight {
+		paramsChangeHeight = rollbackHeight + 1
+	}
+
+	rolledBackState := sm.State{
+		Version: invalidState.Version,
+		ChainID: invalidState.ChainID,
+
+		LastBlockHeight: rollbackBlock.Header.Height,
+		LastBlockID:     rollbackBlock.BlockID,
+		LastBlockTime:   rollbackBlock.Header.Time,
+
+		NextValidators:              invalidState.Validators,
+		Validators:                  invalidState.LastValidators,
+		LastValidators:              previousLastValidatorSet,
+		LastHeightValidatorsChanged: valChangeHeight,
+
+		ConsensusParams:                  previousParams,
+		LastHeightConsensusParamsChanged: paramsChangeHeight,
+
+		LastResultsHash: latestBlock.Header.LastResultsHash,
+		AppHash:         latestBlock.Header.AppHash,
+	}
+
+	sm.SaveState(stateDB, rolledBackState)
+
+	return rolledBackState.LastBlockHeight, rolledBackState.AppHash, nil
+}
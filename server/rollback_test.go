@@ -0,0 +1,85 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/node"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestRollbackState(t *testing.T) {
+	cfg, cleanup := testTendermintConfig(t)
+	defer cleanup()
+
+	// the databases must persist across the rollback
+	cfg.DBBackend = string(dbm.GoLevelDBBackend)
+
+	_, _, err := rollbackState(cfg)
+	require.Error(t, err, "no state to roll back")
+
+	valSet, _ := tmtypes.RandValidatorSet(1, 10)
+	genVals := []tmtypes.GenesisValidator{{PubKey: valSet.Validators[0].PubKey, Power: 10}}
+
+	state, err := sm.MakeGenesisState(&tmtypes.GenesisDoc{
+		ChainID:     "test-chain",
+		GenesisTime: time.Now(),
+		Validators:  genVals,
+	})
+	require.NoError(t, err)
+
+	blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: cfg})
+	require.NoError(t, err)
+	stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: cfg})
+	require.NoError(t, err)
+
+	// commit 3 blocks, the app hash of block h being the state after h-1
+	blockStore := store.NewBlockStore(blockStoreDB)
+	sm.SaveState(stateDB, state)
+	states := make([]sm.State, 4)
+	states[0] = state
+
+	for h := int64(1); h <= 3; h++ {
+		block, parts := state.MakeBlock(h, nil, &tmtypes.Commit{Height: h - 1}, nil, state.Validators.Proposer.Address)
+		blockStore.SaveBlock(block, parts, &tmtypes.Commit{Height: h})
+
+		state = state.Copy()
+		state.LastBlockHeight = h
+		state.LastBlockID = tmtypes.BlockID{Hash: block.Hash(), PartsHeader: parts.Header()}
+		state.LastBlockTime = block.Time
+		state.LastValidators = state.Validators.Copy()
+		state.AppHash = []byte{byte(h)}
+		sm.SaveState(stateDB, state)
+
+		states[h] = state
+	}
+
+	require.NoError(t, blockStoreDB.Close())
+	require.NoError(t, stateDB.Close())
+
+	height, hash, err := rollbackState(cfg)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), height)
+	require.Equal(t, states[2].AppHash, hash)
+
+	stateDB, err = node.DefaultDBProvider(&node.DBContext{ID: "state", Config: cfg})
+	require.NoError(t, err)
+
+	rolledBack := sm.LoadState(stateDB)
+	require.Equal(t, states[2].LastBlockHeight, rolledBack.LastBlockHeight)
+	require.Equal(t, states[2].LastBlockID, rolledBack.LastBlockID)
+	require.Equal(t, states[2].AppHash, rolledBack.AppHash)
+	require.Equal(t, states[2].Validators.Hash(), rolledBack.Validators.Hash())
+	require.NoError(t, stateDB.Close())
+
+	// the state is now one height behind the block store, only the
+	// application has to be rolled back
+	height, hash, err = rollbackState(cfg)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), height)
+	require.Equal(t, states[2].AppHash, hash)
+}
@@ -0,0 +1,342 @@
+package rosetta
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// unsignedTx is the unsigned transaction returned by /construction/payloads.
+// It carries the signer data required to compute the sign bytes again when
+// the signatures are combined.
+type unsignedTx struct {
+	Tx            authtypes.StdTx `json:"tx"`
+	ChainID       string          `json:"chain_id"`
+	AccountNumber uint64          `json:"account_number"`
+	Sequence      uint64          `json:"sequence"`
+}
+
+func (tx unsignedTx) signBytes() []byte {
+	return authtypes.StdSignBytes(tx.ChainID, tx.AccountNumber, tx.Sequence, tx.Tx.TimeoutHeight, tx.Tx.Fee, tx.Tx.Msgs, tx.Tx.Memo)
+}
+
+func (s *Server) constructionDerive(r *http.Request) (interface{}, *Error) {
+	var req ConstructionDeriveRequest
+	if err := decodeRequest(r, &req); err != nil {
+		return nil, err
+	}
+	if err := s.validateNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	pubKey, rosettaErr := parsePublicKey(req.PublicKey)
+	if rosettaErr != nil {
+		return nil, rosettaErr
+	}
+
+	return ConstructionDeriveResponse{Address: sdk.AccAddress(pubKey.Address()).String()}, nil
+}
+
+func (s *Server) constructionPreprocess(r *http.Request) (interface{}, *Error) {
+	var req ConstructionPreprocessRequest
+	if err := decodeRequest(r, &req); err != nil {
+		return nil, err
+	}
+	if err := s.validateNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	_, signer, rosettaErr := operationsToMsg(req.Operations)
+	if rosettaErr != nil {
+		return nil, rosettaErr
+	}
+
+	if req.Metadata.Fee != "" {
+		if _, err := sdk.ParseCoins(req.Metadata.Fee); err != nil {
+			return nil, wrapError(ErrInvalidRequest, err)
+		}
+	}
+
+	options := ConstructionMetadata{
+		Signer: signer.String(),
+		Gas:    req.Metadata.Gas,
+		Fee:    req.Metadata.Fee,
+		Memo:   req.Metadata.Memo,
+	}
+	if options.Gas == 0 {
+		options.Gas = flags.DefaultGasLimit
+	}
+
+	return ConstructionPreprocessResponse{
+		Options:            options,
+		RequiredPublicKeys: []AccountIdentifier{{Address: options.Signer}},
+	}, nil
+}
+
+func (s *Server) constructionMetadata(r *http.Request) (interface{}, *Error) {
+	var req ConstructionMetadataRequest
+	if err := decodeRequest(r, &req); err != nil {
+		return nil, err
+	}
+	if err := s.validateNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Options.Signer)
+	if err != nil {
+		return nil, wrapError(ErrInvalidAddress, err)
+	}
+
+	bz, err := s.query(
+		fmt.Sprintf("custom/%s/%s", authtypes.QuerierRoute, authtypes.QueryAccount),
+		authtypes.NewQueryAccountParams(addr),
+		0,
+	)
+	if err != nil {
+		return nil, wrapError(ErrAccountNotFound, err)
+	}
+
+	var acc authtypes.AccountI
+	if err := s.cdc.UnmarshalJSON(bz, &acc); err != nil {
+		return nil, wrapError(ErrInternal, err)
+	}
+
+	metadata := req.Options
+	metadata.ChainID = s.network.Network
+	metadata.AccountNumber = acc.GetAccountNumber()
+	metadata.Sequence = acc.GetSequence()
+
+	return ConstructionMetadataResponse{Metadata: metadata}, nil
+}
+
+func (s *Server) constructionPayloads(r *http.Request) (interface{}, *Error) {
+	var req ConstructionPayloadsRequest
+	if err := decodeRequest(r, &req); err != nil {
+		return nil, err
+	}
+	if err := s.validateNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	msg, signer, rosettaErr := operationsToMsg(req.Operations)
+	if rosettaErr != nil {
+		return nil, rosettaErr
+	}
+
+	if req.Metadata.ChainID != s.network.Network {
+		return nil, wrapError(ErrInvalidRequest, fmt.Errorf("metadata chain ID %q does not match the network", req.Metadata.ChainID))
+	}
+
+	fee, err := sdk.ParseCoins(req.Metadata.Fee)
+	if err != nil {
+		return nil, wrapError(ErrInvalidRequest, err)
+	}
+
+	tx := unsignedTx{
+		Tx:            authtypes.NewStdTx([]sdk.Msg{msg}, authtypes.NewStdFee(req.Metadata.Gas, fee), nil, req.Metadata.Memo),
+		ChainID:       req.Metadata.ChainID,
+		AccountNumber: req.Metadata.AccountNumber,
+		Sequence:      req.Metadata.Sequence,
+	}
+
+	bz, err := s.cdc.MarshalJSON(tx)
+	if err != nil {
+		return nil, wrapError(ErrInternal, err)
+	}
+
+	digest := sha256.Sum256(tx.signBytes())
+	return ConstructionPayloadsResponse{
+		UnsignedTransaction: hex.EncodeToString(bz),
+		Payloads: []SigningPayload{{
+			Address:       signer.String(),
+			HexBytes:      hex.EncodeToString(digest[:]),
+			SignatureType: SignatureEcdsa,
+		}},
+	}, nil
+}
+
+func (s *Server) constructionCombine(r *http.Request) (interface{}, *Error) {
+	var req ConstructionCombineRequest
+	if err := decodeRequest(r, &req); err != nil {
+		return nil, err
+	}
+	if err := s.validateNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	tx, rosettaErr := s.decodeUnsignedTx(req.UnsignedTransaction)
+	if rosettaErr != nil {
+		return nil, rosettaErr
+	}
+
+	if len(req.Signatures) != 1 {
+		return nil, wrapError(ErrInvalidSignature, fmt.Errorf("expected 1 signature, got %d", len(req.Signatures)))
+	}
+
+	sig := req.Signatures[0]
+	pubKey, rosettaErr := parsePublicKey(sig.PublicKey)
+	if rosettaErr != nil {
+		return nil, rosettaErr
+	}
+
+	if !tx.Tx.FeePayer().Equals(sdk.AccAddress(pubKey.Address())) {
+		return nil, wrapError(ErrInvalidSignature, fmt.Errorf("public key does not belong to the signer %s", tx.Tx.FeePayer()))
+	}
+
+	sigBytes, err := hex.DecodeString(sig.HexBytes)
+	if err != nil {
+		return nil, wrapError(ErrInvalidSignature, err)
+	}
+
+	if sig.SignatureType != SignatureEcdsa || !pubKey.VerifyBytes(tx.signBytes(), sigBytes) {
+		return nil, wrapError(ErrInvalidSignature, fmt.Errorf("signature verification failed"))
+	}
+
+	tx.Tx.Signatures = []authtypes.StdSignature{{PubKey: pubKey.Bytes(), Signature: sigBytes}}
+
+	bz, err := s.cdc.MarshalBinaryBare(tx.Tx)
+	if err != nil {
+		return nil, wrapError(ErrInternal, err)
+	}
+
+	return ConstructionCombineResponse{SignedTransaction: hex.EncodeToString(bz)}, nil
+}
+
+func (s *Server) constructionParse(r *http.Request) (interface{}, *Error) {
+	var req ConstructionParseRequest
+	if err := decodeRequest(r, &req); err != nil {
+		return nil, err
+	}
+	if err := s.validateNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	if !req.Signed {
+		tx, rosettaErr := s.decodeUnsignedTx(req.Transaction)
+		if rosettaErr != nil {
+			return nil, rosettaErr
+		}
+
+		return ConstructionParseResponse{Operations: txOperations(tx.Tx, "")}, nil
+	}
+
+	tx, rosettaErr := s.decodeSignedTx(req.Transaction)
+	if rosettaErr != nil {
+		return nil, rosettaErr
+	}
+
+	signers := make([]AccountIdentifier, len(tx.GetSigners()))
+	for i, signer := range tx.GetSigners() {
+		signers[i] = AccountIdentifier{Address: signer.String()}
+	}
+
+	return ConstructionParseResponse{
+		Operations:               txOperations(tx, ""),
+		AccountIdentifierSigners: signers,
+	}, nil
+}
+
+func (s *Server) constructionHash(r *http.Request) (interface{}, *Error) {
+	var req ConstructionHashRequest
+	if err := decodeRequest(r, &req); err != nil {
+		return nil, err
+	}
+	if err := s.validateNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	bz, err := hex.DecodeString(req.SignedTransaction)
+	if err != nil {
+		return nil, wrapError(ErrInvalidTransaction, err)
+	}
+
+	return TransactionIdentifierResponse{TransactionIdentifier: TransactionIdentifier{Hash: txHash(bz)}}, nil
+}
+
+func (s *Server) constructionSubmit(r *http.Request) (interface{}, *Error) {
+	var req ConstructionSubmitRequest
+	if err := decodeRequest(r, &req); err != nil {
+		return nil, err
+	}
+	if err := s.validateNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	bz, err := hex.DecodeString(req.SignedTransaction)
+	if err != nil {
+		return nil, wrapError(ErrInvalidTransaction, err)
+	}
+
+	if _, rosettaErr := s.decodeTx(bz); rosettaErr != nil {
+		return nil, rosettaErr
+	}
+
+	res, err := s.client.BroadcastTxSync(bz)
+	if err != nil {
+		return nil, wrapError(ErrNodeUnavailable, err)
+	}
+
+	if res.Code != 0 {
+		return nil, wrapError(ErrTransactionRejected, fmt.Errorf("%s", res.Log))
+	}
+
+	return TransactionIdentifierResponse{TransactionIdentifier: TransactionIdentifier{Hash: txHash(bz)}}, nil
+}
+
+// decodeUnsignedTx decodes a hex encoded unsignedTx.
+func (s *Server) decodeUnsignedTx(text string) (unsignedTx, *Error) {
+	var tx unsignedTx
+
+	bz, err := hex.DecodeString(text)
+	if err != nil {
+		return tx, wrapError(ErrInvalidTransaction, err)
+	}
+
+	if err := s.cdc.UnmarshalJSON(bz, &tx); err != nil {
+		return tx, wrapError(ErrInvalidTransaction, err)
+	}
+
+	if len(tx.Tx.Msgs) == 0 {
+		return tx, wrapError(ErrInvalidTransaction, fmt.Errorf("transaction has no messages"))
+	}
+
+	return tx, nil
+}
+
+// decodeSignedTx decodes a hex encoded amino StdTx.
+func (s *Server) decodeSignedTx(text string) (authtypes.StdTx, *Error) {
+	bz, err := hex.DecodeString(text)
+	if err != nil {
+		return authtypes.StdTx{}, wrapError(ErrInvalidTransaction, err)
+	}
+
+	return s.decodeTx(bz)
+}
+
+// parsePublicKey decodes a compressed secp256k1 public key.
+func parsePublicKey(pk PublicKey) (secp256k1.PubKeySecp256k1, *Error) {
+	var pubKey secp256k1.PubKeySecp256k1
+
+	if pk.CurveType != CurveSecp256k1 {
+		return pubKey, wrapError(ErrUnsupportedCurve, fmt.Errorf("%s", pk.CurveType))
+	}
+
+	bz, err := hex.DecodeString(pk.HexBytes)
+	if err != nil {
+		return pubKey, wrapError(ErrInvalidRequest, err)
+	}
+
+	if len(bz) != secp256k1.PubKeySecp256k1Size {
+		return pubKey, wrapError(ErrInvalidRequest, fmt.Errorf("invalid public key length %d", len(bz)))
+	}
+
+	copy(pubKey[:], bz)
+	return pubKey, nil
+}
@@ -0,0 +1,357 @@
+package rosetta
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (s *Server) networkList(r *http.Request) (interface{}, *Error) {
+	var req MetadataRequest
+	if err := decodeRequest(r, &req); err != nil {
+		return nil, err
+	}
+
+	return NetworkListResponse{NetworkIdentifiers: []NetworkIdentifier{s.network}}, nil
+}
+
+func (s *Server) networkOptions(r *http.Request) (interface{}, *Error) {
+	var req NetworkRequest
+	if err := decodeRequest(r, &req); err != nil {
+		return nil, err
+	}
+	if err := s.validateNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	status, err := s.client.Status()
+	if err != nil {
+		return nil, wrapError(ErrNodeUnavailable, err)
+	}
+
+	return NetworkOptionsResponse{
+		Version: VersionInfo{
+			RosettaVersion:    Version,
+			NodeVersion:       status.NodeInfo.Version,
+			MiddlewareVersion: version.Version,
+		},
+		Allow: Allow{
+			OperationStatuses: []OperationStatus{
+				{Status: StatusSuccess, Successful: true},
+				{Status: StatusReverted, Successful: false},
+			},
+			OperationTypes:          []string{OperationTransfer, OperationFee},
+			Errors:                  allErrors,
+			HistoricalBalanceLookup: true,
+		},
+	}, nil
+}
+
+func (s *Server) networkStatus(r *http.Request) (interface{}, *Error) {
+	var req NetworkRequest
+	if err := decodeRequest(r, &req); err != nil {
+		return nil, err
+	}
+	if err := s.validateNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	status, err := s.client.Status()
+	if err != nil {
+		return nil, wrapError(ErrNodeUnavailable, err)
+	}
+
+	info := status.SyncInfo
+	return NetworkStatusResponse{
+		CurrentBlockIdentifier: BlockIdentifier{Index: info.LatestBlockHeight, Hash: info.LatestBlockHash.String()},
+		CurrentBlockTimestamp:  timestamp(info.LatestBlockTime),
+		GenesisBlockIdentifier: BlockIdentifier{Index: info.EarliestBlockHeight, Hash: info.EarliestBlockHash.String()},
+		Peers:                  []Peer{},
+	}, nil
+}
+
+func (s *Server) block(r *http.Request) (interface{}, *Error) {
+	var req BlockRequest
+	if err := decodeRequest(r, &req); err != nil {
+		return nil, err
+	}
+	if err := s.validateNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	block, results, rosettaErr := s.getBlock(req.BlockIdentifier)
+	if rosettaErr != nil {
+		return nil, rosettaErr
+	}
+
+	txs := make([]Transaction, 0, len(block.Block.Txs))
+	for i, txBytes := range block.Block.Txs {
+		tx, rosettaErr := s.blockTransactionAt(txBytes, results.TxsResults[i])
+		if rosettaErr != nil {
+			return nil, rosettaErr
+		}
+
+		txs = append(txs, tx)
+	}
+
+	header := block.Block.Header
+	blockID := BlockIdentifier{Index: header.Height, Hash: block.BlockID.Hash.String()}
+
+	// the genesis block is its own parent
+	parentID := blockID
+	if header.LastBlockID.Hash.String() != "" {
+		parentID = BlockIdentifier{Index: header.Height - 1, Hash: header.LastBlockID.Hash.String()}
+	}
+
+	return BlockResponse{
+		Block: Block{
+			BlockIdentifier:       blockID,
+			ParentBlockIdentifier: parentID,
+			Timestamp:             timestamp(header.Time),
+			Transactions:          txs,
+		},
+	}, nil
+}
+
+func (s *Server) blockTransaction(r *http.Request) (interface{}, *Error) {
+	var req BlockTransactionRequest
+	if err := decodeRequest(r, &req); err != nil {
+		return nil, err
+	}
+	if err := s.validateNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	index, hash := req.BlockIdentifier.Index, req.BlockIdentifier.Hash
+	block, results, rosettaErr := s.getBlock(PartialBlockIdentifier{Index: &index, Hash: &hash})
+	if rosettaErr != nil {
+		return nil, rosettaErr
+	}
+
+	for i, txBytes := range block.Block.Txs {
+		if txHash(txBytes) != strings.ToUpper(req.TransactionIdentifier.Hash) {
+			continue
+		}
+
+		tx, rosettaErr := s.blockTransactionAt(txBytes, results.TxsResults[i])
+		if rosettaErr != nil {
+			return nil, rosettaErr
+		}
+
+		return BlockTransactionResponse{Transaction: tx}, nil
+	}
+
+	return nil, wrapError(ErrTransactionNotFound, fmt.Errorf("%s", req.TransactionIdentifier.Hash))
+}
+
+func (s *Server) accountBalance(r *http.Request) (interface{}, *Error) {
+	var req AccountBalanceRequest
+	if err := decodeRequest(r, &req); err != nil {
+		return nil, err
+	}
+	if err := s.validateNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.AccountIdentifier.Address)
+	if err != nil {
+		return nil, wrapError(ErrInvalidAddress, err)
+	}
+
+	// the balance is queried at the height of the requested block, or the
+	// latest block, so that the returned block identifier matches it
+	partialID := PartialBlockIdentifier{}
+	if req.BlockIdentifier != nil {
+		partialID = *req.BlockIdentifier
+	}
+
+	block, _, rosettaErr := s.getBlock(partialID)
+	if rosettaErr != nil {
+		return nil, rosettaErr
+	}
+
+	height := block.Block.Header.Height
+	bz, err := s.query(
+		fmt.Sprintf("custom/%s/%s", banktypes.QuerierRoute, banktypes.QueryAllBalances),
+		banktypes.NewQueryAllBalancesRequest(addr, nil),
+		height,
+	)
+	if err != nil {
+		return nil, wrapError(ErrInternal, err)
+	}
+
+	var coins sdk.Coins
+	if err := s.cdc.UnmarshalJSON(bz, &coins); err != nil {
+		return nil, wrapError(ErrInternal, err)
+	}
+
+	balances := make([]Amount, len(coins))
+	for i, coin := range coins {
+		balances[i] = Amount{Value: coin.Amount.String(), Currency: Currency{Symbol: coin.Denom}}
+	}
+
+	return AccountBalanceResponse{
+		BlockIdentifier: BlockIdentifier{Index: height, Hash: block.BlockID.Hash.String()},
+		Balances:        balances,
+	}, nil
+}
+
+func (s *Server) mempool(r *http.Request) (interface{}, *Error) {
+	var req NetworkRequest
+	if err := decodeRequest(r, &req); err != nil {
+		return nil, err
+	}
+	if err := s.validateNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	res, err := s.client.UnconfirmedTxs(mempoolLimit)
+	if err != nil {
+		return nil, wrapError(ErrNodeUnavailable, err)
+	}
+
+	ids := make([]TransactionIdentifier, len(res.Txs))
+	for i, txBytes := range res.Txs {
+		ids[i] = TransactionIdentifier{Hash: txHash(txBytes)}
+	}
+
+	return MempoolResponse{TransactionIdentifiers: ids}, nil
+}
+
+func (s *Server) mempoolTransaction(r *http.Request) (interface{}, *Error) {
+	var req MempoolTransactionRequest
+	if err := decodeRequest(r, &req); err != nil {
+		return nil, err
+	}
+	if err := s.validateNetwork(req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	res, err := s.client.UnconfirmedTxs(mempoolLimit)
+	if err != nil {
+		return nil, wrapError(ErrNodeUnavailable, err)
+	}
+
+	for _, txBytes := range res.Txs {
+		hash := txHash(txBytes)
+		if hash != strings.ToUpper(req.TransactionIdentifier.Hash) {
+			continue
+		}
+
+		tx, rosettaErr := s.decodeTx(txBytes)
+		if rosettaErr != nil {
+			return nil, rosettaErr
+		}
+
+		return MempoolTransactionResponse{
+			Transaction: Transaction{
+				TransactionIdentifier: TransactionIdentifier{Hash: hash},
+				Operations:            txOperations(tx, ""),
+			},
+		}, nil
+	}
+
+	return nil, wrapError(ErrTransactionNotFound, fmt.Errorf("%s", req.TransactionIdentifier.Hash))
+}
+
+// getBlock returns the block and block results identified by id. The latest
+// block is returned if id is empty. Blocks can only be looked up by index; a
+// hash is checked against the block found at the index.
+func (s *Server) getBlock(id PartialBlockIdentifier) (*ctypes.ResultBlock, *ctypes.ResultBlockResults, *Error) {
+	if id.Index == nil && id.Hash != nil {
+		return nil, nil, wrapError(ErrInvalidRequest, fmt.Errorf("blocks can only be looked up by index"))
+	}
+
+	block, err := s.client.Block(id.Index)
+	if err != nil {
+		return nil, nil, wrapError(ErrBlockNotFound, err)
+	}
+
+	if id.Hash != nil && !strings.EqualFold(block.BlockID.Hash.String(), *id.Hash) {
+		return nil, nil, wrapError(ErrBlockNotFound, fmt.Errorf("no block with hash %s at height %d", *id.Hash, *id.Index))
+	}
+
+	height := block.Block.Header.Height
+	results, err := s.client.BlockResults(&height)
+	if err != nil {
+		return nil, nil, wrapError(ErrBlockNotFound, err)
+	}
+
+	if len(results.TxsResults) != len(block.Block.Txs) {
+		return nil, nil, wrapError(ErrInternal, fmt.Errorf("block %d has %d transactions and %d results", height, len(block.Block.Txs), len(results.TxsResults)))
+	}
+
+	return block, results, nil
+}
+
+// blockTransactionAt returns the transaction of txBytes. Its operations are
+// successful if the transaction was successfully delivered, reverted
+// otherwise.
+func (s *Server) blockTransactionAt(txBytes tmtypes.Tx, result *abci.ResponseDeliverTx) (Transaction, *Error) {
+	tx, rosettaErr := s.decodeTx(txBytes)
+	if rosettaErr != nil {
+		return Transaction{}, rosettaErr
+	}
+
+	status := StatusSuccess
+	if !result.IsOK() {
+		status = StatusReverted
+	}
+
+	return Transaction{
+		TransactionIdentifier: TransactionIdentifier{Hash: txHash(txBytes)},
+		Operations:            txOperations(tx, status),
+	}, nil
+}
+
+// decodeTx decodes an amino encoded StdTx.
+func (s *Server) decodeTx(txBytes []byte) (authtypes.StdTx, *Error) {
+	var tx authtypes.StdTx
+	if err := s.cdc.UnmarshalBinaryBare(txBytes, &tx); err != nil {
+		return tx, wrapError(ErrInvalidTransaction, err)
+	}
+
+	return tx, nil
+}
+
+// query performs an ABCI query of the JSON encoded params at the given height.
+func (s *Server) query(path string, params interface{}, height int64) ([]byte, error) {
+	data, err := s.cdc.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.client.ABCIQueryWithOptions(path, data, rpcclient.ABCIQueryOptions{Height: height})
+	if err != nil {
+		return nil, err
+	}
+
+	if !res.Response.IsOK() {
+		return nil, fmt.Errorf("query %s failed: %s", path, res.Response.Log)
+	}
+
+	return res.Response.Value, nil
+}
+
+// txHash returns the upper case hex encoded hash of a transaction, as reported
+// by Tendermint.
+func txHash(txBytes tmtypes.Tx) string {
+	return strings.ToUpper(hex.EncodeToString(txBytes.Hash()))
+}
+
+// timestamp returns t in milliseconds since the Unix epoch.
+func timestamp(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
@@ -0,0 +1,52 @@
+package rosetta
+
+// Error is the error object returned by all endpoints on failure.
+type Error struct {
+	Code      int32                  `json:"code"`
+	Message   string                 `json:"message"`
+	Retriable bool                   `json:"retriable"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+}
+
+// Errors returned by the Rosetta endpoints. They are listed by
+// /network/options, as required by the specification.
+var (
+	ErrInternal             = &Error{Code: 1, Message: "internal error"}
+	ErrNetworkNotSupported  = &Error{Code: 2, Message: "network not supported"}
+	ErrInvalidRequest       = &Error{Code: 3, Message: "invalid request"}
+	ErrNodeUnavailable      = &Error{Code: 4, Message: "node unavailable", Retriable: true}
+	ErrBlockNotFound        = &Error{Code: 5, Message: "block not found"}
+	ErrTransactionNotFound  = &Error{Code: 6, Message: "transaction not found"}
+	ErrInvalidAddress       = &Error{Code: 7, Message: "invalid address"}
+	ErrUnsupportedCurve     = &Error{Code: 8, Message: "unsupported curve type"}
+	ErrInvalidOperations    = &Error{Code: 9, Message: "invalid operations"}
+	ErrInvalidTransaction   = &Error{Code: 10, Message: "invalid transaction"}
+	ErrInvalidSignature     = &Error{Code: 11, Message: "invalid signature"}
+	ErrAccountNotFound      = &Error{Code: 12, Message: "account not found"}
+	ErrTransactionRejected  = &Error{Code: 13, Message: "transaction rejected"}
+	ErrUnsupportedOperation = &Error{Code: 14, Message: "unsupported operation"}
+)
+
+var allErrors = []*Error{
+	ErrInternal,
+	ErrNetworkNotSupported,
+	ErrInvalidRequest,
+	ErrNodeUnavailable,
+	ErrBlockNotFound,
+	ErrTransactionNotFound,
+	ErrInvalidAddress,
+	ErrUnsupportedCurve,
+	ErrInvalidOperations,
+	ErrInvalidTransaction,
+	ErrInvalidSignature,
+	ErrAccountNotFound,
+	ErrTransactionRejected,
+	ErrUnsupportedOperation,
+}
+
+// wrapError returns a copy of base which reports err in its details.
+func wrapError(base *Error, err error) *Error {
+	wrapped := *base
+	wrapped.Details = map[string]interface{}{"error": err.Error()}
+	return &wrapped
+}
@@ -0,0 +1,168 @@
+package rosetta
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// operationsBuilder appends operations with consecutive indexes.
+type operationsBuilder struct {
+	status     string
+	operations []Operation
+}
+
+func (b *operationsBuilder) add(opType string, addr sdk.AccAddress, amount sdk.Int, denom string, related []OperationIdentifier) OperationIdentifier {
+	id := OperationIdentifier{Index: int64(len(b.operations))}
+
+	b.operations = append(b.operations, Operation{
+		OperationIdentifier: id,
+		RelatedOperations:   related,
+		Type:                opType,
+		Status:              b.status,
+		Account:             &AccountIdentifier{Address: addr.String()},
+		Amount: &Amount{
+			Value:    amount.String(),
+			Currency: Currency{Symbol: denom},
+		},
+	})
+
+	return id
+}
+
+// addTransfer appends the debits of the inputs followed by the credits of the
+// outputs. Every credit is related to the debits of the same denomination.
+func (b *operationsBuilder) addTransfer(opType string, inputs []banktypes.Input, outputs []banktypes.Output) {
+	debits := make(map[string][]OperationIdentifier)
+	for _, in := range inputs {
+		for _, coin := range in.Coins {
+			id := b.add(opType, in.Address, coin.Amount.Neg(), coin.Denom, nil)
+			debits[coin.Denom] = append(debits[coin.Denom], id)
+		}
+	}
+
+	for _, out := range outputs {
+		for _, coin := range out.Coins {
+			b.add(opType, out.Address, coin.Amount, coin.Denom, debits[coin.Denom])
+		}
+	}
+}
+
+// txOperations returns the operations of a transaction: the transfers of its
+// bank messages and the payment of its fee. Messages of other modules are not
+// described by any operation. The status is left empty for transactions which
+// are not included in a block.
+func txOperations(tx authtypes.StdTx, status string) []Operation {
+	b := &operationsBuilder{status: status, operations: []Operation{}}
+
+	for _, msg := range tx.Msgs {
+		switch msg := msg.(type) {
+		case *banktypes.MsgSend:
+			b.addTransfer(
+				OperationTransfer,
+				[]banktypes.Input{banktypes.NewInput(msg.FromAddress, msg.Amount)},
+				[]banktypes.Output{banktypes.NewOutput(msg.ToAddress, msg.Amount)},
+			)
+
+		case *banktypes.MsgMultiSend:
+			b.addTransfer(OperationTransfer, msg.Inputs, msg.Outputs)
+		}
+	}
+
+	if !tx.Fee.Amount.Empty() && len(tx.GetSigners()) > 0 {
+		b.addTransfer(
+			OperationFee,
+			[]banktypes.Input{banktypes.NewInput(tx.FeePayer(), tx.Fee.Amount)},
+			[]banktypes.Output{banktypes.NewOutput(authtypes.NewModuleAddress(authtypes.FeeCollectorName), tx.Fee.Amount)},
+		)
+	}
+
+	return b.operations
+}
+
+// operationsToMsg returns the bank message performing the transfer operations
+// and its signer. All the debits must be made by the same account. A single
+// credit is sent with MsgSend, several credits with MsgMultiSend.
+func operationsToMsg(ops []Operation) (sdk.Msg, sdk.AccAddress, *Error) {
+	var (
+		signer            sdk.AccAddress
+		debited, credited sdk.Coins
+		outputs           []banktypes.Output
+	)
+
+	for _, op := range ops {
+		if op.Type != OperationTransfer {
+			return nil, nil, wrapError(ErrUnsupportedOperation, fmt.Errorf("operation type %q", op.Type))
+		}
+
+		if op.Account == nil || op.Amount == nil {
+			return nil, nil, wrapError(ErrInvalidOperations, fmt.Errorf("operation %d has no account or amount", op.OperationIdentifier.Index))
+		}
+
+		addr, err := sdk.AccAddressFromBech32(op.Account.Address)
+		if err != nil {
+			return nil, nil, wrapError(ErrInvalidAddress, err)
+		}
+
+		coin, negative, err := parseAmount(*op.Amount)
+		if err != nil {
+			return nil, nil, wrapError(ErrInvalidOperations, err)
+		}
+
+		if negative {
+			if signer != nil && !signer.Equals(addr) {
+				return nil, nil, wrapError(ErrInvalidOperations, fmt.Errorf("debits from several accounts: %s, %s", signer, addr))
+			}
+
+			signer = addr
+			debited = debited.Add(coin)
+			continue
+		}
+
+		credited = credited.Add(coin)
+		outputs = append(outputs, banktypes.NewOutput(addr, sdk.NewCoins(coin)))
+	}
+
+	if signer == nil || len(outputs) == 0 {
+		return nil, nil, wrapError(ErrInvalidOperations, fmt.Errorf("transfers require at least one debit and one credit"))
+	}
+
+	if !debited.IsAllGTE(credited) || !credited.IsAllGTE(debited) {
+		return nil, nil, wrapError(ErrInvalidOperations, fmt.Errorf("debits %s do not match credits %s", debited, credited))
+	}
+
+	var msg sdk.Msg
+	if len(outputs) == 1 {
+		msg = banktypes.NewMsgSend(signer, outputs[0].Address, outputs[0].Coins)
+	} else {
+		msg = banktypes.NewMsgMultiSend([]banktypes.Input{banktypes.NewInput(signer, debited)}, outputs)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, nil, wrapError(ErrInvalidOperations, err)
+	}
+
+	return msg, signer, nil
+}
+
+// parseAmount returns the coin of a non zero amount and whether the amount is
+// negative.
+func parseAmount(amount Amount) (sdk.Coin, bool, error) {
+	value, ok := sdk.NewIntFromString(amount.Value)
+	if !ok || value.IsZero() {
+		return sdk.Coin{}, false, fmt.Errorf("invalid amount %q", amount.Value)
+	}
+
+	if err := sdk.ValidateDenom(amount.Currency.Symbol); err != nil {
+		return sdk.Coin{}, false, err
+	}
+
+	negative := value.IsNegative()
+	if negative {
+		value = value.Neg()
+	}
+
+	return sdk.NewCoin(amount.Currency.Symbol, value), negative, nil
+}
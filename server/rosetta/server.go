@@ -0,0 +1,201 @@
+// Package rosetta implements the Rosetta Data and Construction APIs
+// (https://www.rosetta-api.org) on top of a node's RPC, query and broadcast
+// services, so that exchanges and other integrators can track balances and
+// submit transfers without running a custom indexer.
+//
+// Transactions are described by "transfer" operations, derived from bank send
+// messages, and "fee" operations, paid by the first signer to the fee
+// collector. Coin movements which are not caused by transactions, such as the
+// block rewards, are not reported as operations.
+package rosetta
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmrpcserver "github.com/tendermint/tendermint/rpc/jsonrpc/server"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+const (
+	flagBlockchain = "blockchain"
+
+	// mempoolLimit is the maximum number of unconfirmed transactions returned
+	// by /mempool.
+	mempoolLimit = 100
+)
+
+// Client defines the node services used by the Rosetta server. It is
+// satisfied by any Tendermint RPC client.
+type Client interface {
+	Status() (*ctypes.ResultStatus, error)
+	Block(height *int64) (*ctypes.ResultBlock, error)
+	BlockResults(height *int64) (*ctypes.ResultBlockResults, error)
+	UnconfirmedTxs(limit int) (*ctypes.ResultUnconfirmedTxs, error)
+	BroadcastTxSync(tx tmtypes.Tx) (*ctypes.ResultBroadcastTx, error)
+	ABCIQueryWithOptions(path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error)
+}
+
+var _ Client = rpcclient.Client(nil)
+
+// Server serves the Rosetta APIs of a single network.
+type Server struct {
+	Router *mux.Router
+
+	client  Client
+	cdc     *codec.Codec
+	network NetworkIdentifier
+}
+
+// NewServer creates a Rosetta server for the given network. The codec must
+// have the transaction, account and message types of the application
+// registered.
+func NewServer(client Client, cdc *codec.Codec, network NetworkIdentifier) *Server {
+	s := &Server{
+		Router:  mux.NewRouter(),
+		client:  client,
+		cdc:     cdc,
+		network: network,
+	}
+
+	s.registerRoutes()
+
+	return s
+}
+
+func (s *Server) registerRoutes() {
+	routes := map[string]func(*http.Request) (interface{}, *Error){
+		"/network/list":            s.networkList,
+		"/network/options":         s.networkOptions,
+		"/network/status":          s.networkStatus,
+		"/block":                   s.block,
+		"/block/transaction":       s.blockTransaction,
+		"/account/balance":         s.accountBalance,
+		"/mempool":                 s.mempool,
+		"/mempool/transaction":     s.mempoolTransaction,
+		"/construction/derive":     s.constructionDerive,
+		"/construction/preprocess": s.constructionPreprocess,
+		"/construction/metadata":   s.constructionMetadata,
+		"/construction/payloads":   s.constructionPayloads,
+		"/construction/combine":    s.constructionCombine,
+		"/construction/parse":      s.constructionParse,
+		"/construction/hash":       s.constructionHash,
+		"/construction/submit":     s.constructionSubmit,
+	}
+
+	for path, fn := range routes {
+		s.Router.HandleFunc(path, handler(fn)).Methods(http.MethodPost)
+	}
+}
+
+// handler writes the JSON encoded response of fn. Errors are written with
+// the 500 status code, as required by the specification.
+func handler(fn func(*http.Request) (interface{}, *Error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, rosettaErr := fn(r)
+
+		w.Header().Set("Content-Type", "application/json")
+		if rosettaErr != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_ = json.NewEncoder(w).Encode(rosettaErr)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(res)
+	}
+}
+
+// decodeRequest decodes the JSON body of r into req.
+func decodeRequest(r *http.Request, req interface{}) *Error {
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		return wrapError(ErrInvalidRequest, err)
+	}
+
+	return nil
+}
+
+// validateNetwork returns an error if network is not the network served by s.
+func (s *Server) validateNetwork(network NetworkIdentifier) *Error {
+	if network != s.network {
+		return wrapError(ErrNetworkNotSupported, fmt.Errorf("%s/%s", network.Blockchain, network.Network))
+	}
+
+	return nil
+}
+
+// ServeCommand returns the command starting the Rosetta server as a blocking
+// process. The network is named after the --blockchain flag and the chain ID
+// of the node.
+func ServeCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rosetta",
+		Short: "Start the Rosetta Data and Construction API server",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			node, err := rpchttp.New(viper.GetString(flags.FlagNode), "/websocket")
+			if err != nil {
+				return err
+			}
+
+			chainID := viper.GetString(flags.FlagChainID)
+			if chainID == "" {
+				status, err := node.Status()
+				if err != nil {
+					return err
+				}
+
+				chainID = status.NodeInfo.Network
+			}
+
+			network := NetworkIdentifier{
+				Blockchain: viper.GetString(flagBlockchain),
+				Network:    chainID,
+			}
+			s := NewServer(node, cdc, network)
+
+			cfg := tmrpcserver.DefaultConfig()
+			cfg.MaxOpenConnections = viper.GetInt(flags.FlagMaxOpenConnections)
+			cfg.ReadTimeout = time.Duration(viper.GetInt64(flags.FlagRPCReadTimeout)) * time.Second
+			cfg.WriteTimeout = time.Duration(viper.GetInt64(flags.FlagRPCWriteTimeout)) * time.Second
+			cfg.MaxBodyBytes = viper.GetInt64(flags.FlagRPCMaxBodyBytes)
+
+			listener, err := tmrpcserver.Listen(viper.GetString(flags.FlagListenAddr), cfg)
+			if err != nil {
+				return err
+			}
+
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "rosetta")
+			server.TrapSignal(func() {
+				err := listener.Close()
+				logger.Error("error closing listener", "err", err)
+			})
+
+			logger.Info(fmt.Sprintf("Starting Rosetta service (network: %s/%s)...", network.Blockchain, network.Network))
+			return tmrpcserver.Serve(listener, s.Router, logger, cfg)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain")
+	cmd.Flags().String(flagBlockchain, "app", "The blockchain name reported in the network identifier")
+	cmd.Flags().String(flags.FlagListenAddr, "tcp://localhost:8080", "The address for the server to listen on")
+	cmd.Flags().Uint(flags.FlagMaxOpenConnections, 1000, "The number of maximum open connections")
+	cmd.Flags().Uint(flags.FlagRPCReadTimeout, 10, "The RPC read timeout (in seconds)")
+	cmd.Flags().Uint(flags.FlagRPCWriteTimeout, 10, "The RPC write timeout (in seconds)")
+	cmd.Flags().Uint(flags.FlagRPCMaxBodyBytes, 1000000, "The RPC max body bytes")
+
+	return cmd
+}
@@ -0,0 +1,368 @@
+package rosetta_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/server/rosetta"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var network = rosetta.NetworkIdentifier{Blockchain: "app", Network: "test-chain"}
+
+// mockClient serves a single block and a fixed account.
+type mockClient struct {
+	block      *ctypes.ResultBlock
+	results    *ctypes.ResultBlockResults
+	mempool    []tmtypes.Tx
+	queries    map[string][]byte
+	broadcasts []tmtypes.Tx
+}
+
+func (c *mockClient) Status() (*ctypes.ResultStatus, error) {
+	return &ctypes.ResultStatus{
+		SyncInfo: ctypes.SyncInfo{
+			LatestBlockHash:     c.block.BlockID.Hash,
+			LatestBlockHeight:   c.block.Block.Height,
+			LatestBlockTime:     c.block.Block.Time,
+			EarliestBlockHash:   c.block.BlockID.Hash,
+			EarliestBlockHeight: c.block.Block.Height,
+		},
+	}, nil
+}
+
+func (c *mockClient) Block(height *int64) (*ctypes.ResultBlock, error) {
+	if height != nil && *height != c.block.Block.Height {
+		return nil, errors.New("block not found")
+	}
+	return c.block, nil
+}
+
+func (c *mockClient) BlockResults(height *int64) (*ctypes.ResultBlockResults, error) {
+	return c.results, nil
+}
+
+func (c *mockClient) UnconfirmedTxs(int) (*ctypes.ResultUnconfirmedTxs, error) {
+	return &ctypes.ResultUnconfirmedTxs{Txs: c.mempool}, nil
+}
+
+func (c *mockClient) BroadcastTxSync(tx tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	c.broadcasts = append(c.broadcasts, tx)
+	return &ctypes.ResultBroadcastTx{Hash: tx.Hash()}, nil
+}
+
+func (c *mockClient) ABCIQueryWithOptions(path string, _ tmbytes.HexBytes, _ rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
+	bz, ok := c.queries[path]
+	if !ok {
+		return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{Code: 1, Log: "unknown"}}, nil
+	}
+	return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: bz}}, nil
+}
+
+func makeCodec() *codec.Codec {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	cryptocodec.RegisterCrypto(cdc)
+	authtypes.RegisterCodec(cdc)
+	banktypes.RegisterCodec(cdc)
+	return cdc
+}
+
+func post(t *testing.T, s *rosetta.Server, path string, req, res interface{}) *rosetta.Error {
+	bz, err := json.Marshal(req)
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	s.Router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, bytes.NewReader(bz)))
+
+	if rec.Code != http.StatusOK {
+		var rosettaErr rosetta.Error
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &rosettaErr))
+		return &rosettaErr
+	}
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), res))
+	return nil
+}
+
+func setup(t *testing.T) (*rosetta.Server, *mockClient, *codec.Codec) {
+	cdc := makeCodec()
+	client := &mockClient{
+		block: &ctypes.ResultBlock{
+			BlockID: tmtypes.BlockID{Hash: tmbytes.HexBytes{0xab, 0xcd}},
+			Block: &tmtypes.Block{
+				Header: tmtypes.Header{
+					Height:      10,
+					Time:        time.Unix(1600000000, 0),
+					LastBlockID: tmtypes.BlockID{Hash: tmbytes.HexBytes{0x12, 0x34}},
+				},
+			},
+		},
+		results: &ctypes.ResultBlockResults{Height: 10},
+		queries: make(map[string][]byte),
+	}
+
+	return rosetta.NewServer(client, cdc, network), client, cdc
+}
+
+func TestNetwork(t *testing.T) {
+	s, _, _ := setup(t)
+
+	var list rosetta.NetworkListResponse
+	require.Nil(t, post(t, s, "/network/list", rosetta.MetadataRequest{}, &list))
+	require.Equal(t, []rosetta.NetworkIdentifier{network}, list.NetworkIdentifiers)
+
+	var options rosetta.NetworkOptionsResponse
+	require.Nil(t, post(t, s, "/network/options", rosetta.NetworkRequest{NetworkIdentifier: network}, &options))
+	require.Equal(t, rosetta.Version, options.Version.RosettaVersion)
+	require.Equal(t, []string{rosetta.OperationTransfer, rosetta.OperationFee}, options.Allow.OperationTypes)
+	require.NotEmpty(t, options.Allow.Errors)
+
+	var status rosetta.NetworkStatusResponse
+	require.Nil(t, post(t, s, "/network/status", rosetta.NetworkRequest{NetworkIdentifier: network}, &status))
+	require.Equal(t, rosetta.BlockIdentifier{Index: 10, Hash: "ABCD"}, status.CurrentBlockIdentifier)
+	require.Equal(t, int64(1600000000000), status.CurrentBlockTimestamp)
+
+	other := rosetta.NetworkIdentifier{Blockchain: "app", Network: "other-chain"}
+	rosettaErr := post(t, s, "/network/status", rosetta.NetworkRequest{NetworkIdentifier: other}, &status)
+	require.NotNil(t, rosettaErr)
+	require.Equal(t, rosetta.ErrNetworkNotSupported.Code, rosettaErr.Code)
+}
+
+func TestAccountBalance(t *testing.T) {
+	s, client, cdc := setup(t)
+
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	client.queries["custom/bank/all_balances"] = cdc.MustMarshalJSON(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+
+	var res rosetta.AccountBalanceResponse
+	req := rosetta.AccountBalanceRequest{
+		NetworkIdentifier: network,
+		AccountIdentifier: rosetta.AccountIdentifier{Address: addr.String()},
+	}
+	require.Nil(t, post(t, s, "/account/balance", req, &res))
+	require.Equal(t, rosetta.BlockIdentifier{Index: 10, Hash: "ABCD"}, res.BlockIdentifier)
+	require.Equal(t, []rosetta.Amount{{Value: "100", Currency: rosetta.Currency{Symbol: "stake"}}}, res.Balances)
+
+	height := int64(11)
+	req.BlockIdentifier = &rosetta.PartialBlockIdentifier{Index: &height}
+	rosettaErr := post(t, s, "/account/balance", req, &res)
+	require.NotNil(t, rosettaErr)
+	require.Equal(t, rosetta.ErrBlockNotFound.Code, rosettaErr.Code)
+
+	req.BlockIdentifier = nil
+	req.AccountIdentifier.Address = "invalid"
+	rosettaErr = post(t, s, "/account/balance", req, &res)
+	require.NotNil(t, rosettaErr)
+	require.Equal(t, rosetta.ErrInvalidAddress.Code, rosettaErr.Code)
+}
+
+func TestConstructionAndBlock(t *testing.T) {
+	s, client, cdc := setup(t)
+
+	privKey := secp256k1.GenPrivKey()
+	pubKeyBytes := privKey.PubKey().(secp256k1.PubKeySecp256k1)
+	pubKey := rosetta.PublicKey{
+		HexBytes:  hex.EncodeToString(pubKeyBytes[:]),
+		CurveType: rosetta.CurveSecp256k1,
+	}
+
+	var derived rosetta.ConstructionDeriveResponse
+	require.Nil(t, post(t, s, "/construction/derive", rosetta.ConstructionDeriveRequest{NetworkIdentifier: network, PublicKey: pubKey}, &derived))
+	from := sdk.AccAddress(privKey.PubKey().Address())
+	require.Equal(t, from.String(), derived.Address)
+
+	to := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	ops := []rosetta.Operation{
+		{
+			OperationIdentifier: rosetta.OperationIdentifier{Index: 0},
+			Type:                rosetta.OperationTransfer,
+			Account:             &rosetta.AccountIdentifier{Address: from.String()},
+			Amount:              &rosetta.Amount{Value: "-10", Currency: rosetta.Currency{Symbol: "stake"}},
+		},
+		{
+			OperationIdentifier: rosetta.OperationIdentifier{Index: 1},
+			RelatedOperations:   []rosetta.OperationIdentifier{{Index: 0}},
+			Type:                rosetta.OperationTransfer,
+			Account:             &rosetta.AccountIdentifier{Address: to.String()},
+			Amount:              &rosetta.Amount{Value: "10", Currency: rosetta.Currency{Symbol: "stake"}},
+		},
+	}
+
+	var preprocess rosetta.ConstructionPreprocessResponse
+	require.Nil(t, post(t, s, "/construction/preprocess", rosetta.ConstructionPreprocessRequest{
+		NetworkIdentifier: network,
+		Operations:        ops,
+		Metadata:          rosetta.ConstructionMetadata{Fee: "1stake"},
+	}, &preprocess))
+	require.Equal(t, from.String(), preprocess.Options.Signer)
+	require.Equal(t, []rosetta.AccountIdentifier{{Address: from.String()}}, preprocess.RequiredPublicKeys)
+
+	// the metadata of unknown accounts cannot be fetched
+	var metadata rosetta.ConstructionMetadataResponse
+	rosettaErr := post(t, s, "/construction/metadata", rosetta.ConstructionMetadataRequest{NetworkIdentifier: network, Options: preprocess.Options}, &metadata)
+	require.NotNil(t, rosettaErr)
+	require.Equal(t, rosetta.ErrAccountNotFound.Code, rosettaErr.Code)
+
+	var acc authtypes.AccountI = authtypes.NewBaseAccount(from, nil, 3, 7)
+	client.queries["custom/auth/account"] = cdc.MustMarshalJSON(acc)
+	require.Nil(t, post(t, s, "/construction/metadata", rosetta.ConstructionMetadataRequest{NetworkIdentifier: network, Options: preprocess.Options}, &metadata))
+	require.Equal(t, network.Network, metadata.Metadata.ChainID)
+	require.Equal(t, uint64(3), metadata.Metadata.AccountNumber)
+	require.Equal(t, uint64(7), metadata.Metadata.Sequence)
+
+	var payloads rosetta.ConstructionPayloadsResponse
+	require.Nil(t, post(t, s, "/construction/payloads", rosetta.ConstructionPayloadsRequest{
+		NetworkIdentifier: network,
+		Operations:        ops,
+		Metadata:          metadata.Metadata,
+		PublicKeys:        []rosetta.PublicKey{pubKey},
+	}, &payloads))
+	require.Len(t, payloads.Payloads, 1)
+
+	var parsed rosetta.ConstructionParseResponse
+	require.Nil(t, post(t, s, "/construction/parse", rosetta.ConstructionParseRequest{NetworkIdentifier: network, Transaction: payloads.UnsignedTransaction}, &parsed))
+	require.Equal(t, ops, parsed.Operations[:2])
+	require.Empty(t, parsed.AccountIdentifierSigners)
+
+	// sign the payload as an external signer would
+	digest, err := hex.DecodeString(payloads.Payloads[0].HexBytes)
+	require.NoError(t, err)
+	signer, _ := btcec.PrivKeyFromBytes(btcec.S256(), privKey[:])
+	sig, err := signer.Sign(digest)
+	require.NoError(t, err)
+	sigBytes := make([]byte, 64)
+	copy(sigBytes[32-len(sig.R.Bytes()):32], sig.R.Bytes())
+	copy(sigBytes[64-len(sig.S.Bytes()):], sig.S.Bytes())
+
+	signature := rosetta.Signature{
+		SigningPayload: payloads.Payloads[0],
+		PublicKey:      pubKey,
+		SignatureType:  rosetta.SignatureEcdsa,
+		HexBytes:       hex.EncodeToString(sigBytes),
+	}
+
+	var combined rosetta.ConstructionCombineResponse
+	require.Nil(t, post(t, s, "/construction/combine", rosetta.ConstructionCombineRequest{
+		NetworkIdentifier:   network,
+		UnsignedTransaction: payloads.UnsignedTransaction,
+		Signatures:          []rosetta.Signature{signature},
+	}, &combined))
+
+	// signatures over other bytes are rejected
+	badSignature := signature
+	badSignature.HexBytes = hex.EncodeToString(make([]byte, 64))
+	rosettaErr = post(t, s, "/construction/combine", rosetta.ConstructionCombineRequest{
+		NetworkIdentifier:   network,
+		UnsignedTransaction: payloads.UnsignedTransaction,
+		Signatures:          []rosetta.Signature{badSignature},
+	}, &combined)
+	require.NotNil(t, rosettaErr)
+	require.Equal(t, rosetta.ErrInvalidSignature.Code, rosettaErr.Code)
+
+	require.Nil(t, post(t, s, "/construction/parse", rosetta.ConstructionParseRequest{NetworkIdentifier: network, Signed: true, Transaction: combined.SignedTransaction}, &parsed))
+	require.Equal(t, []rosetta.AccountIdentifier{{Address: from.String()}}, parsed.AccountIdentifierSigners)
+
+	var hash rosetta.TransactionIdentifierResponse
+	require.Nil(t, post(t, s, "/construction/hash", rosetta.ConstructionHashRequest{NetworkIdentifier: network, SignedTransaction: combined.SignedTransaction}, &hash))
+
+	var submitted rosetta.TransactionIdentifierResponse
+	require.Nil(t, post(t, s, "/construction/submit", rosetta.ConstructionSubmitRequest{NetworkIdentifier: network, SignedTransaction: combined.SignedTransaction}, &submitted))
+	require.Equal(t, hash, submitted)
+	require.Len(t, client.broadcasts, 1)
+
+	// the submitted transaction is a valid StdTx signed by the sender
+	var tx authtypes.StdTx
+	require.NoError(t, cdc.UnmarshalBinaryBare(client.broadcasts[0], &tx))
+	signBytes := authtypes.StdSignBytes(network.Network, 3, 7, 0, tx.Fee, tx.Msgs, tx.Memo)
+	require.True(t, privKey.PubKey().VerifyBytes(signBytes, tx.Signatures[0].Signature))
+
+	// the transaction shows up in the mempool, then in a block
+	client.mempool = []tmtypes.Tx{client.broadcasts[0]}
+	var mempool rosetta.MempoolResponse
+	require.Nil(t, post(t, s, "/mempool", rosetta.NetworkRequest{NetworkIdentifier: network}, &mempool))
+	require.Equal(t, []rosetta.TransactionIdentifier{hash.TransactionIdentifier}, mempool.TransactionIdentifiers)
+
+	client.block.Block.Txs = tmtypes.Txs{client.broadcasts[0]}
+	client.results.TxsResults = []*abci.ResponseDeliverTx{{Code: 0}}
+
+	var block rosetta.BlockResponse
+	require.Nil(t, post(t, s, "/block", rosetta.BlockRequest{NetworkIdentifier: network}, &block))
+	require.Equal(t, rosetta.BlockIdentifier{Index: 9, Hash: "1234"}, block.Block.ParentBlockIdentifier)
+	require.Len(t, block.Block.Transactions, 1)
+
+	txOps := block.Block.Transactions[0].Operations
+	require.Len(t, txOps, 4)
+	for i, op := range txOps {
+		require.Equal(t, int64(i), op.OperationIdentifier.Index)
+		require.Equal(t, rosetta.StatusSuccess, op.Status)
+	}
+	require.Equal(t, rosetta.OperationFee, txOps[2].Type)
+	require.Equal(t, "-1", txOps[2].Amount.Value)
+	require.Equal(t, authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(), txOps[3].Account.Address)
+
+	// failed transactions revert their operations
+	client.results.TxsResults[0].Code = 5
+	var blockTx rosetta.BlockTransactionResponse
+	require.Nil(t, post(t, s, "/block/transaction", rosetta.BlockTransactionRequest{
+		NetworkIdentifier:     network,
+		BlockIdentifier:       rosetta.BlockIdentifier{Index: 10, Hash: "abcd"},
+		TransactionIdentifier: hash.TransactionIdentifier,
+	}, &blockTx))
+	require.Equal(t, rosetta.StatusReverted, blockTx.Transaction.Operations[0].Status)
+}
+
+func TestConstructionInvalidOperations(t *testing.T) {
+	s, _, _ := setup(t)
+
+	from := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	to := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	op := func(opType string, addr sdk.AccAddress, value string) rosetta.Operation {
+		return rosetta.Operation{
+			Type:    opType,
+			Account: &rosetta.AccountIdentifier{Address: addr.String()},
+			Amount:  &rosetta.Amount{Value: value, Currency: rosetta.Currency{Symbol: "stake"}},
+		}
+	}
+
+	testCases := []struct {
+		name string
+		ops  []rosetta.Operation
+		code int32
+	}{
+		{"unsupported type", []rosetta.Operation{op("stake", from, "-1"), op("stake", to, "1")}, rosetta.ErrUnsupportedOperation.Code},
+		{"no credit", []rosetta.Operation{op(rosetta.OperationTransfer, from, "-1")}, rosetta.ErrInvalidOperations.Code},
+		{"mismatched amounts", []rosetta.Operation{op(rosetta.OperationTransfer, from, "-1"), op(rosetta.OperationTransfer, to, "2")}, rosetta.ErrInvalidOperations.Code},
+		{"several debitors", []rosetta.Operation{op(rosetta.OperationTransfer, from, "-1"), op(rosetta.OperationTransfer, to, "-1")}, rosetta.ErrInvalidOperations.Code},
+		{"zero amount", []rosetta.Operation{op(rosetta.OperationTransfer, from, "0"), op(rosetta.OperationTransfer, to, "0")}, rosetta.ErrInvalidOperations.Code},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var res rosetta.ConstructionPreprocessResponse
+			rosettaErr := post(t, s, "/construction/preprocess", rosetta.ConstructionPreprocessRequest{NetworkIdentifier: network, Operations: tc.ops}, &res)
+			require.NotNil(t, rosettaErr)
+			require.Equal(t, tc.code, rosettaErr.Code)
+		})
+	}
+}
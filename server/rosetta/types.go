@@ -0,0 +1,288 @@
+package rosetta
+
+// The types below model the subset of the Rosetta specification
+// (https://www.rosetta-api.org) served by this package. They are encoded with
+// encoding/json, following the field names of the specification.
+
+// Version is the version of the Rosetta specification implemented by this
+// package.
+const Version = "1.4.0"
+
+const (
+	// OperationTransfer is the type of the operations moving coins between
+	// two accounts.
+	OperationTransfer = "transfer"
+	// OperationFee is the type of the operations paying the transaction fee
+	// to the fee collector.
+	OperationFee = "fee"
+
+	// StatusSuccess is the status of the operations of a successful
+	// transaction.
+	StatusSuccess = "success"
+	// StatusReverted is the status of the operations of a failed transaction.
+	StatusReverted = "reverted"
+
+	// CurveSecp256k1 is the only curve supported by the Construction API.
+	CurveSecp256k1 = "secp256k1"
+	// SignatureEcdsa is the signature type of the signing payloads, a 64 byte
+	// r || s signature of the payload.
+	SignatureEcdsa = "ecdsa"
+)
+
+type NetworkIdentifier struct {
+	Blockchain string `json:"blockchain"`
+	Network    string `json:"network"`
+}
+
+type BlockIdentifier struct {
+	Index int64  `json:"index"`
+	Hash  string `json:"hash"`
+}
+
+type PartialBlockIdentifier struct {
+	Index *int64  `json:"index,omitempty"`
+	Hash  *string `json:"hash,omitempty"`
+}
+
+type TransactionIdentifier struct {
+	Hash string `json:"hash"`
+}
+
+type OperationIdentifier struct {
+	Index int64 `json:"index"`
+}
+
+type AccountIdentifier struct {
+	Address string `json:"address"`
+}
+
+type Currency struct {
+	Symbol   string `json:"symbol"`
+	Decimals int32  `json:"decimals"`
+}
+
+type Amount struct {
+	Value    string   `json:"value"`
+	Currency Currency `json:"currency"`
+}
+
+type Operation struct {
+	OperationIdentifier OperationIdentifier   `json:"operation_identifier"`
+	RelatedOperations   []OperationIdentifier `json:"related_operations,omitempty"`
+	Type                string                `json:"type"`
+	Status              string                `json:"status,omitempty"`
+	Account             *AccountIdentifier    `json:"account,omitempty"`
+	Amount              *Amount               `json:"amount,omitempty"`
+}
+
+type Transaction struct {
+	TransactionIdentifier TransactionIdentifier `json:"transaction_identifier"`
+	Operations            []Operation           `json:"operations"`
+}
+
+type Block struct {
+	BlockIdentifier       BlockIdentifier `json:"block_identifier"`
+	ParentBlockIdentifier BlockIdentifier `json:"parent_block_identifier"`
+	Timestamp             int64           `json:"timestamp"`
+	Transactions          []Transaction   `json:"transactions"`
+}
+
+type Peer struct {
+	PeerID string `json:"peer_id"`
+}
+
+type PublicKey struct {
+	HexBytes  string `json:"hex_bytes"`
+	CurveType string `json:"curve_type"`
+}
+
+type SigningPayload struct {
+	Address       string `json:"address"`
+	HexBytes      string `json:"hex_bytes"`
+	SignatureType string `json:"signature_type"`
+}
+
+type Signature struct {
+	SigningPayload SigningPayload `json:"signing_payload"`
+	PublicKey      PublicKey      `json:"public_key"`
+	SignatureType  string         `json:"signature_type"`
+	HexBytes       string         `json:"hex_bytes"`
+}
+
+type OperationStatus struct {
+	Status     string `json:"status"`
+	Successful bool   `json:"successful"`
+}
+
+type VersionInfo struct {
+	RosettaVersion    string `json:"rosetta_version"`
+	NodeVersion       string `json:"node_version"`
+	MiddlewareVersion string `json:"middleware_version,omitempty"`
+}
+
+type Allow struct {
+	OperationStatuses       []OperationStatus `json:"operation_statuses"`
+	OperationTypes          []string          `json:"operation_types"`
+	Errors                  []*Error          `json:"errors"`
+	HistoricalBalanceLookup bool              `json:"historical_balance_lookup"`
+}
+
+// ----------------------------------------------------------------------------
+// Data API
+
+type MetadataRequest struct{}
+
+type NetworkRequest struct {
+	NetworkIdentifier NetworkIdentifier `json:"network_identifier"`
+}
+
+type NetworkListResponse struct {
+	NetworkIdentifiers []NetworkIdentifier `json:"network_identifiers"`
+}
+
+type NetworkOptionsResponse struct {
+	Version VersionInfo `json:"version"`
+	Allow   Allow       `json:"allow"`
+}
+
+type NetworkStatusResponse struct {
+	CurrentBlockIdentifier BlockIdentifier `json:"current_block_identifier"`
+	CurrentBlockTimestamp  int64           `json:"current_block_timestamp"`
+	GenesisBlockIdentifier BlockIdentifier `json:"genesis_block_identifier"`
+	Peers                  []Peer          `json:"peers"`
+}
+
+type BlockRequest struct {
+	NetworkIdentifier NetworkIdentifier      `json:"network_identifier"`
+	BlockIdentifier   PartialBlockIdentifier `json:"block_identifier"`
+}
+
+type BlockResponse struct {
+	Block Block `json:"block"`
+}
+
+type BlockTransactionRequest struct {
+	NetworkIdentifier     NetworkIdentifier     `json:"network_identifier"`
+	BlockIdentifier       BlockIdentifier       `json:"block_identifier"`
+	TransactionIdentifier TransactionIdentifier `json:"transaction_identifier"`
+}
+
+type BlockTransactionResponse struct {
+	Transaction Transaction `json:"transaction"`
+}
+
+type AccountBalanceRequest struct {
+	NetworkIdentifier NetworkIdentifier       `json:"network_identifier"`
+	AccountIdentifier AccountIdentifier       `json:"account_identifier"`
+	BlockIdentifier   *PartialBlockIdentifier `json:"block_identifier,omitempty"`
+}
+
+type AccountBalanceResponse struct {
+	BlockIdentifier BlockIdentifier `json:"block_identifier"`
+	Balances        []Amount        `json:"balances"`
+}
+
+type MempoolResponse struct {
+	TransactionIdentifiers []TransactionIdentifier `json:"transaction_identifiers"`
+}
+
+type MempoolTransactionRequest struct {
+	NetworkIdentifier     NetworkIdentifier     `json:"network_identifier"`
+	TransactionIdentifier TransactionIdentifier `json:"transaction_identifier"`
+}
+
+type MempoolTransactionResponse struct {
+	Transaction Transaction `json:"transaction"`
+}
+
+// ----------------------------------------------------------------------------
+// Construction API
+
+type ConstructionDeriveRequest struct {
+	NetworkIdentifier NetworkIdentifier `json:"network_identifier"`
+	PublicKey         PublicKey         `json:"public_key"`
+}
+
+type ConstructionDeriveResponse struct {
+	Address string `json:"address"`
+}
+
+// ConstructionMetadata holds the optional transaction parameters accepted by
+// /construction/preprocess and the signer data returned by
+// /construction/metadata.
+type ConstructionMetadata struct {
+	Signer        string `json:"signer,omitempty"`
+	Gas           uint64 `json:"gas,omitempty"`
+	Fee           string `json:"fee,omitempty"`
+	Memo          string `json:"memo,omitempty"`
+	ChainID       string `json:"chain_id,omitempty"`
+	AccountNumber uint64 `json:"account_number,omitempty"`
+	Sequence      uint64 `json:"sequence,omitempty"`
+}
+
+type ConstructionPreprocessRequest struct {
+	NetworkIdentifier NetworkIdentifier    `json:"network_identifier"`
+	Operations        []Operation          `json:"operations"`
+	Metadata          ConstructionMetadata `json:"metadata"`
+}
+
+type ConstructionPreprocessResponse struct {
+	Options            ConstructionMetadata `json:"options"`
+	RequiredPublicKeys []AccountIdentifier  `json:"required_public_keys"`
+}
+
+type ConstructionMetadataRequest struct {
+	NetworkIdentifier NetworkIdentifier    `json:"network_identifier"`
+	Options           ConstructionMetadata `json:"options"`
+}
+
+type ConstructionMetadataResponse struct {
+	Metadata ConstructionMetadata `json:"metadata"`
+}
+
+type ConstructionPayloadsRequest struct {
+	NetworkIdentifier NetworkIdentifier    `json:"network_identifier"`
+	Operations        []Operation          `json:"operations"`
+	Metadata          ConstructionMetadata `json:"metadata"`
+	PublicKeys        []PublicKey          `json:"public_keys"`
+}
+
+type ConstructionPayloadsResponse struct {
+	UnsignedTransaction string           `json:"unsigned_transaction"`
+	Payloads            []SigningPayload `json:"payloads"`
+}
+
+type ConstructionCombineRequest struct {
+	NetworkIdentifier   NetworkIdentifier `json:"network_identifier"`
+	UnsignedTransaction string            `json:"unsigned_transaction"`
+	Signatures          []Signature       `json:"signatures"`
+}
+
+type ConstructionCombineResponse struct {
+	SignedTransaction string `json:"signed_transaction"`
+}
+
+type ConstructionParseRequest struct {
+	NetworkIdentifier NetworkIdentifier `json:"network_identifier"`
+	Signed            bool              `json:"signed"`
+	Transaction       string            `json:"transaction"`
+}
+
+type ConstructionParseResponse struct {
+	Operations               []Operation         `json:"operations"`
+	AccountIdentifierSigners []AccountIdentifier `json:"account_identifier_signers,omitempty"`
+}
+
+type ConstructionHashRequest struct {
+	NetworkIdentifier NetworkIdentifier `json:"network_identifier"`
+	SignedTransaction string            `json:"signed_transaction"`
+}
+
+type ConstructionSubmitRequest struct {
+	NetworkIdentifier NetworkIdentifier `json:"network_identifier"`
+	SignedTransaction string            `json:"signed_transaction"`
+}
+
+type TransactionIdentifierResponse struct {
+	TransactionIdentifier TransactionIdentifier `json:"transaction_identifier"`
+}
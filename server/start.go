@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"os"
 	"runtime/pprof"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -16,24 +18,56 @@ import (
 	"github.com/tendermint/tendermint/p2p"
 	pvm "github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
+	"github.com/tendermint/tendermint/rpc/client/local"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/api"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
 // Tendermint full-node start flags
 const (
-	flagWithTendermint       = "with-tendermint"
-	flagAddress              = "address"
-	flagTraceStore           = "trace-store"
-	flagPruning              = "pruning"
-	flagPruningKeepEvery     = "pruning-keep-every"
-	flagPruningSnapshotEvery = "pruning-snapshot-every"
-	flagCPUProfile           = "cpu-profile"
-	FlagMinGasPrices         = "minimum-gas-prices"
-	FlagHaltHeight           = "halt-height"
-	FlagHaltTime             = "halt-time"
-	FlagInterBlockCache      = "inter-block-cache"
-	FlagUnsafeSkipUpgrades   = "unsafe-skip-upgrades"
+	flagWithTendermint     = "with-tendermint"
+	flagAddress            = "address"
+	flagTraceStore         = "trace-store"
+	flagPruning            = "pruning"
+	flagPruningKeepRecent  = "pruning-keep-recent"
+	flagPruningKeepEvery   = "pruning-keep-every"
+	flagPruningInterval    = "pruning-interval"
+	flagCPUProfile         = "cpu-profile"
+	FlagMinGasPrices       = "minimum-gas-prices"
+	FlagHaltHeight         = "halt-height"
+	FlagHaltTime           = "halt-time"
+	FlagInterBlockCache    = "inter-block-cache"
+	FlagSigVerifyWorkers   = "sig-verify-workers"
+	FlagUnsafeSkipUpgrades = "unsafe-skip-upgrades"
 )
 
+// API server flags
+const (
+	flagAPIEnable           = "api.enable"
+	flagAPISwagger          = "api.swagger"
+	flagAPIAddress          = "api.address"
+	flagAPIEnableUnsafeCORS = "api.enabled-unsafe-cors"
+)
+
+// gRPC server flags
+const (
+	flagGRPCEnable  = "grpc.enable"
+	flagGRPCAddress = "grpc.address"
+)
+
+// APIRoutesRegistrar is implemented by applications that expose REST routes
+// through the API server embedded in the node.
+type APIRoutesRegistrar interface {
+	RegisterAPIRoutes(*api.Server)
+}
+
 // StartCmd runs the service passed in, either stand-alone or in-process with
 // Tendermint.
 func StartCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
@@ -43,13 +77,16 @@ func StartCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
 		Long: `Run the full node application with Tendermint in or out of process. By
 default, the application will run with Tendermint in process.
 
-Pruning options can be provided via the '--pruning' flag or alternatively with '--pruning-snapshot-every' and 'pruning-keep-every' together.
+Pruning options can be provided via the '--pruning' flag or alternatively with '--pruning-keep-recent',
+'pruning-keep-every', and 'pruning-interval' together.
 
 For '--pruning' the options are as follows:
 
-syncable: only those states not needed for state syncing will be deleted (flushes every 100th to disk and keeps every 10000th)
+default: the last 362880 states are kept in addition to every 100th state; pruning at 10 block intervals
 nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
-everything: all saved states will be deleted, storing only the current state
+everything: all saved states will be deleted, storing only the current state; pruning at 10 block intervals
+custom: allow pruning options to be manually specified through 'pruning-keep-recent', 'pruning-keep-every', and 'pruning-interval'
+syncable: deprecated, same as default
 
 Node halting configurations exist in the form of two flags: '--halt-height' and '--halt-time'. During
 the ABCI Commit phase, the node will check if the current block height is greater than or equal to
@@ -57,10 +94,32 @@ the halt-height or if the current block time is greater than or equal to the hal
 node will attempt to gracefully shutdown and the block will not be committed. In addition, the node
 will not be able to commit subsequent blocks.
 
+Validators may keep their consensus key in a remote signer, such as an HSM-backed KMS, by setting
+'--priv_validator_laddr' or 'priv_validator_laddr' in config.toml to the address the node listens
+on for the signer connection. No local validator key is created in that case.
+
 For profiling and benchmarking purposes, CPU profiling can be enabled via the '--cpu-profile' flag
 which accepts a path for the resulting pprof file.
+
+The REST API server can be embedded in the node via the '--api.enable' flag or the [api] section of
+app.toml. It serves the routes registered by the application, and swagger documentation if
+'--api.swagger' is set.
+
+The gRPC server is enabled by default and listens on '--grpc.address'. It serves all the module query
+services registered by the application as well as the tx Service, if the application provides it.
+
+Application metrics are collected if the [telemetry] section of app.toml enables them. They are
+served by the API server at /metrics, in JSON or, with '?format=prometheus', in the Prometheus
+exposition format.
 `,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if strings.ToLower(viper.GetString(flagPruning)) == storetypes.PruningOptionSyncable {
+				ctx.Logger.Error(fmt.Sprintf(
+					"the '%s' pruning strategy is deprecated and will be removed, using '%s' instead",
+					storetypes.PruningOptionSyncable, storetypes.PruningOptionDefault,
+				))
+			}
+
 			_, err := GetPruningOptionsFromFlags()
 			return err
 		},
@@ -81,9 +140,10 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Bool(flagWithTendermint, true, "Run abci app embedded in-process with tendermint")
 	cmd.Flags().String(flagAddress, "tcp://0.0.0.0:26658", "Listen address")
 	cmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
-	cmd.Flags().String(flagPruning, "syncable", "Pruning strategy: syncable, nothing, everything, custom")
-	cmd.Flags().Int64(flagPruningKeepEvery, 0, "Define the state number that will be kept. Ignored if pruning is not custom.")
-	cmd.Flags().Int64(flagPruningSnapshotEvery, 0, "Defines the state that will be snapshot for pruning. Ignored if pruning is not custom.")
+	cmd.Flags().String(flagPruning, storetypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
+	cmd.Flags().Uint64(flagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
+	cmd.Flags().Uint64(flagPruningKeepEvery, 0, "Offset heights to keep on disk after 'keep-recent' (ignored if pruning is not 'custom')")
+	cmd.Flags().Uint64(flagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
 	cmd.Flags().String(
 		FlagMinGasPrices, "",
 		"Minimum gas prices to accept for transactions; Any fee in a tx must meet this minimum (e.g. 0.01photino;0.0001stake)",
@@ -92,11 +152,19 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
+	cmd.Flags().Int(FlagSigVerifyWorkers, 0, "Number of goroutines used to verify tx signatures in CheckTx (0 or 1 verifies sequentially)")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
+	cmd.Flags().Bool(flagAPIEnable, false, "Enable the REST API server embedded in the node")
+	cmd.Flags().Bool(flagAPISwagger, false, "Serve swagger documentation from the API server")
+	cmd.Flags().String(flagAPIAddress, "tcp://0.0.0.0:1317", "The API server listen address")
+	cmd.Flags().Bool(flagAPIEnableUnsafeCORS, false, "Enable CORS for the API server (unsafe - use it at your own risk)")
+	cmd.Flags().Bool(flagGRPCEnable, true, "Enable the gRPC server embedded in the node")
+	cmd.Flags().String(flagGRPCAddress, "0.0.0.0:9090", "The gRPC server listen address")
 
 	viper.BindPFlag(flagPruning, cmd.Flags().Lookup(flagPruning))
+	viper.BindPFlag(flagPruningKeepRecent, cmd.Flags().Lookup(flagPruningKeepRecent))
 	viper.BindPFlag(flagPruningKeepEvery, cmd.Flags().Lookup(flagPruningKeepEvery))
-	viper.BindPFlag(flagPruningSnapshotEvery, cmd.Flags().Lookup(flagPruningSnapshotEvery))
+	viper.BindPFlag(flagPruningInterval, cmd.Flags().Lookup(flagPruningInterval))
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
@@ -165,10 +233,19 @@ func startInProcess(ctx *Context, appCreator AppCreator) error {
 		return err
 	}
 
+	// A node configured with a remote signer (priv_validator_laddr) listens for
+	// the signer connection itself, no local validator key is needed.
+	var privValidator tmtypes.PrivValidator
+	if cfg.PrivValidatorListenAddr == "" {
+		privValidator = pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile())
+	} else {
+		ctx.Logger.Info("waiting for the remote signer", "laddr", cfg.PrivValidatorListenAddr)
+	}
+
 	// create & start tendermint node
 	tmNode, err := node.NewNode(
 		cfg,
-		pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
+		privValidator,
 		nodeKey,
 		proxy.NewLocalClientCreator(app),
 		node.DefaultGenesisDocProviderFunc(cfg),
@@ -184,6 +261,79 @@ func startInProcess(ctx *Context, appCreator AppCreator) error {
 		return err
 	}
 
+	srvCfg, err := config.ParseConfig()
+	if err != nil {
+		return err
+	}
+
+	var metrics *telemetry.Metrics
+
+	if srvCfg.Telemetry.Enabled {
+		metrics, err = telemetry.New(srvCfg.Telemetry)
+		if err != nil {
+			return err
+		}
+	}
+
+	var clientCtx client.Context
+
+	if srvCfg.API.Enable || srvCfg.GRPC.Enable {
+		genDoc, err := node.DefaultGenesisDocProviderFunc(cfg)()
+		if err != nil {
+			return err
+		}
+
+		clientCtx = client.Context{}.
+			WithChainID(genDoc.ChainID).
+			WithClient(local.New(tmNode)).
+			WithTrustNode(true)
+	}
+
+	var apiSrv *api.Server
+
+	if srvCfg.API.Enable {
+		registrar, ok := app.(APIRoutesRegistrar)
+		if !ok {
+			return fmt.Errorf("the API server is enabled but %T does not register API routes", app)
+		}
+
+		apiSrv = api.New(clientCtx, ctx.Logger.With("module", "api-server"))
+		if metrics != nil {
+			apiSrv.SetTelemetry(metrics)
+		}
+
+		registrar.RegisterAPIRoutes(apiSrv)
+
+		errCh := make(chan error)
+		go func() {
+			if err := apiSrv.Start(*srvCfg); err != nil {
+				errCh <- err
+			}
+		}()
+
+		select {
+		case err := <-errCh:
+			return err
+		case <-time.After(5 * time.Second): // assume server started successfully
+		}
+	}
+
+	var grpcSrv *grpc.Server
+
+	if srvCfg.GRPC.Enable {
+		grpcApp, ok := app.(servergrpc.Registrar)
+		if !ok {
+			return fmt.Errorf("the gRPC server is enabled but %T does not register gRPC services", app)
+		}
+
+		ctx.Logger.Info("starting gRPC server", "address", srvCfg.GRPC.Address)
+
+		grpcSrv, err = servergrpc.StartGRPCServer(grpcApp, clientCtx, srvCfg.GRPC.Address)
+		if err != nil {
+			return err
+		}
+	}
+
 	var cpuProfileCleanup func()
 
 	if cpuProfile := viper.GetString(flagCPUProfile); cpuProfile != "" {
@@ -209,6 +359,14 @@ func startInProcess(ctx *Context, appCreator AppCreator) error {
 			_ = tmNode.Stop()
 		}
 
+		if apiSrv != nil {
+			_ = apiSrv.Close()
+		}
+
+		if grpcSrv != nil {
+			grpcSrv.Stop()
+		}
+
 		if cpuProfileCleanup != nil {
 			cpuProfileCleanup()
 		}
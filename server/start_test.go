@@ -33,24 +33,25 @@ func TestPruningOptions(t *testing.T) {
 				viper.Set(flagPruning, "custom")
 				viper.Set(flagPruningKeepEvery, 12345)
 			},
-			returnsErr:  false,
-			expectedErr: nil,
+			returnsErr:  true,
+			expectedErr: fmt.Errorf("invalid custom pruning options: invalid 'Interval' when pruning: 0"),
 		},
 		{
-			name: "only snapshot-every provided",
+			name: "only keep-recent provided",
 			paramInit: func() {
 				viper.Set(flagPruning, "custom")
-				viper.Set(flagPruningSnapshotEvery, 12345)
+				viper.Set(flagPruningKeepRecent, 12345)
 			},
 			returnsErr:  true,
-			expectedErr: fmt.Errorf("invalid granular options"),
+			expectedErr: fmt.Errorf("invalid custom pruning options: invalid 'Interval' when pruning everything: 0"),
 		},
 		{
 			name: "pruning flag with other granular options 3",
 			paramInit: func() {
 				viper.Set(flagPruning, "custom")
+				viper.Set(flagPruningKeepRecent, 1234)
 				viper.Set(flagPruningKeepEvery, 1234)
-				viper.Set(flagPruningSnapshotEvery, 1234)
+				viper.Set(flagPruningInterval, 10)
 			},
 			returnsErr:  false,
 			expectedErr: nil,
@@ -70,7 +71,7 @@ func TestPruningOptions(t *testing.T) {
 
 		t.Run(tt.name, func(t *testing.T) {
 			viper.Reset()
-			viper.SetDefault(flagPruning, "syncable")
+			viper.SetDefault(flagPruning, "default")
 			startCommand := StartCmd(nil, nil)
 			tt.paramInit()
 			err := startCommand.PreRunE(startCommand, nil)
@@ -4,15 +4,21 @@ package server
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	yaml "gopkg.in/yaml.v2"
 
 	tcmd "github.com/tendermint/tendermint/cmd/tendermint/commands"
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/log"
+	tmos "github.com/tendermint/tendermint/libs/os"
 	"github.com/tendermint/tendermint/p2p"
 	pvm "github.com/tendermint/tendermint/privval"
+	tmtypes "github.com/tendermint/tendermint/types"
 	tversion "github.com/tendermint/tendermint/version"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -42,11 +48,12 @@ func ShowValidatorCmd(ctx *Context) *cobra.Command {
 	cmd := cobra.Command{
 		Use:   "show-validator",
 		Short: "Show this node's tendermint validator info",
+		Long: `Show this node's tendermint validator consensus public key. If the node is
+configured with a remote signer (priv_validator_laddr), the key is queried from
+the signer once it connects.
+`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			cfg := ctx.Config
-
-			privValidator := pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile())
-			valPubKey, err := privValidator.GetPubKey()
+			valPubKey, err := getValidatorPubKey(ctx.Config, ctx.Logger)
 			if err != nil {
 				return err
 			}
@@ -75,11 +82,12 @@ func ShowAddressCmd(ctx *Context) *cobra.Command {
 		Use:   "show-address",
 		Short: "Shows this node's tendermint validator consensus address",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			valPubKey, err := getValidatorPubKey(ctx.Config, ctx.Logger)
+			if err != nil {
+				return err
+			}
 
-			cfg := ctx.Config
-			privValidator := pvm.LoadOrGenFilePV(
-				cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile())
-			valConsAddr := (sdk.ConsAddress)(privValidator.GetAddress())
+			valConsAddr := (sdk.ConsAddress)(valPubKey.Address())
 
 			if viper.GetString(cli.OutputFlag) == "json" {
 				return printlnJSON(valConsAddr)
@@ -142,10 +150,73 @@ func UnsafeResetAllCmd(ctx *Context) *cobra.Command {
 	return &cobra.Command{
 		Use:   "unsafe-reset-all",
 		Short: "Resets the blockchain database, removes address book files, and resets priv_validator.json to the genesis state",
+		Long: `Resets the blockchain database, removes address book files, and resets priv_validator.json
+to the genesis state. If the node is configured with a remote signer (priv_validator_laddr), no local
+validator key is created or reset; the signer state must be reset on the signer itself.
+`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cfg := ctx.Config
-			tcmd.ResetAll(cfg.DBDir(), cfg.P2P.AddrBookFile(), cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile(), ctx.Logger)
-			return nil
+			if cfg.PrivValidatorListenAddr == "" {
+				tcmd.ResetAll(cfg.DBDir(), cfg.P2P.AddrBookFile(), cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile(), ctx.Logger)
+				return nil
+			}
+
+			return resetAllWithRemoteSigner(cfg, ctx.Logger)
 		},
 	}
 }
+
+// newPrivValidator returns the private validator of the node: a client of the
+// remote signer listening on priv_validator_laddr if one is configured, or the
+// local file private validator otherwise. The returned function releases the
+// resources held by the private validator.
+func newPrivValidator(cfg *tmcfg.Config, logger log.Logger) (tmtypes.PrivValidator, func(), error) {
+	if cfg.PrivValidatorListenAddr == "" {
+		pv := pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile())
+		return pv, func() {}, nil
+	}
+
+	listener, err := pvm.NewSignerListener(cfg.PrivValidatorListenAddr, logger)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to listen for the remote signer: %w", err)
+	}
+
+	client, err := pvm.NewSignerClient(listener)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to start the remote signer client: %w", err)
+	}
+
+	return client, func() { _ = client.Close() }, nil
+}
+
+// getValidatorPubKey returns the consensus public key of the node's private
+// validator.
+func getValidatorPubKey(cfg *tmcfg.Config, logger log.Logger) (crypto.PubKey, error) {
+	privValidator, closer, err := newPrivValidator(cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+	defer closer()
+
+	return privValidator.GetPubKey()
+}
+
+// resetAllWithRemoteSigner removes the blockchain database and the address
+// book, leaving the validator key and signing state to the remote signer.
+func resetAllWithRemoteSigner(cfg *tmcfg.Config, logger log.Logger) error {
+	if err := os.RemoveAll(cfg.P2P.AddrBookFile()); err == nil {
+		logger.Info("Removed existing address book", "file", cfg.P2P.AddrBookFile())
+	} else if !os.IsNotExist(err) {
+		logger.Info("Error removing address book", "file", cfg.P2P.AddrBookFile(), "err", err)
+	}
+
+	if err := os.RemoveAll(cfg.DBDir()); err != nil {
+		return fmt.Errorf("failed to remove the blockchain database: %w", err)
+	}
+
+	logger.Info("Removed all blockchain history", "dir", cfg.DBDir())
+	logger.Info("Remote signer configured, the validator signing state must be reset on the signer",
+		"laddr", cfg.PrivValidatorListenAddr)
+
+	return tmos.EnsureDir(cfg.DBDir(), 0700)
+}
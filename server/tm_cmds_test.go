@@ -0,0 +1,87 @@
+package server
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	tmnet "github.com/tendermint/tendermint/libs/net"
+	pvm "github.com/tendermint/tendermint/privval"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func testTendermintConfig(t *testing.T) (*tmcfg.Config, func()) {
+	dir, err := ioutil.TempDir("", "tm_cmds")
+	require.NoError(t, err)
+
+	cfg := tmcfg.TestConfig()
+	cfg.SetRoot(dir)
+	require.NoError(t, os.MkdirAll(filepath.Join(cfg.RootDir, "config"), 0700))
+	require.NoError(t, os.MkdirAll(cfg.DBDir(), 0700))
+
+	return cfg, func() { os.RemoveAll(dir) }
+}
+
+func TestGetValidatorPubKey(t *testing.T) {
+	cfg, cleanup := testTendermintConfig(t)
+	defer cleanup()
+
+	pubKey, err := getValidatorPubKey(cfg, log.NewNopLogger())
+	require.NoError(t, err)
+
+	filePV := pvm.LoadFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile())
+	require.Equal(t, filePV.Key.PubKey, pubKey)
+}
+
+func TestGetValidatorPubKeyRemoteSigner(t *testing.T) {
+	cfg, cleanup := testTendermintConfig(t)
+	defer cleanup()
+
+	port, err := tmnet.GetFreePort()
+	require.NoError(t, err)
+	cfg.PrivValidatorListenAddr = fmt.Sprintf("tcp://127.0.0.1:%d", port)
+
+	// the remote signer dials the node, which listens on priv_validator_laddr
+	mockPV := tmtypes.NewMockPV()
+	dialer := pvm.DialTCPFn(fmt.Sprintf("127.0.0.1:%d", port), time.Second, mockPV.PrivKey)
+	signer := pvm.NewSignerServer(pvm.NewSignerDialerEndpoint(log.NewNopLogger(), dialer), cfg.ChainID(), mockPV)
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		_ = signer.Start()
+	}()
+	defer signer.Stop() // nolint: errcheck
+
+	pubKey, err := getValidatorPubKey(cfg, log.NewNopLogger())
+	require.NoError(t, err)
+
+	expected, err := mockPV.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, expected, pubKey)
+
+	// no local validator key is created for a remote signer
+	_, err = os.Stat(cfg.PrivValidatorKeyFile())
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestResetAllWithRemoteSigner(t *testing.T) {
+	cfg, cleanup := testTendermintConfig(t)
+	defer cleanup()
+
+	cfg.PrivValidatorListenAddr = "tcp://127.0.0.1:26659"
+	require.NoError(t, ioutil.WriteFile(filepath.Join(cfg.DBDir(), "blockstore"), []byte("data"), 0600))
+
+	require.NoError(t, resetAllWithRemoteSigner(cfg, log.NewNopLogger()))
+
+	files, err := ioutil.ReadDir(cfg.DBDir())
+	require.NoError(t, err)
+	require.Empty(t, files)
+
+	_, err = os.Stat(cfg.PrivValidatorKeyFile())
+	require.True(t, os.IsNotExist(err))
+}
@@ -143,6 +143,7 @@ func AddCommands(
 	rootCmd.AddCommand(
 		StartCmd(ctx, appCreator),
 		UnsafeResetAllCmd(ctx),
+		RollbackCmd(ctx, appCreator),
 		flags.LineBreak,
 		tendermintCmd,
 		ExportCmd(ctx, cdc, appExport),
@@ -4,21 +4,34 @@ import (
 	"io"
 	"os"
 
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/spf13/viper"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/testdata"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server/api"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/store/streaming"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authrest "github.com/cosmos/cosmos-sdk/x/auth/client/rest"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -35,11 +48,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	evidencekeeper "github.com/cosmos/cosmos-sdk/x/evidence/keeper"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	feemarketante "github.com/cosmos/cosmos-sdk/x/feemarket/ante"
+	feemarketkeeper "github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	groupkeeper "github.com/cosmos/cosmos-sdk/x/group/keeper"
+	grouptypes "github.com/cosmos/cosmos-sdk/x/group/types"
 	"github.com/cosmos/cosmos-sdk/x/ibc"
 	transfer "github.com/cosmos/cosmos-sdk/x/ibc-transfer"
 	ibctransferkeeper "github.com/cosmos/cosmos-sdk/x/ibc-transfer/keeper"
@@ -51,6 +71,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
+	nfttypes "github.com/cosmos/cosmos-sdk/x/nft/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	paramsclient "github.com/cosmos/cosmos-sdk/x/params/client"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
@@ -98,6 +121,10 @@ var (
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
 		transfer.AppModuleBasic{},
+		authz.AppModuleBasic{},
+		group.AppModuleBasic{},
+		nft.AppModuleBasic{},
+		feemarket.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -152,6 +179,10 @@ type SimApp struct {
 	IBCKeeper        *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	EvidenceKeeper   evidencekeeper.Keeper
 	TransferKeeper   ibctransferkeeper.Keeper
+	AuthzKeeper      authzkeeper.Keeper
+	GroupKeeper      groupkeeper.Keeper
+	NFTKeeper        nftkeeper.Keeper
+	FeeMarketKeeper  feemarketkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
@@ -160,6 +191,9 @@ type SimApp struct {
 	// the module manager
 	mm *module.Manager
 
+	// configurator holding the in-place store migrations of all modules
+	configurator module.Configurator
+
 	// simulation manager
 	sm *module.SimulationManager
 }
@@ -171,9 +205,11 @@ func NewSimApp(
 ) *SimApp {
 
 	// TODO: Remove cdc in favor of appCodec once all modules are migrated.
-	appCodec, cdc := MakeCodecs()
+	interfaceRegistry := MakeInterfaceRegistry()
+	cdc := std.MakeCodec(ModuleBasics)
+	appCodec := std.NewAppCodec(cdc, interfaceRegistry)
 
-	bApp := baseapp.NewBaseApp(appName, logger, db, auth.DefaultTxDecoder(cdc), baseAppOptions...)
+	bApp := baseapp.NewBaseApp(appName, logger, db, authtx.DefaultTxDecoder(cdc, interfaceRegistry), baseAppOptions...)
 	bApp.SetCommitMultiStoreTracer(traceStore)
 	bApp.SetAppVersion(version.Version)
 
@@ -182,6 +218,7 @@ func NewSimApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
+		authztypes.StoreKey, grouptypes.StoreKey, nfttypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -207,6 +244,7 @@ func NewSimApp(
 	app.subspaces[slashingtypes.ModuleName] = app.ParamsKeeper.Subspace(slashingtypes.DefaultParamspace)
 	app.subspaces[govtypes.ModuleName] = app.ParamsKeeper.Subspace(govtypes.DefaultParamspace).WithKeyTable(govtypes.ParamKeyTable())
 	app.subspaces[crisistypes.ModuleName] = app.ParamsKeeper.Subspace(crisistypes.DefaultParamspace)
+	app.subspaces[feemarkettypes.ModuleName] = app.ParamsKeeper.Subspace(feemarkettypes.DefaultParamspace)
 
 	// set the BaseApp's parameter store
 	bApp.SetParamStore(app.ParamsKeeper.Subspace(baseapp.Paramspace).WithKeyTable(std.ConsensusParamsKeyTable()))
@@ -240,6 +278,7 @@ func NewSimApp(
 	app.CrisisKeeper = crisiskeeper.NewKeeper(
 		app.subspaces[crisistypes.ModuleName], invCheckPeriod, app.BankKeeper, auth.FeeCollectorName,
 	)
+	app.FeeMarketKeeper = feemarketkeeper.NewKeeper(appCodec, app.subspaces[feemarkettypes.ModuleName])
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath)
 
 	// register the proposal types
@@ -289,6 +328,10 @@ func NewSimApp(
 	evidenceKeeper.SetRouter(evidenceRouter)
 	app.EvidenceKeeper = *evidenceKeeper
 
+	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authztypes.StoreKey], appCodec, app.BaseApp.Router())
+	app.GroupKeeper = groupkeeper.NewKeeper(keys[grouptypes.StoreKey], appCodec, app.AccountKeeper, app.BaseApp.Router())
+	app.NFTKeeper = nftkeeper.NewKeeper(keys[nfttypes.StoreKey], appCodec)
+
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
 	app.mm = module.NewManager(
@@ -307,17 +350,33 @@ func NewSimApp(
 		ibc.NewAppModule(app.IBCKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		transferModule,
+		authz.NewAppModule(appCodec, app.AuthzKeeper),
+		group.NewAppModule(app.GroupKeeper),
+		nft.NewAppModule(app.NFTKeeper),
+		feemarket.NewAppModule(app.FeeMarketKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
 	// NOTE: staking module is required if HistoricalEntries param > 0
+	// NOTE: every module must be listed, the ones after ibc have no begin blocker.
 	app.mm.SetOrderBeginBlockers(
 		upgradetypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
 		evidencetypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName,
+		genutiltypes.ModuleName, auth.ModuleName, banktypes.ModuleName, capabilitytypes.ModuleName,
+		crisistypes.ModuleName, govtypes.ModuleName, paramstypes.ModuleName, ibctransfertypes.ModuleName,
+		authztypes.ModuleName, grouptypes.ModuleName, nfttypes.ModuleName, feemarkettypes.ModuleName,
+	)
+	// NOTE: fee market must run last so that the block gas used is final. The
+	// modules before crisis have no end blocker.
+	app.mm.SetOrderEndBlockers(
+		genutiltypes.ModuleName, auth.ModuleName, banktypes.ModuleName, capabilitytypes.ModuleName,
+		minttypes.ModuleName, slashingtypes.ModuleName, distrtypes.ModuleName, upgradetypes.ModuleName,
+		evidencetypes.ModuleName, ibchost.ModuleName, paramstypes.ModuleName, ibctransfertypes.ModuleName,
+		authztypes.ModuleName, grouptypes.ModuleName, nfttypes.ModuleName,
+		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName, feemarkettypes.ModuleName,
 	)
-	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName)
 
 	// NOTE: The genutils moodule must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -328,11 +387,17 @@ func NewSimApp(
 		capabilitytypes.ModuleName, auth.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName, banktypes.ModuleName,
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
 		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, ibctransfertypes.ModuleName,
+		authztypes.ModuleName, grouptypes.ModuleName, nfttypes.ModuleName, feemarkettypes.ModuleName, upgradetypes.ModuleName,
+		paramstypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
 	app.mm.RegisterQueryServices(app.GRPCQueryRouter())
+	app.mm.RegisterMsgServices(app.MsgServiceRouter())
+
+	app.configurator = module.NewConfigurator(app.cdc)
+	app.mm.RegisterMigrations(app.configurator)
 
 	// add test gRPC service for testing gRPC queries in isolation
 	testdata.RegisterTestServiceServer(app.GRPCQueryRouter(), testdata.TestServiceImpl{})
@@ -354,6 +419,7 @@ func NewSimApp(
 		evidence.NewAppModule(app.EvidenceKeeper),
 		ibc.NewAppModule(app.IBCKeeper),
 		transferModule,
+		authz.NewAppModule(appCodec, app.AuthzKeeper),
 	)
 
 	app.sm.RegisterStoreDecoders()
@@ -367,12 +433,26 @@ func NewSimApp(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetAnteHandler(
-		ante.NewAnteHandler(
-			app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer,
+		feemarketante.NewAnteHandler(
+			app.FeeMarketKeeper,
+			ante.NewAnteHandler(
+				app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer,
+				authtx.DefaultSignModeHandler(),
+			),
 		),
 	)
 	app.SetEndBlocker(app.EndBlocker)
 
+	// register the streaming service configured in the [streaming] section of
+	// app.toml, if any
+	streamingConfig := serverconfig.DefaultConfig().Streaming
+	if err := viper.UnmarshalKey("streaming", &streamingConfig); err != nil {
+		tmos.Exit(err.Error())
+	}
+	if _, err := streaming.LoadStreamingService(bApp, streamingConfig, homePath, keys); err != nil {
+		tmos.Exit(err.Error())
+	}
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
@@ -398,11 +478,17 @@ func NewSimApp(
 // full simapp
 func MakeCodecs() (*std.Codec, *codec.Codec) {
 	cdc := std.MakeCodec(ModuleBasics)
+	appCodec := std.NewAppCodec(cdc, MakeInterfaceRegistry())
+	return appCodec, cdc
+}
+
+// MakeInterfaceRegistry constructs the InterfaceRegistry used by simapp to
+// decode the interfaces of protobuf messages, including protobuf transactions.
+func MakeInterfaceRegistry() types.InterfaceRegistry {
 	interfaceRegistry := types.NewInterfaceRegistry()
 	std.RegisterInterfaces(interfaceRegistry)
 	ModuleBasics.RegisterInterfaceModules(interfaceRegistry)
-	appCodec := std.NewAppCodec(cdc, interfaceRegistry)
-	return appCodec, cdc
+	return interfaceRegistry
 }
 
 // Name returns the name of the App
@@ -422,6 +508,7 @@ func (app *SimApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.Re
 func (app *SimApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 	var genesisState GenesisState
 	app.cdc.MustUnmarshalJSON(req.AppStateBytes, &genesisState)
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
 	return app.mm.InitGenesis(ctx, app.cdc, genesisState)
 }
 
@@ -499,6 +586,25 @@ func (app *SimApp) SimulationManager() *module.SimulationManager {
 	return app.sm
 }
 
+// RegisterAPIRoutes registers all application module routes with the provided
+// API server.
+func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server) {
+	clientCtx := apiSvr.ClientCtx.WithCodec(app.cdc).WithJSONMarshaler(app.appCodec)
+
+	rpc.RegisterRoutes(clientCtx, apiSvr.Router)
+	authrest.RegisterTxRoutes(clientCtx, apiSvr.Router)
+	ModuleBasics.RegisterRESTRoutes(clientCtx, apiSvr.Router)
+
+	// serve the REST translation of the module gRPC query services
+	app.RegisterGRPCServer(apiSvr)
+}
+
+// RegisterTxService registers the tx gRPC Service with the node's gRPC server,
+// where it is served alongside the module query services.
+func (app *SimApp) RegisterTxService(server gogogrpc.Server, clientCtx client.Context) {
+	authclient.RegisterTxService(server, clientCtx.WithCodec(app.cdc))
+}
+
 // GetMaccPerms returns a copy of the module account permissions
 func GetMaccPerms() map[string][]string {
 	dupMaccPerms := make(map[string][]string)
@@ -1,14 +1,26 @@
 package simapp
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/gogo/protobuf/jsonpb"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server/api"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -50,3 +62,84 @@ func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
 }
+
+func TestGRPCQueryHeight(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	app := SetupWithGenesisAccounts(
+		[]auth.GenesisAccount{auth.NewBaseAccountWithAddress(addr)},
+		banktypes.Balance{Address: addr, Coins: coins},
+	)
+	app.EndBlock(abci.RequestEndBlock{Height: app.LastBlockHeight() + 1})
+	app.Commit()
+	require.Equal(t, int64(2), app.LastBlockHeight())
+
+	reqBz, err := app.AppCodec().MarshalBinaryBare(&banktypes.QueryBalanceRequest{Address: addr, Denom: sdk.DefaultBondDenom})
+	require.NoError(t, err)
+
+	path := "/cosmos_sdk.x.bank.v1.Query/Balance"
+
+	// the latest height is reported when no height is provided
+	res := app.Query(abci.RequestQuery{Path: path, Data: reqBz})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(2), res.Height)
+
+	res = app.Query(abci.RequestQuery{Path: path, Data: reqBz, Height: 1})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(1), res.Height)
+
+	var balance banktypes.QueryBalanceResponse
+	require.NoError(t, app.AppCodec().UnmarshalBinaryBare(res.Value, &balance))
+	require.Equal(t, coins[0], *balance.Balance)
+
+	// future heights cannot be queried
+	res = app.Query(abci.RequestQuery{Path: path, Data: reqBz, Height: 5})
+	require.False(t, res.IsOK())
+}
+
+func TestTxServiceNotServedByABCIQuery(t *testing.T) {
+	app := Setup(false)
+
+	// the tx Service is registered with the gRPC server only, so that
+	// BroadcastTx cannot be reached while the ABCI connection is locked
+	app.RegisterTxService(grpc.NewServer(), client.Context{})
+
+	res := app.Query(abci.RequestQuery{Path: "/cosmos_sdk.tx.v1.Service/BroadcastTx"})
+	require.False(t, res.IsOK())
+}
+
+func TestGRPCGateway(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	app := SetupWithGenesisAccounts(
+		[]auth.GenesisAccount{auth.NewBaseAccountWithAddress(addr)},
+		banktypes.Balance{Address: addr, Coins: coins},
+	)
+
+	apiSvr := api.New(client.Context{}, log.NewNopLogger())
+	app.RegisterAPIRoutes(apiSvr)
+
+	path := api.GRPCGatewayPrefix + "/cosmos_sdk.x.bank.v1.Query/Balance"
+	body := fmt.Sprintf(`{"address":"%s","denom":"%s"}`, addr, sdk.DefaultBondDenom)
+
+	rec := httptest.NewRecorder()
+	apiSvr.Router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, strings.NewReader(body)))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, "1", rec.Header().Get(baseapp.GRPCBlockHeightHeader))
+
+	var balance banktypes.QueryBalanceResponse
+	require.NoError(t, jsonpb.Unmarshal(rec.Body, &balance))
+	require.Equal(t, coins[0], *balance.Balance)
+
+	// the request must be valid Proto3 JSON
+	rec = httptest.NewRecorder()
+	apiSvr.Router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, strings.NewReader(`{"foo":1}`)))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// future heights cannot be queried
+	rec = httptest.NewRecorder()
+	apiSvr.Router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path+"?height=5", strings.NewReader(body)))
+	require.NotEqual(t, http.StatusOK, rec.Code)
+}
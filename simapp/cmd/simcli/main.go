@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/lcd"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server/rosetta"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
@@ -66,6 +67,7 @@ func main() {
 		txCmd(cdc),
 		flags.LineBreak,
 		lcd.ServeCommand(cdc, registerRoutes),
+		rosetta.ServeCommand(cdc),
 		flags.LineBreak,
 		keys.Commands(),
 		flags.LineBreak,
@@ -2,8 +2,10 @@ package main
 
 import (
 	"bufio"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 
@@ -27,10 +29,11 @@ import (
 )
 
 const (
-	flagClientHome   = "home-client"
-	flagVestingStart = "vesting-start-time"
-	flagVestingEnd   = "vesting-end-time"
-	flagVestingAmt   = "vesting-amount"
+	flagClientHome     = "home-client"
+	flagVestingStart   = "vesting-start-time"
+	flagVestingEnd     = "vesting-end-time"
+	flagVestingAmt     = "vesting-amount"
+	flagVestingPeriods = "vesting-periods"
 )
 
 // AddGenesisAccountCmd returns add-genesis-account cobra Command.
@@ -45,6 +48,18 @@ func AddGenesisAccountCmd(
 the account address or key name and a list of initial coins. If a key name is given,
 the address will be looked up in the local Keybase. The list of initial tokens must
 contain valid denominations. Accounts may optionally be supplied with vesting parameters.
+
+A periodic vesting account is created by passing a JSON file to --vesting-periods
+together with --vesting-start-time, in which case --vesting-end-time must not be
+set as it is derived from the periods. The file lists each period's length in seconds
+and the coins that vest at the end of it, e.g.:
+
+{
+  "periods": [
+    {"length": 2592000, "coins": "1000stake"},
+    {"length": 2592000, "coins": "1000stake"}
+  ]
+}
 `,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -85,6 +100,34 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 				return fmt.Errorf("failed to parse vesting amount: %w", err)
 			}
 
+			var vestingPeriods authvesting.Periods
+			if periodsFile := viper.GetString(flagVestingPeriods); periodsFile != "" {
+				// the end time of a periodic vesting account is derived from its periods
+				if vestingEnd != 0 {
+					return fmt.Errorf("--%s cannot be combined with --%s", flagVestingEnd, flagVestingPeriods)
+				}
+
+				vestingPeriods, err = parseVestingPeriods(periodsFile)
+				if err != nil {
+					return fmt.Errorf("failed to parse vesting periods: %w", err)
+				}
+
+				periodsAmt := sdk.NewCoins()
+				for _, p := range vestingPeriods {
+					periodsAmt = periodsAmt.Add(p.Amount...)
+				}
+
+				if !vestingAmt.IsZero() && !vestingAmt.IsEqual(periodsAmt) {
+					return errors.New("vesting amount must equal the sum of all vesting periods")
+				}
+
+				vestingAmt = periodsAmt
+				vestingEnd = vestingStart
+				for _, p := range vestingPeriods {
+					vestingEnd += p.Length
+				}
+			}
+
 			// create concrete account type based on input parameters
 			var genAccount types.GenesisAccount
 
@@ -99,6 +142,12 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 				}
 
 				switch {
+				case len(vestingPeriods) > 0 && vestingStart != 0:
+					genAccount = authvesting.NewPeriodicVestingAccountRaw(baseVestingAccount, vestingStart, vestingPeriods)
+
+				case len(vestingPeriods) > 0:
+					return errors.New("invalid vesting parameters; must supply start time with vesting periods")
+
 				case vestingStart != 0 && vestingEnd != 0:
 					genAccount = authvesting.NewContinuousVestingAccountRaw(baseVestingAccount, vestingStart)
 
@@ -167,6 +216,49 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
 	cmd.Flags().Uint64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
 	cmd.Flags().Uint64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
+	cmd.Flags().String(flagVestingPeriods, "", "path to a JSON file of vesting periods for periodic vesting accounts")
 
 	return cmd
 }
+
+// vestingPeriodsJSON defines the format of the file passed to --vesting-periods.
+type vestingPeriodsJSON struct {
+	Periods []struct {
+		Length int64  `json:"length"`
+		Coins  string `json:"coins"`
+	} `json:"periods"`
+}
+
+// parseVestingPeriods reads and parses the vesting periods defined in the
+// given JSON file.
+func parseVestingPeriods(path string) (authvesting.Periods, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var input vestingPeriodsJSON
+	if err := json.Unmarshal(bz, &input); err != nil {
+		return nil, err
+	}
+
+	if len(input.Periods) == 0 {
+		return nil, errors.New("no vesting periods provided")
+	}
+
+	periods := make(authvesting.Periods, len(input.Periods))
+	for i, p := range input.Periods {
+		if p.Length <= 0 {
+			return nil, fmt.Errorf("vesting period %d must have a positive length", i)
+		}
+
+		amount, err := sdk.ParseCoins(p.Coins)
+		if err != nil {
+			return nil, fmt.Errorf("invalid coins for vesting period %d: %w", i, err)
+		}
+
+		periods[i] = authvesting.Period{Length: p.Length, Amount: amount}
+	}
+
+	return periods, nil
+}
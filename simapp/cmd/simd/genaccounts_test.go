@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting"
+)
+
+func TestParseVestingPeriods(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vesting-periods")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	testCases := []struct {
+		name       string
+		contents   string
+		expPeriods authvesting.Periods
+		expErr     bool
+	}{
+		{
+			"valid periods",
+			`{"periods": [{"length": 2592000, "coins": "1000stake"}, {"length": 86400, "coins": "10atom,20stake"}]}`,
+			authvesting.Periods{
+				{Length: 2592000, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))},
+				{Length: 86400, Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 20))},
+			},
+			false,
+		},
+		{"empty file", ``, nil, true},
+		{"no periods", `{"periods": []}`, nil, true},
+		{"malformed json", `{"periods": [{"length": 10,`, nil, true},
+		{"non-positive length", `{"periods": [{"length": 0, "coins": "1000stake"}]}`, nil, true},
+		{"invalid coins", `{"periods": [{"length": 10, "coins": "1000"}]}`, nil, true},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(dir, fmt.Sprintf("periods%d.json", i))
+			require.NoError(t, ioutil.WriteFile(path, []byte(tc.contents), 0600))
+
+			periods, err := parseVestingPeriods(path)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expPeriods, periods)
+		})
+	}
+
+	_, err = parseVestingPeriods(filepath.Join(dir, "missing.json"))
+	require.Error(t, err)
+}
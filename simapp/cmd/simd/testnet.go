@@ -42,6 +42,11 @@ var (
 	flagNodeDaemonHome    = "node-daemon-home"
 	flagNodeCLIHome       = "node-cli-home"
 	flagStartingIPAddress = "starting-ip-address"
+	flagP2PPort           = "p2p-port"
+	flagRPCPort           = "rpc-port"
+	flagAccountTokens     = "account-tokens"
+	flagStakingTokens     = "staking-tokens"
+	flagValidatorTokens   = "validator-tokens"
 )
 
 // get cmd to initialize all files for tendermint testnet and application
@@ -57,8 +62,13 @@ necessary files (private validator, genesis, config, etc.).
 
 Note, strict routability for addresses is turned off in the config file.
 
+Each validator account is funded with account-tokens of its own node token
+and staking-tokens of the bond denomination, validator-tokens of which are
+self-delegated by its genesis transaction.
+
 Example:
 	simd testnet --v 4 --output-dir ./output --starting-ip-address 192.168.10.2
+	simd testnet --v 4 --starting-ip-address 127.0.0.1 --p2p-port 36656 --rpc-port 36657
 	`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			config := ctx.Config
@@ -71,10 +81,32 @@ Example:
 			nodeCLIHome := viper.GetString(flagNodeCLIHome)
 			startingIPAddress := viper.GetString(flagStartingIPAddress)
 			numValidators := viper.GetInt(flagNumValidators)
+			p2pPort := viper.GetInt(flagP2PPort)
+			rpcPort := viper.GetInt(flagRPCPort)
+
+			accTokens, err := parseTokens(flagAccountTokens)
+			if err != nil {
+				return err
+			}
+
+			stakingTokens, err := parseTokens(flagStakingTokens)
+			if err != nil {
+				return err
+			}
+
+			valTokens, err := parseTokens(flagValidatorTokens)
+			if err != nil {
+				return err
+			}
+
+			if valTokens.GT(stakingTokens) {
+				return fmt.Errorf("%s cannot exceed %s", flagValidatorTokens, flagStakingTokens)
+			}
 
 			return InitTestnet(
 				cmd, config, cdc, mbm, genBalIterator, outputDir, chainID, minGasPrices,
 				nodeDirPrefix, nodeDaemonHome, nodeCLIHome, startingIPAddress, numValidators,
+				p2pPort, rpcPort, accTokens, stakingTokens, valTokens,
 			)
 		},
 	}
@@ -90,7 +122,17 @@ Example:
 	cmd.Flags().String(flagNodeCLIHome, "simcli",
 		"Home directory of the node's cli configuration")
 	cmd.Flags().String(flagStartingIPAddress, "192.168.0.1",
-		"Starting IP address (192.168.0.1 results in persistent peers list ID0@192.168.0.1:46656, ID1@192.168.0.2:46656, ...)")
+		"Starting IP address (192.168.0.1 results in persistent peers list ID0@192.168.0.1:26656, ID1@192.168.0.2:26656, ...)")
+	cmd.Flags().Int(flagP2PPort, 26656,
+		"P2P port of each node, advertised in the persistent peers list")
+	cmd.Flags().Int(flagRPCPort, 26657,
+		"RPC port of each node")
+	cmd.Flags().String(flagAccountTokens, sdk.TokensFromConsensusPower(1000).String(),
+		"Amount of its node token each validator account starts with")
+	cmd.Flags().String(flagStakingTokens, sdk.TokensFromConsensusPower(500).String(),
+		"Amount of the bond denomination each validator account starts with")
+	cmd.Flags().String(flagValidatorTokens, sdk.TokensFromConsensusPower(100).String(),
+		"Amount of the bond denomination each validator self-delegates at genesis")
 	cmd.Flags().String(
 		flags.FlagChainID, "", "genesis file chain-id, if left blank will be randomly created")
 	cmd.Flags().String(
@@ -108,7 +150,8 @@ func InitTestnet(
 	cmd *cobra.Command, config *tmconfig.Config, cdc codec.JSONMarshaler,
 	mbm module.BasicManager, genBalIterator banktypes.GenesisBalancesIterator,
 	outputDir, chainID, minGasPrices, nodeDirPrefix, nodeDaemonHome,
-	nodeCLIHome, startingIPAddress string, numValidators int,
+	nodeCLIHome, startingIPAddress string, numValidators, p2pPort, rpcPort int,
+	accTokens, stakingTokens, valTokens sdk.Int,
 ) error {
 
 	if chainID == "" {
@@ -137,7 +180,8 @@ func InitTestnet(
 		gentxsDir := filepath.Join(outputDir, "gentxs")
 
 		config.SetRoot(nodeDir)
-		config.RPC.ListenAddress = "tcp://0.0.0.0:26657"
+		config.RPC.ListenAddress = fmt.Sprintf("tcp://0.0.0.0:%d", rpcPort)
+		config.P2P.ListenAddress = fmt.Sprintf("tcp://0.0.0.0:%d", p2pPort)
 
 		if err := os.MkdirAll(filepath.Join(nodeDir, "config"), nodeDirPerm); err != nil {
 			_ = os.RemoveAll(outputDir)
@@ -149,7 +193,7 @@ func InitTestnet(
 			return err
 		}
 
-		monikers = append(monikers, nodeDirName)
+		monikers[i] = nodeDirName
 		config.Moniker = nodeDirName
 
 		ip, err := getIP(i, startingIPAddress)
@@ -164,7 +208,7 @@ func InitTestnet(
 			return err
 		}
 
-		memo := fmt.Sprintf("%s@%s:26656", nodeIDs[i], ip)
+		memo := fmt.Sprintf("%s@%s:%d", nodeIDs[i], ip, p2pPort)
 		genFiles = append(genFiles, config.GenesisFile())
 
 		kb, err := keyring.New(
@@ -196,17 +240,14 @@ func InitTestnet(
 			return err
 		}
 
-		accTokens := sdk.TokensFromConsensusPower(1000)
-		accStakingTokens := sdk.TokensFromConsensusPower(500)
 		coins := sdk.Coins{
 			sdk.NewCoin(fmt.Sprintf("%stoken", nodeDirName), accTokens),
-			sdk.NewCoin(sdk.DefaultBondDenom, accStakingTokens),
+			sdk.NewCoin(sdk.DefaultBondDenom, stakingTokens),
 		}
 
 		genBalances = append(genBalances, banktypes.Balance{Address: addr, Coins: coins.Sort()})
 		genAccounts = append(genAccounts, auth.NewBaseAccount(addr, nil, 0, 0))
 
-		valTokens := sdk.TokensFromConsensusPower(100)
 		msg := stakingtypes.NewMsgCreateValidator(
 			sdk.ValAddress(addr),
 			valPubKeys[i],
@@ -346,6 +387,17 @@ func collectGenFiles(
 	return nil
 }
 
+// parseTokens parses the token amount of the given flag, which must be
+// positive.
+func parseTokens(flag string) (sdk.Int, error) {
+	amount, ok := sdk.NewIntFromString(viper.GetString(flag))
+	if !ok || !amount.IsPositive() {
+		return sdk.Int{}, fmt.Errorf("invalid %s: %s", flag, viper.GetString(flag))
+	}
+
+	return amount, nil
+}
+
 func getIP(i int, startingIPAddr string) (ip string, err error) {
 	if len(startingIPAddr) == 0 {
 		ip, err = server.ExternalIP()
@@ -84,7 +84,7 @@ func SetupWithGenesisAccounts(genAccs []auth.GenesisAccount, balances ...banktyp
 		totalSupply = totalSupply.Add(b.Coins...)
 	}
 
-	bankGenesis := banktypes.NewGenesisState(banktypes.DefaultGenesisState().SendEnabled, balances, totalSupply)
+	bankGenesis := banktypes.NewGenesisState(banktypes.DefaultGenesisState().SendEnabled, []banktypes.SendEnabled{}, balances, totalSupply, []banktypes.Metadata{})
 	genesisState[banktypes.ModuleName] = app.Codec().MustMarshalJSON(bankGenesis)
 
 	stateBytes, err := codec.MarshalJSONIndent(app.Codec(), genesisState)
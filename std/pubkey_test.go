@@ -11,6 +11,7 @@ import (
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/crypto/sr25519"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 )
 
@@ -34,8 +35,11 @@ func TestDefaultPublicKeyCodec(t *testing.T) {
 	pubKeySr25519 := sr25519.GenPrivKey().PubKey()
 	roundTripTest(t, pubKeySr25519)
 
+	pubKeySecp256r1 := secp256r1.GenPrivKey().PubKey()
+	roundTripTest(t, pubKeySecp256r1)
+
 	pubKeyMultisig := multisig.NewPubKeyMultisigThreshold(2, []crypto.PubKey{
-		pubKeySecp256k1, pubKeyEd25519, pubKeySr25519,
+		pubKeySecp256k1, pubKeyEd25519, pubKeySr25519, pubKeySecp256r1,
 	})
 	roundTripTest(t, pubKeyMultisig)
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"time"
 
 	ics23iavl "github.com/confio/ics23-iavl"
 	ics23 "github.com/confio/ics23/go"
@@ -17,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -34,39 +36,17 @@ var (
 
 // Store Implements types.KVStore and CommitKVStore.
 type Store struct {
-	tree    Tree
-	pruning types.PruningOptions
+	tree Tree
 }
 
 // LoadStore returns an IAVL Store as a CommitKVStore. Internally, it will load the
 // store's version (id) from the provided DB. An error is returned if the version
 // fails to load.
-func LoadStore(db dbm.DB, id types.CommitID, pruning types.PruningOptions, lazyLoading bool) (types.CommitKVStore, error) {
-	if !pruning.IsValid() {
-		return nil, fmt.Errorf("pruning options are invalid: %v", pruning)
-	}
-
-	var keepRecent int64
-
-	// Determine the value of keepRecent based on the following:
-	//
-	// If KeepEvery = 1, keepRecent should be 0 since there is no need to keep
-	// latest version in a in-memory cache.
-	//
-	// If KeepEvery > 1, keepRecent should be 1 so that state changes in between
-	// flushed states can be saved in the in-memory latest tree.
-	if pruning.KeepEvery == 1 {
-		keepRecent = 0
-	} else {
-		keepRecent = 1
-	}
-
-	tree, err := iavl.NewMutableTreeWithOpts(
-		db,
-		dbm.NewMemDB(),
-		defaultIAVLCacheSize,
-		iavl.PruningOptions(pruning.KeepEvery, keepRecent),
-	)
+//
+// Every version is flushed to disk. Pruning of old versions is driven by the
+// root multi-store through DeleteVersions.
+func LoadStore(db dbm.DB, id types.CommitID, lazyLoading bool) (types.CommitKVStore, error) {
+	tree, err := iavl.NewMutableTree(db, defaultIAVLCacheSize)
 	if err != nil {
 		return nil, err
 	}
@@ -82,8 +62,7 @@ func LoadStore(db dbm.DB, id types.CommitID, pruning types.PruningOptions, lazyL
 	}
 
 	return &Store{
-		tree:    tree,
-		pruning: pruning,
+		tree: tree,
 	}, nil
 }
 
@@ -91,12 +70,9 @@ func LoadStore(db dbm.DB, id types.CommitID, pruning types.PruningOptions, lazyL
 // IAVL tree reference. It should only be used for testing purposes.
 //
 // CONTRACT: The IAVL tree should be fully loaded.
-// CONTRACT: PruningOptions passed in as argument must be the same as pruning options
-// passed into iavl.MutableTree
-func UnsafeNewStore(tree *iavl.MutableTree, po types.PruningOptions) *Store {
+func UnsafeNewStore(tree *iavl.MutableTree) *Store {
 	return &Store{
-		tree:    tree,
-		pruning: po,
+		tree: tree,
 	}
 }
 
@@ -116,35 +92,33 @@ func (st *Store) GetImmutable(version int64) (*Store, error) {
 	}
 
 	return &Store{
-		tree:    &immutableTree{iTree},
-		pruning: st.pruning,
+		tree: &immutableTree{iTree},
 	}, nil
 }
 
+// LoadVersionForOverwriting loads the given version of the store and deletes
+// every later version, so that the next Commit saves targetVersion+1 again.
+// It returns an error if the store is backed by an immutable IAVL tree.
+func (st *Store) LoadVersionForOverwriting(targetVersion int64) (int64, error) {
+	tree, ok := st.tree.(*iavl.MutableTree)
+	if !ok {
+		return 0, fmt.Errorf("cannot overwrite versions of an immutable IAVL tree")
+	}
+
+	return tree.LoadVersionForOverwriting(targetVersion)
+}
+
 // Commit commits the current store state and returns a CommitID with the new
 // version and hash.
 func (st *Store) Commit() types.CommitID {
+	defer telemetry.MeasureSince(time.Now(), "store", "iavl", "commit")
+
 	hash, version, err := st.tree.SaveVersion()
 	if err != nil {
 		// TODO: Do we want to extend Commit to allow returning errors?
 		panic(err)
 	}
 
-	// If the version we saved got flushed to disk, check if previous flushed
-	// version should be deleted.
-	if st.pruning.FlushVersion(version) {
-		previous := version - st.pruning.KeepEvery
-
-		// Previous flushed version should only be pruned if the previous version is
-		// not a snapshot version OR if snapshotting is disabled (SnapshotEvery == 0).
-		if previous != 0 && !st.pruning.SnapshotVersion(previous) {
-			err := st.tree.DeleteVersion(previous)
-			if errCause := errors.Cause(err); errCause != nil && errCause != iavl.ErrVersionDoesNotExist {
-				panic(err)
-			}
-		}
-	}
-
 	return types.CommitID{
 		Version: version,
 		Hash:    hash,
@@ -165,6 +139,20 @@ func (st *Store) SetPruning(_ types.PruningOptions) {
 	panic("cannot set pruning options on an initialized IAVL store")
 }
 
+// DeleteVersions deletes a series of versions from the MutableTree. Versions
+// that do not exist, e.g. because they have already been deleted, are skipped.
+// An error is returned if any other version fails to delete.
+func (st *Store) DeleteVersions(versions ...int64) error {
+	for _, version := range versions {
+		err := st.tree.DeleteVersion(version)
+		if errCause := errors.Cause(err); errCause != nil && errCause != iavl.ErrVersionDoesNotExist {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // VersionExists returns whether or not a given version is stored.
 func (st *Store) VersionExists(version int64) bool {
 	return st.tree.VersionExists(version)
@@ -187,23 +175,27 @@ func (st *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.Ca
 
 // Implements types.KVStore.
 func (st *Store) Set(key, value []byte) {
+	defer telemetry.MeasureSince(time.Now(), "store", "iavl", "set")
 	types.AssertValidValue(value)
 	st.tree.Set(key, value)
 }
 
 // Implements types.KVStore.
 func (st *Store) Get(key []byte) []byte {
+	defer telemetry.MeasureSince(time.Now(), "store", "iavl", "get")
 	_, value := st.tree.Get(key)
 	return value
 }
 
 // Implements types.KVStore.
 func (st *Store) Has(key []byte) (exists bool) {
+	defer telemetry.MeasureSince(time.Now(), "store", "iavl", "has")
 	return st.tree.Has(key)
 }
 
 // Implements types.KVStore.
 func (st *Store) Delete(key []byte) {
+	defer telemetry.MeasureSince(time.Now(), "store", "iavl", "delete")
 	st.tree.Remove(key)
 }
 
@@ -257,6 +249,8 @@ func getHeight(tree Tree, req abci.RequestQuery) int64 {
 // if you care to have the latest data to see a tx results, you must
 // explicitly set the height you want to see
 func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
+	defer telemetry.MeasureSince(time.Now(), "store", "iavl", "query")
+
 	if len(req.Data) == 0 {
 		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrTxDecode, "query cannot be zero length"))
 	}
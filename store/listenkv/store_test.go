@@ -0,0 +1,74 @@
+package listenkv_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var testStoreKey = types.NewKVStoreKey("listen_test")
+
+func newListenKVStore(buf *bytes.Buffer) *listenkv.Store {
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+	listener := types.NewStoreKVPairWriteListener(buf)
+
+	return listenkv.NewStore(memDB, testStoreKey, []types.WriteListener{listener})
+}
+
+func decodeKVPairs(t *testing.T, buf *bytes.Buffer) []types.StoreKVPair {
+	var pairs []types.StoreKVPair
+
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var pair types.StoreKVPair
+		require.NoError(t, dec.Decode(&pair))
+		pairs = append(pairs, pair)
+	}
+
+	return pairs
+}
+
+func TestListenKVStoreSetDelete(t *testing.T) {
+	var buf bytes.Buffer
+	store := newListenKVStore(&buf)
+
+	store.Set([]byte("key1"), []byte("value1"))
+	store.Delete([]byte("key1"))
+	require.Nil(t, store.Get([]byte("key1")))
+
+	// reads are not reported to the listeners
+	store.Has([]byte("key1"))
+
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: []byte("key1"), Value: []byte("value1")},
+		{StoreKey: testStoreKey.Name(), Delete: true, Key: []byte("key1")},
+	}, decodeKVPairs(t, &buf))
+}
+
+func TestListenKVStoreCacheWrap(t *testing.T) {
+	var buf bytes.Buffer
+	store := newListenKVStore(&buf)
+
+	cache := store.CacheWrap().(types.CacheKVStore)
+	cache.Set([]byte("key1"), []byte("value1"))
+	require.Zero(t, buf.Len(), "cached writes must not reach the listeners before Write")
+
+	cache.Write()
+	require.Equal(t, []byte("value1"), store.Get([]byte("key1")))
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: []byte("key1"), Value: []byte("value1")},
+	}, decodeKVPairs(t, &buf))
+}
+
+func TestListenKVStoreGetStoreType(t *testing.T) {
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+	store := listenkv.NewStore(memDB, testStoreKey, nil)
+	require.Equal(t, memDB.GetStoreType(), store.GetStoreType())
+}
@@ -31,7 +31,11 @@ type (
 )
 
 var (
+	PruneDefault    = types.PruneDefault
 	PruneNothing    = types.PruneNothing
 	PruneEverything = types.PruneEverything
-	PruneSyncable   = types.PruneSyncable
+	PruneSyncable   = types.PruneSyncable // Deprecated: use PruneDefault.
+
+	NewPruningOptions           = types.NewPruningOptions
+	NewPruningOptionsFromString = types.NewPruningOptionsFromString
 )
@@ -25,3 +25,20 @@ func DefaultProofRuntime() (prt *merkle.ProofRuntime) {
 	prt.RegisterOpDecoder(storetypes.ProofOpSimpleMerkleCommitment, storetypes.CommitmentOpDecoder)
 	return
 }
+
+// VerifyProof verifies a proof returned by a multistore query with prove=true
+// against the given app hash. The proof must chain the substore commitment op
+// with the multistore simple-merkle op. A nil value verifies the absence of the
+// key in the given store, otherwise its membership with the given value.
+func VerifyProof(proof *merkle.Proof, appHash []byte, storeName string, key, value []byte) error {
+	kp := merkle.KeyPath{}
+	kp = kp.AppendKey([]byte(storeName), merkle.KeyEncodingURL)
+	kp = kp.AppendKey(key, merkle.KeyEncodingURL)
+
+	prt := DefaultProofRuntime()
+	if value == nil {
+		return prt.VerifyAbsence(proof, appHash, kp.String())
+	}
+
+	return prt.VerifyValue(proof, appHash, kp.String(), value)
+}
@@ -14,7 +14,7 @@ import (
 func TestVerifyIAVLStoreQueryProof(t *testing.T) {
 	// Create main tree for testing.
 	db := dbm.NewMemDB()
-	iStore, err := iavl.LoadStore(db, types.CommitID{}, types.PruneNothing, false)
+	iStore, err := iavl.LoadStore(db, types.CommitID{}, false)
 	store := iStore.(*iavl.Store)
 	require.Nil(t, err)
 	store.Set([]byte("MYKEY"), []byte("MYVALUE"))
@@ -146,3 +146,38 @@ func TestVerifyMultiStoreQueryProofAbsence(t *testing.T) {
 	err = prt.VerifyValue(res.Proof, cid.Hash, "/iavlStoreKey/MYABSENTKEY", []byte(""))
 	require.NotNil(t, err)
 }
+
+func TestVerifyProof(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := NewStore(db)
+	iavlStoreKey := types.NewKVStoreKey("iavlStoreKey")
+
+	store.MountStoreWithDB(iavlStoreKey, types.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadVersion(0))
+
+	iavlStore := store.GetCommitStore(iavlStoreKey).(*iavl.Store)
+	iavlStore.Set([]byte("MYKEY"), []byte("MYVALUE"))
+	cid := store.Commit()
+
+	res := store.Query(abci.RequestQuery{
+		Path:  "/iavlStoreKey/key",
+		Data:  []byte("MYKEY"),
+		Prove: true,
+	})
+	require.NotNil(t, res.Proof)
+
+	require.NoError(t, VerifyProof(res.Proof, cid.Hash, "iavlStoreKey", []byte("MYKEY"), []byte("MYVALUE")))
+	require.Error(t, VerifyProof(res.Proof, cid.Hash, "iavlStoreKey", []byte("MYKEY"), []byte("MYVALUE_NOT")))
+	require.Error(t, VerifyProof(res.Proof, cid.Hash, "otherStoreKey", []byte("MYKEY"), []byte("MYVALUE")))
+	require.Error(t, VerifyProof(res.Proof, []byte("badhash"), "iavlStoreKey", []byte("MYKEY"), []byte("MYVALUE")))
+
+	res = store.Query(abci.RequestQuery{
+		Path:  "/iavlStoreKey/key",
+		Data:  []byte("MYABSENTKEY"),
+		Prove: true,
+	})
+	require.NotNil(t, res.Proof)
+
+	require.NoError(t, VerifyProof(res.Proof, cid.Hash, "iavlStoreKey", []byte("MYABSENTKEY"), nil))
+	require.Error(t, VerifyProof(res.Proof, cid.Hash, "iavlStoreKey", []byte("MYKEY"), nil))
+}
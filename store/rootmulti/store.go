@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/cachemulti"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
 	"github.com/cosmos/cosmos-sdk/store/mem"
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/transient"
@@ -26,6 +27,7 @@ import (
 
 const (
 	latestVersionKey = "s/latest"
+	pruneHeightsKey  = "s/pruneheights"
 	commitInfoKeyFmt = "s/%d" // s/<version>
 )
 
@@ -42,11 +44,14 @@ type Store struct {
 	stores         map[types.StoreKey]types.CommitKVStore
 	keysByName     map[string]types.StoreKey
 	lazyLoading    bool
+	pruneHeights   []int64
 
 	traceWriter  io.Writer
 	traceContext types.TraceContext
 
 	interBlockCache types.MultiStorePersistentCache
+
+	listeners map[types.StoreKey][]types.WriteListener
 }
 
 var _ types.CommitMultiStore = (*Store)(nil)
@@ -63,20 +68,16 @@ func NewStore(db dbm.DB) *Store {
 		storesParams: make(map[types.StoreKey]storeParams),
 		stores:       make(map[types.StoreKey]types.CommitKVStore),
 		keysByName:   make(map[string]types.StoreKey),
+		pruneHeights: make([]int64, 0),
+		listeners:    make(map[types.StoreKey][]types.WriteListener),
 	}
 }
 
-// SetPruning sets the pruning strategy on the root store and all the sub-stores.
-// Note, calling SetPruning on the root store prior to LoadVersion or
-// LoadLatestVersion performs a no-op as the stores aren't mounted yet.
-//
-// TODO: Consider removing this API altogether on sub-stores as a pruning
-// strategy should only be provided on initialization.
+// SetPruning sets the pruning strategy on the root store. Pruning is driven by
+// the root store on Commit so that the same heights are removed from every
+// IAVL sub-store.
 func (rs *Store) SetPruning(pruningOpts types.PruningOptions) {
 	rs.pruningOpts = pruningOpts
-	for _, substore := range rs.stores {
-		substore.SetPruning(pruningOpts)
-	}
 }
 
 // SetLazyLoading sets if the iavl store should be loaded lazily or not
@@ -208,9 +209,53 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 	rs.lastCommitInfo = cInfo
 	rs.stores = newStores
 
+	// load any pruned heights we missed from disk to be pruned on the next run
+	ph, err := getPruningHeights(rs.db)
+	if err != nil {
+		return err
+	}
+
+	if len(ph) > 0 {
+		rs.pruneHeights = ph
+	}
+
 	return nil
 }
 
+// RollbackToVersion deletes every version of the IAVL stores above target and
+// makes target the latest version of the multi-store, so that the next Commit
+// saves target+1 again. It is meant to recover a node which committed a state
+// that must be recomputed, and reloads the stores once done.
+func (rs *Store) RollbackToVersion(target int64) error {
+	if target <= 0 {
+		return fmt.Errorf("invalid rollback height target: %d", target)
+	}
+
+	cInfo, err := getCommitInfo(rs.db, target)
+	if err != nil {
+		return err
+	}
+
+	for key, params := range rs.storesParams {
+		if params.typ != types.StoreTypeIAVL {
+			continue
+		}
+
+		store, err := iavl.LoadStore(rs.storeDB(params), types.CommitID{Version: target}, false)
+		if err != nil {
+			return errors.Wrapf(err, "failed to load store %s", key.Name())
+		}
+
+		if _, err := store.(*iavl.Store).LoadVersionForOverwriting(target); err != nil {
+			return errors.Wrapf(err, "failed to roll back store %s", key.Name())
+		}
+	}
+
+	flushMetadata(rs.db, target, cInfo, rs.pruneHeights)
+
+	return rs.LoadLatestVersion()
+}
+
 func (rs *Store) getCommitID(infos map[string]storeInfo, name string) types.CommitID {
 	info, ok := infos[name]
 	if !ok {
@@ -284,6 +329,16 @@ func (rs *Store) TracingEnabled() bool {
 	return rs.traceWriter != nil
 }
 
+// AddListeners adds listeners for a specific KVStore
+func (rs *Store) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	rs.listeners[key] = append(rs.listeners[key], listeners...)
+}
+
+// ListeningEnabled returns if listening is enabled for a specific KVStore
+func (rs *Store) ListeningEnabled(key types.StoreKey) bool {
+	return len(rs.listeners[key]) != 0
+}
+
 //----------------------------------------
 // +CommitStore
 
@@ -294,16 +349,29 @@ func (rs *Store) LastCommitID() types.CommitID {
 
 // Commit implements Committer/CommitStore.
 func (rs *Store) Commit() types.CommitID {
-
-	// Commit stores.
-	version := rs.lastCommitInfo.Version + 1
+	previousHeight := rs.lastCommitInfo.Version
+	version := previousHeight + 1
 	rs.lastCommitInfo = commitStores(version, rs.stores)
 
-	// write CommitInfo to disk only if this version was flushed to disk
-	if rs.pruningOpts.FlushVersion(version) {
-		flushCommitInfo(rs.db, version, rs.lastCommitInfo)
+	// Determine if pruneHeight height needs to be added to the list of heights to
+	// be pruned, where pruneHeight = (commitHeight - 1) - KeepRecent.
+	if int64(rs.pruningOpts.KeepRecent) < previousHeight {
+		pruneHeight := previousHeight - int64(rs.pruningOpts.KeepRecent)
+
+		// We consider this height to be pruned iff it is not a height kept by
+		// KeepEvery, see PruningOptions.PruneHeight.
+		if rs.pruningOpts.PruneHeight(pruneHeight) {
+			rs.pruneHeights = append(rs.pruneHeights, pruneHeight)
+		}
 	}
 
+	// batch prune if the current height is a pruning interval height
+	if rs.pruningOpts.Interval > 0 && version%int64(rs.pruningOpts.Interval) == 0 {
+		rs.pruneStores()
+	}
+
+	flushMetadata(rs.db, version, rs.lastCommitInfo, rs.pruneHeights)
+
 	// Prepare for next version.
 	commitID := types.CommitID{
 		Version: version,
@@ -312,6 +380,28 @@ func (rs *Store) Commit() types.CommitID {
 	return commitID
 }
 
+// pruneStores will batch delete a list of heights from each mounted sub-store.
+// Afterwards, pruneHeights is reset.
+func (rs *Store) pruneStores() {
+	if len(rs.pruneHeights) == 0 {
+		return
+	}
+
+	for key, store := range rs.stores {
+		if store.GetStoreType() == types.StoreTypeIAVL {
+			// If the store is wrapped with an inter-block cache, we must first unwrap
+			// it to get the underlying IAVL store.
+			store = rs.GetCommitKVStore(key)
+
+			if err := store.(*iavl.Store).DeleteVersions(rs.pruneHeights...); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	rs.pruneHeights = make([]int64, 0)
+}
+
 // CacheWrap implements CacheWrapper/Store/CommitStore.
 func (rs *Store) CacheWrap() types.CacheWrap {
 	return rs.CacheMultiStore().(types.CacheWrap)
@@ -330,7 +420,12 @@ func (rs *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.Cac
 func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range rs.stores {
-		stores[k] = v
+		var store types.CacheWrapper = v
+		if rs.ListeningEnabled(k) {
+			store = listenkv.NewStore(v, k, rs.listeners[k])
+		}
+
+		stores[k] = store
 	}
 
 	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext)
@@ -394,6 +489,10 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 		store = tracekv.NewStore(store, rs.traceWriter, rs.traceContext)
 	}
 
+	if rs.ListeningEnabled(key) {
+		store = listenkv.NewStore(store, key, rs.listeners[key])
+	}
+
 	return store
 }
 
@@ -484,23 +583,26 @@ func parsePath(path string) (storeName string, subpath string, err error) {
 }
 
 //----------------------------------------
-// Note: why do we use key and params.key in different places. Seems like there should be only one key used.
-func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
-	var db dbm.DB
-
+// storeDB returns the database of the store with the given params.
+func (rs *Store) storeDB(params storeParams) dbm.DB {
 	if params.db != nil {
-		db = dbm.NewPrefixDB(params.db, []byte("s/_/"))
-	} else {
-		prefix := "s/k:" + params.key.Name() + "/"
-		db = dbm.NewPrefixDB(rs.db, []byte(prefix))
+		return dbm.NewPrefixDB(params.db, []byte("s/_/"))
 	}
 
+	prefix := "s/k:" + params.key.Name() + "/"
+	return dbm.NewPrefixDB(rs.db, []byte(prefix))
+}
+
+// Note: why do we use key and params.key in different places. Seems like there should be only one key used.
+func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
+	db := rs.storeDB(params)
+
 	switch params.typ {
 	case types.StoreTypeMulti:
 		panic("recursive MultiStores not yet supported")
 
 	case types.StoreTypeIAVL:
-		store, err := iavl.LoadStore(db, id, rs.pruningOpts, rs.lazyLoading)
+		store, err := iavl.LoadStore(db, id, rs.lazyLoading)
 		if err != nil {
 			return nil, err
 		}
@@ -666,7 +768,8 @@ func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore
 	for key, store := range storeMap {
 		commitID := store.Commit()
 
-		if store.GetStoreType() == types.StoreTypeTransient {
+		// transient and memory stores are never part of the committed app state
+		if typ := store.GetStoreType(); typ == types.StoreTypeTransient || typ == types.StoreTypeMemory {
 			continue
 		}
 
@@ -711,16 +814,41 @@ func setCommitInfo(batch dbm.Batch, version int64, cInfo commitInfo) {
 	batch.Set([]byte(cInfoKey), cInfoBytes)
 }
 
-// flushCommitInfo flushes a commitInfo for given version to the DB. Note, this
-// needs to happen atomically.
-func flushCommitInfo(db dbm.DB, version int64, cInfo commitInfo) {
+// Set the heights that are still to be pruned.
+func setPruningHeights(batch dbm.Batch, pruneHeights []int64) {
+	bz := cdc.MustMarshalBinaryBare(pruneHeights)
+	batch.Set([]byte(pruneHeightsKey), bz)
+}
+
+// Gets the heights that are still to be pruned from disk.
+func getPruningHeights(db dbm.DB) ([]int64, error) {
+	bz, err := db.Get([]byte(pruneHeightsKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get pruned heights")
+	} else if len(bz) == 0 {
+		return nil, nil
+	}
+
+	var pruneHeights []int64
+	if err := cdc.UnmarshalBinaryBare(bz, &pruneHeights); err != nil {
+		return nil, errors.Wrap(err, "failed to get pruned heights")
+	}
+
+	return pruneHeights, nil
+}
+
+// flushMetadata flushes the commitInfo, latest version and pending pruning
+// heights for the given version to the DB. Note, this needs to happen
+// atomically.
+func flushMetadata(db dbm.DB, version int64, cInfo commitInfo, pruneHeights []int64) {
 	batch := db.NewBatch()
 	defer batch.Close()
 
 	setCommitInfo(batch, version, cInfo)
 	setLatestVersion(batch, version)
-	err := batch.Write()
-	if err != nil {
+	setPruningHeights(batch, pruneHeights)
+
+	if err := batch.Write(); err != nil {
 		panic(fmt.Errorf("error on batch write %w", err))
 	}
 }
@@ -21,7 +21,7 @@ func TestStoreType(t *testing.T) {
 
 func TestGetCommitKVStore(t *testing.T) {
 	var db dbm.DB = dbm.NewMemDB()
-	ms := newMultiStoreWithMounts(db, types.PruneSyncable)
+	ms := newMultiStoreWithMounts(db, types.PruneDefault)
 	err := ms.LoadLatestVersion()
 	require.Nil(t, err)
 
@@ -113,6 +113,40 @@ func TestHashStableWithEmptyCommit(t *testing.T) {
 	require.Equal(t, hash, cID.Hash)
 }
 
+func TestTransientAndMemoryStoresNotCommitted(t *testing.T) {
+	var db dbm.DB = dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+
+	tKey := types.NewTransientStoreKey("transient")
+	mKey := types.NewMemoryStoreKey("memory")
+	ms.MountStoreWithDB(tKey, types.StoreTypeTransient, nil)
+	ms.MountStoreWithDB(mKey, types.StoreTypeMemory, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	// the same state without any ephemeral stores
+	expected := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, expected.LoadLatestVersion())
+
+	k, v := []byte("wind"), []byte("blows")
+	ms.getStoreByName("store1").(types.KVStore).Set(k, v)
+	expected.getStoreByName("store1").(types.KVStore).Set(k, v)
+
+	ms.GetKVStore(tKey).Set(k, v)
+	ms.GetKVStore(mKey).Set(k, v)
+
+	cID := ms.Commit()
+	require.Equal(t, expected.Commit(), cID)
+
+	// transient state is reset on commit while memory state is retained
+	require.Nil(t, ms.GetKVStore(tKey).Get(k))
+	require.Equal(t, v, ms.GetKVStore(mKey).Get(k))
+
+	for _, si := range ms.lastCommitInfo.StoreInfos {
+		require.NotEqual(t, tKey.Name(), si.Name)
+		require.NotEqual(t, mKey.Name(), si.Name)
+	}
+}
+
 func TestMultistoreCommitLoad(t *testing.T) {
 	var db dbm.DB = dbm.NewMemDB()
 	store := newMultiStoreWithMounts(db, types.PruneNothing)
@@ -166,13 +200,46 @@ func TestMultistoreCommitLoad(t *testing.T) {
 
 	// XXX: confirm old commit is overwritten and we have rolled back
 	// LatestVersion
-	store = newMultiStoreWithMounts(db, types.PruneSyncable)
+	store = newMultiStoreWithMounts(db, types.PruneDefault)
 	err = store.LoadLatestVersion()
 	require.Nil(t, err)
 	commitID = getExpectedCommitID(store, ver+1)
 	checkStore(t, store, commitID, commitID)
 }
 
+func TestMultistoreRollback(t *testing.T) {
+	var db dbm.DB = dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+
+	key := []byte("key")
+	commitIDs := make([]types.CommitID, 3)
+
+	for i := range commitIDs {
+		store.getStoreByName("store1").(types.KVStore).Set(key, []byte(fmt.Sprintf("value%d", i+1)))
+		commitIDs[i] = store.Commit()
+	}
+
+	require.Error(t, store.RollbackToVersion(0))
+	require.Error(t, store.RollbackToVersion(4))
+
+	require.NoError(t, store.RollbackToVersion(2))
+	checkStore(t, store, commitIDs[1], commitIDs[1])
+	require.Equal(t, int64(2), getLatestVersion(db))
+	require.Equal(t, []byte("value2"), store.getStoreByName("store1").(types.KVStore).Get(key))
+
+	// a different version 3 can be committed and survives a restart
+	store.getStoreByName("store1").(types.KVStore).Set(key, []byte("other"))
+	commitID := store.Commit()
+	require.Equal(t, int64(3), commitID.Version)
+	require.NotEqual(t, commitIDs[2], commitID)
+
+	store = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+	checkStore(t, store, commitID, commitID)
+	require.Equal(t, []byte("other"), store.getStoreByName("store1").(types.KVStore).Get(key))
+}
+
 func TestMultistoreLoadWithUpgrade(t *testing.T) {
 	var db dbm.DB = dbm.NewMemDB()
 	store := newMultiStoreWithMounts(db, types.PruneNothing)
@@ -292,10 +359,7 @@ func TestParsePath(t *testing.T) {
 
 func TestMultiStoreRestart(t *testing.T) {
 	db := dbm.NewMemDB()
-	pruning := types.PruningOptions{
-		KeepEvery:     3,
-		SnapshotEvery: 6,
-	}
+	pruning := types.NewPruningOptions(2, 3, 1)
 	multi := newMultiStoreWithMounts(db, pruning)
 	err := multi.LoadLatestVersion()
 	require.Nil(t, err)
@@ -322,8 +386,8 @@ func TestMultiStoreRestart(t *testing.T) {
 		multi.Commit()
 
 		cinfo, err := getCommitInfo(multi.db, int64(i))
-		require.NotNil(t, err)
-		require.Equal(t, commitInfo{}, cinfo)
+		require.NoError(t, err)
+		require.Equal(t, int64(i), cinfo.Version)
 	}
 
 	// Set and commit data in one store.
@@ -347,15 +411,15 @@ func TestMultiStoreRestart(t *testing.T) {
 	multi.Commit()
 
 	postFlushCinfo, err := getCommitInfo(multi.db, 4)
-	require.NotNil(t, err)
-	require.Equal(t, commitInfo{}, postFlushCinfo, "Commit changed after in-memory commit")
+	require.NoError(t, err)
+	require.Equal(t, int64(4), postFlushCinfo.Version, "Commit changed after in-memory commit")
 
 	multi = newMultiStoreWithMounts(db, pruning)
 	err = multi.LoadLatestVersion()
 	require.Nil(t, err)
 
 	reloadedCid := multi.LastCommitID()
-	require.Equal(t, flushedCinfo.CommitID(), reloadedCid, "Reloaded CID is not the same as last flushed CID")
+	require.Equal(t, postFlushCinfo.CommitID(), reloadedCid, "Reloaded CID is not the same as last flushed CID")
 
 	// Check that store1 and store2 retained date from 3rd commit
 	store1 = multi.getStoreByName("store1").(types.KVStore)
@@ -366,10 +430,84 @@ func TestMultiStoreRestart(t *testing.T) {
 	val2 := store2.Get([]byte(k2))
 	require.Equal(t, []byte(fmt.Sprintf("%s:%d", v2, 3)), val2, "Reloaded value not the same as last flushed value")
 
-	// Check that store3 still has data from last commit even though update happened on 2nd commit
+	// Check that store3 has data from the last commit
 	store3 = multi.getStoreByName("store3").(types.KVStore)
 	val3 := store3.Get([]byte(k3))
-	require.Equal(t, []byte(fmt.Sprintf("%s:%d", v3, 2)), val3, "Reloaded value not the same as last flushed value")
+	require.Equal(t, []byte(fmt.Sprintf("%s:%d", v3, 3)), val3, "Reloaded value not the same as last flushed value")
+}
+
+func TestMultiStore_Pruning(t *testing.T) {
+	testCases := []struct {
+		name        string
+		numVersions int64
+		po          types.PruningOptions
+		deleted     []int64
+		saved       []int64
+	}{
+		{"prune nothing", 10, types.PruneNothing, nil, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+		{"prune everything", 10, types.PruneEverything, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9}, []int64{10}},
+		{"prune some; no batch", 10, types.NewPruningOptions(2, 3, 1), []int64{1, 2, 4, 5, 7}, []int64{3, 6, 8, 9, 10}},
+		{"prune some; small batch", 10, types.NewPruningOptions(2, 3, 3), []int64{1, 2, 4, 5}, []int64{3, 6, 7, 8, 9, 10}},
+		{"prune some; large batch", 10, types.NewPruningOptions(2, 3, 11), nil, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			db := dbm.NewMemDB()
+			ms := newMultiStoreWithMounts(db, tc.po)
+			require.NoError(t, ms.LoadLatestVersion())
+
+			for i := int64(0); i < tc.numVersions; i++ {
+				ms.Commit()
+			}
+
+			for _, v := range tc.saved {
+				_, err := ms.CacheMultiStoreWithVersion(v)
+				require.NoError(t, err, "expected error when loading height: %d", v)
+			}
+
+			for _, v := range tc.deleted {
+				_, err := ms.CacheMultiStoreWithVersion(v)
+				require.Error(t, err, "expected error when loading height: %d", v)
+			}
+		})
+	}
+}
+
+func TestMultiStore_PruningRestart(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.NewPruningOptions(2, 3, 11))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	// Commit enough to build up heights to prune, where on the next block we should
+	// batch delete.
+	for i := int64(0); i < 10; i++ {
+		ms.Commit()
+	}
+
+	pruneHeights := []int64{1, 2, 4, 5, 7}
+
+	// ensure we've persisted the current batch of heights to prune to the store's DB
+	ph, err := getPruningHeights(ms.db)
+	require.NoError(t, err)
+	require.Equal(t, pruneHeights, ph)
+
+	// "restart"
+	ms = newMultiStoreWithMounts(db, types.NewPruningOptions(2, 3, 11))
+	err = ms.LoadLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, pruneHeights, ms.pruneHeights)
+
+	// commit one more block and ensure the heights have been pruned
+	ms.Commit()
+	require.Empty(t, ms.pruneHeights)
+
+	for _, v := range pruneHeights {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.Error(t, err, "expected error when loading height: %d", v)
+	}
 }
 
 func TestMultiStoreQuery(t *testing.T) {
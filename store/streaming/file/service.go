@@ -0,0 +1,127 @@
+package file
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ baseapp.StreamingService = (*StreamingService)(nil)
+
+// StreamingService is a baseapp.StreamingService that writes, for every block,
+// the ABCI requests and responses together with the state changes of the
+// listened KVStores to a file named "block-{height}" in the write directory.
+//
+// Every line of a block file is a JSON object. ABCI messages are written as
+// {"type": ..., "request": ..., "response": ...} and state changes as
+// types.StoreKVPair. State changes are only flushed to the listeners when the
+// block is committed, so they appear right before the commit entry.
+type StreamingService struct {
+	mtx       sync.Mutex
+	listeners map[types.StoreKey][]types.WriteListener
+	writeDir  string
+	buf       *bytes.Buffer
+}
+
+// abciMessage is a line of a block file holding an ABCI request and response
+type abciMessage struct {
+	Type     string      `json:"type"`
+	Request  interface{} `json:"request,omitempty"`
+	Response interface{} `json:"response"`
+}
+
+// NewStreamingService creates a new StreamingService writing to writeDir and
+// listening to the state changes of the given store keys.
+func NewStreamingService(writeDir string, storeKeys []types.StoreKey) (*StreamingService, error) {
+	if err := os.MkdirAll(writeDir, 0755); err != nil {
+		return nil, err
+	}
+
+	ss := &StreamingService{
+		listeners: make(map[types.StoreKey][]types.WriteListener, len(storeKeys)),
+		writeDir:  writeDir,
+		buf:       new(bytes.Buffer),
+	}
+
+	listener := types.NewStoreKVPairWriteListener(&lockedWriter{ss: ss})
+	for _, key := range storeKeys {
+		ss.listeners[key] = []types.WriteListener{listener}
+	}
+
+	return ss, nil
+}
+
+// Listeners implements the baseapp.StreamingService interface
+func (ss *StreamingService) Listeners() map[sdk.StoreKey][]sdk.WriteListener {
+	return ss.listeners
+}
+
+// ListenBeginBlock implements the baseapp.ABCIListener interface
+func (ss *StreamingService) ListenBeginBlock(_ sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	return ss.writeMessage("begin_block", req, res)
+}
+
+// ListenDeliverTx implements the baseapp.ABCIListener interface
+func (ss *StreamingService) ListenDeliverTx(_ sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	return ss.writeMessage("deliver_tx", req, res)
+}
+
+// ListenEndBlock implements the baseapp.ABCIListener interface
+func (ss *StreamingService) ListenEndBlock(_ sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return ss.writeMessage("end_block", req, res)
+}
+
+// ListenCommit implements the baseapp.ABCIListener interface. It writes the
+// buffered messages of the block to its file and resets the buffer.
+func (ss *StreamingService) ListenCommit(ctx sdk.Context, res abci.ResponseCommit) error {
+	if err := ss.writeMessage("commit", nil, res); err != nil {
+		return err
+	}
+
+	ss.mtx.Lock()
+	defer ss.mtx.Unlock()
+
+	fileName := filepath.Join(ss.writeDir, fmt.Sprintf("block-%d", ctx.BlockHeight()))
+	err := ioutil.WriteFile(fileName, ss.buf.Bytes(), 0600)
+	ss.buf.Reset()
+
+	return err
+}
+
+// Close implements the io.Closer interface
+func (ss *StreamingService) Close() error {
+	return nil
+}
+
+func (ss *StreamingService) writeMessage(msgType string, req, res interface{}) error {
+	bz, err := json.Marshal(abciMessage{Type: msgType, Request: req, Response: res})
+	if err != nil {
+		return err
+	}
+
+	_, err = (&lockedWriter{ss: ss}).Write(append(bz, '\n'))
+	return err
+}
+
+// lockedWriter writes to the block buffer of a StreamingService while holding
+// its lock
+type lockedWriter struct {
+	ss *StreamingService
+}
+
+func (w *lockedWriter) Write(p []byte) (int, error) {
+	w.ss.mtx.Lock()
+	defer w.ss.mtx.Unlock()
+
+	return w.ss.buf.Write(p)
+}
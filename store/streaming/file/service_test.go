@@ -0,0 +1,69 @@
+package file_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestStreamingService(t *testing.T) {
+	dir, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	keyA := types.NewKVStoreKey("a")
+	keyB := types.NewKVStoreKey("b")
+
+	ss, err := file.NewStreamingService(dir, []types.StoreKey{keyA})
+	require.NoError(t, err)
+	defer ss.Close()
+
+	listeners := ss.Listeners()
+	require.Len(t, listeners, 1)
+	require.Len(t, listeners[keyA], 1)
+	require.Nil(t, listeners[keyB])
+
+	ctx := sdk.Context{}.WithBlockHeight(7)
+	require.NoError(t, ss.ListenBeginBlock(ctx, abci.RequestBeginBlock{}, abci.ResponseBeginBlock{}))
+	require.NoError(t, ss.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: []byte("tx")}, abci.ResponseDeliverTx{Code: 1}))
+	require.NoError(t, ss.ListenEndBlock(ctx, abci.RequestEndBlock{Height: 7}, abci.ResponseEndBlock{}))
+	require.NoError(t, listeners[keyA][0].OnWrite(keyA, []byte("key"), []byte("value"), false))
+	require.NoError(t, ss.ListenCommit(ctx, abci.ResponseCommit{Data: []byte("hash")}))
+
+	f, err := os.Open(filepath.Join(dir, "block-7"))
+	require.NoError(t, err)
+	defer f.Close()
+
+	var lines []map[string]interface{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var line map[string]interface{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+	require.NoError(t, scanner.Err())
+
+	require.Len(t, lines, 5)
+	require.Equal(t, "begin_block", lines[0]["type"])
+	require.Equal(t, "deliver_tx", lines[1]["type"])
+	require.Equal(t, "end_block", lines[2]["type"])
+	require.Equal(t, "a", lines[3]["store_key"])
+	require.Equal(t, "commit", lines[4]["type"])
+
+	// the buffer is reset after every commit
+	require.NoError(t, ss.ListenCommit(ctx.WithBlockHeight(8), abci.ResponseCommit{}))
+	bz, err := ioutil.ReadFile(filepath.Join(dir, "block-8"))
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"type":"commit"`)
+	require.NotContains(t, string(bz), "store_key")
+}
@@ -0,0 +1,95 @@
+// Package streaming registers the state streaming service selected by the
+// [streaming] section of app.toml with a BaseApp.
+//
+// The only service available is the file streaming service of the file
+// package. There is no gRPC sink; consumers that need one can implement
+// baseapp.StreamingService and register it with BaseApp.SetStreamingService.
+package streaming
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const (
+	// FileService is the name of the file streaming service
+	FileService = "file"
+
+	// AllStores is the store key name that selects every KVStore
+	AllStores = "*"
+)
+
+// LoadStreamingService creates the streaming service selected by cfg, listening
+// to the stores of keys named in cfg.Keys, and registers it with bApp. It
+// returns a nil service if streaming is disabled.
+func LoadStreamingService(
+	bApp *baseapp.BaseApp, cfg config.StreamingConfig, homePath string, keys map[string]*types.KVStoreKey,
+) (baseapp.StreamingService, error) {
+	switch cfg.Service {
+	case "":
+		return nil, nil
+
+	case FileService:
+		storeKeys, err := selectStoreKeys(cfg.Keys, keys)
+		if err != nil {
+			return nil, err
+		}
+
+		writeDir := cfg.FileWriteDir
+		if !filepath.IsAbs(writeDir) {
+			writeDir = filepath.Join(homePath, writeDir)
+		}
+
+		ss, err := file.NewStreamingService(writeDir, storeKeys)
+		if err != nil {
+			return nil, err
+		}
+
+		bApp.SetStreamingService(ss)
+		return ss, nil
+
+	default:
+		return nil, fmt.Errorf("unknown streaming service %q, only %q is supported", cfg.Service, FileService)
+	}
+}
+
+// selectStoreKeys returns the store keys of keys named in names, sorted by name.
+func selectStoreKeys(names []string, keys map[string]*types.KVStoreKey) ([]types.StoreKey, error) {
+	selected := make(map[string]*types.KVStoreKey)
+
+	for _, name := range names {
+		if name == AllStores {
+			for n, key := range keys {
+				selected[n] = key
+			}
+
+			continue
+		}
+
+		key, ok := keys[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown store key %q in streaming keys", name)
+		}
+
+		selected[name] = key
+	}
+
+	sortedNames := make([]string, 0, len(selected))
+	for name := range selected {
+		sortedNames = append(sortedNames, name)
+	}
+	sort.Strings(sortedNames)
+
+	storeKeys := make([]types.StoreKey, len(sortedNames))
+	for i, name := range sortedNames {
+		storeKeys[i] = selected[name]
+	}
+
+	return storeKeys, nil
+}
@@ -0,0 +1,76 @@
+package streaming_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/store/streaming"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestLoadStreamingService(t *testing.T) {
+	home, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	keys := sdk.NewKVStoreKeys("a", "b")
+	newApp := func() *baseapp.BaseApp {
+		bApp := baseapp.NewBaseApp("test", log.NewNopLogger(), dbm.NewMemDB(), nil)
+		bApp.MountKVStores(keys)
+		return bApp
+	}
+
+	// streaming is disabled by default
+	ss, err := streaming.LoadStreamingService(newApp(), config.DefaultConfig().Streaming, home, keys)
+	require.NoError(t, err)
+	require.Nil(t, ss)
+
+	cfg := config.StreamingConfig{Service: "grpc", Keys: []string{"*"}}
+	_, err = streaming.LoadStreamingService(newApp(), cfg, home, keys)
+	require.Error(t, err)
+
+	cfg = config.StreamingConfig{Service: streaming.FileService, Keys: []string{"c"}}
+	_, err = streaming.LoadStreamingService(newApp(), cfg, home, keys)
+	require.Error(t, err)
+
+	cfg = config.StreamingConfig{Service: streaming.FileService, Keys: []string{"*"}, FileWriteDir: "data/streaming"}
+	ss, err = streaming.LoadStreamingService(newApp(), cfg, home, keys)
+	require.NoError(t, err)
+	require.Len(t, ss.Listeners(), 2)
+
+	cfg.Keys = []string{"b"}
+	bApp := newApp()
+	ss, err = streaming.LoadStreamingService(bApp, cfg, home, keys)
+	require.NoError(t, err)
+	require.Len(t, ss.Listeners(), 1)
+	require.Contains(t, ss.Listeners(), sdk.StoreKey(keys["b"]))
+
+	bApp.SetBeginBlocker(func(ctx sdk.Context, _ abci.RequestBeginBlock) abci.ResponseBeginBlock {
+		ctx.KVStore(keys["a"]).Set([]byte("key"), []byte("skipped"))
+		ctx.KVStore(keys["b"]).Set([]byte("key"), []byte("streamed"))
+		return abci.ResponseBeginBlock{}
+	})
+	require.NoError(t, bApp.LoadLatestVersion())
+
+	bApp.InitChain(abci.RequestInitChain{})
+	bApp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	bApp.EndBlock(abci.RequestEndBlock{Height: 1})
+	bApp.Commit()
+
+	// the block file is written relative to the home directory
+	bz, err := ioutil.ReadFile(filepath.Join(home, "data", "streaming", "block-1"))
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"type":"begin_block"`)
+	require.Contains(t, string(bz), `"store_key":"b"`)
+	require.NotContains(t, string(bz), `"store_key":"a"`)
+	require.Contains(t, string(bz), `"type":"commit"`)
+}
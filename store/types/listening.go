@@ -0,0 +1,55 @@
+package types
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// WriteListener interface for streaming data out from a listenkv.Store
+type WriteListener interface {
+	// OnWrite is called for every write to a KVStore. storeKey indicates the
+	// source KVStore, to facilitate using the same WriteListener across
+	// separate KVStores, and delete is true for deletes, in which case value
+	// is nil.
+	OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error
+}
+
+// StoreKVPair is a KVStore write captured by a WriteListener
+type StoreKVPair struct {
+	StoreKey string `json:"store_key"`
+	Delete   bool   `json:"delete"`
+	Key      []byte `json:"key"`
+	Value    []byte `json:"value"`
+}
+
+// StoreKVPairWriteListener is a WriteListener that writes every KVStore write
+// to an io.Writer as a line of JSON-encoded StoreKVPair.
+type StoreKVPairWriteListener struct {
+	writer io.Writer
+}
+
+var _ WriteListener = (*StoreKVPairWriteListener)(nil)
+
+// NewStoreKVPairWriteListener wraps an io.Writer with a StoreKVPairWriteListener
+func NewStoreKVPairWriteListener(w io.Writer) *StoreKVPairWriteListener {
+	return &StoreKVPairWriteListener{writer: w}
+}
+
+// OnWrite implements the WriteListener interface
+func (wl *StoreKVPairWriteListener) OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error {
+	bz, err := json.Marshal(StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err := wl.writer.Write(append(bz, '\n')); err != nil {
+		return err
+	}
+
+	return nil
+}
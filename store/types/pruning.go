@@ -1,66 +1,104 @@
 package types
 
+import "fmt"
+
+// Pruning option string constants
+const (
+	PruningOptionDefault    = "default"
+	PruningOptionEverything = "everything"
+	PruningOptionNothing    = "nothing"
+	PruningOptionCustom     = "custom"
+
+	// PruningOptionSyncable is kept for existing configurations and maps to
+	// the default strategy.
+	//
+	// Deprecated: use PruningOptionDefault.
+	PruningOptionSyncable = "syncable"
+)
+
 var (
-	// PruneEverything defines a pruning strategy where all committed states will
-	// be deleted, persisting only the current state.
-	PruneEverything = PruningOptions{
-		KeepEvery:     1,
-		SnapshotEvery: 0,
-	}
+	// PruneDefault defines a pruning strategy where the last 362880 heights are
+	// kept in addition to every 100th and where to-be pruned heights are pruned
+	// at every 10th height. The last 362880 heights are kept assuming the typical
+	// block time is 5s and typical unbonding period is 21 days. If these values
+	// do not match the applications' requirements, use the "custom" option.
+	PruneDefault = NewPruningOptions(362880, 100, 10)
 
-	// PruneNothing defines a pruning strategy where all committed states will be
-	// kept on disk, i.e. no states will be pruned.
-	PruneNothing = PruningOptions{
-		KeepEvery:     1,
-		SnapshotEvery: 1,
-	}
+	// PruneEverything defines a pruning strategy where all committed heights are
+	// deleted, storing only the current height and where to-be pruned heights are
+	// pruned at every 10th height.
+	PruneEverything = NewPruningOptions(0, 0, 10)
 
-	// PruneSyncable defines a pruning strategy where only those states not needed
-	// for state syncing will be pruned. It flushes every 100th state to disk and
-	// keeps every 10000th.
-	PruneSyncable = PruningOptions{
-		KeepEvery:     100,
-		SnapshotEvery: 10000,
-	}
+	// PruneNothing defines a pruning strategy where all heights are kept on disk.
+	PruneNothing = NewPruningOptions(0, 1, 0)
+
+	// PruneSyncable is an alias of PruneDefault, the closest strategy to the
+	// former syncable one.
+	//
+	// Deprecated: use PruneDefault.
+	PruneSyncable = PruneDefault
 )
 
-// PruningOptions defines the specific pruning strategy every store in a multi-store
-// will use when committing state, where keepEvery determines which committed
-// heights are flushed to disk and snapshotEvery determines which of these heights
-// are kept after pruning.
+// PruningOptions defines the pruning strategy used when determining which
+// heights are removed from disk when committing state.
 type PruningOptions struct {
-	KeepEvery     int64
-	SnapshotEvery int64
+	// KeepRecent defines how many recent heights to keep on disk.
+	KeepRecent uint64
+
+	// KeepEvery defines how many offset heights are kept on disk past KeepRecent.
+	KeepEvery uint64
+
+	// Interval defines when the pruned heights are removed from disk.
+	Interval uint64
 }
 
-// IsValid verifies if the pruning options are valid. It returns false if invalid
-// and true otherwise. Pruning options are considered valid iff:
-//
-// - KeepEvery > 0
-// - SnapshotEvery >= 0
-// - SnapshotEvery % KeepEvery = 0
-func (po PruningOptions) IsValid() bool {
-	// must flush at positive block interval
-	if po.KeepEvery <= 0 {
-		return false
+// NewPruningOptions returns a new PruningOptions instance
+func NewPruningOptions(keepRecent, keepEvery, interval uint64) PruningOptions {
+	return PruningOptions{
+		KeepRecent: keepRecent,
+		KeepEvery:  keepEvery,
+		Interval:   interval,
 	}
+}
+
+// Validate verifies the pruning options are consistent. Pruning options are
+// considered valid iff:
+//
+// - Interval > 0 when KeepEvery != 1, as heights are otherwise never pruned
+// - Interval = 0 when KeepEvery = 1, as every height is kept
+func (po PruningOptions) Validate() error {
+	switch {
+	case po.KeepEvery == 0 && po.Interval == 0:
+		return fmt.Errorf("invalid 'Interval' when pruning everything: %d", po.Interval)
 
-	// cannot snapshot negative intervals
-	if po.SnapshotEvery < 0 {
-		return false
+	case po.KeepEvery == 1 && po.Interval != 0:
+		return fmt.Errorf("invalid 'Interval' when pruning nothing: %d", po.Interval)
+
+	case po.KeepEvery > 1 && po.Interval == 0:
+		return fmt.Errorf("invalid 'Interval' when pruning: %d", po.Interval)
 	}
 
-	return po.SnapshotEvery%po.KeepEvery == 0
+	return nil
 }
 
-// FlushVersion returns a boolean signaling if the provided version/height should
-// be flushed to disk.
-func (po PruningOptions) FlushVersion(ver int64) bool {
-	return po.KeepEvery != 0 && ver%po.KeepEvery == 0
+// PruneHeight reports whether the given height should be removed from disk
+// once it falls out of the KeepRecent window.
+func (po PruningOptions) PruneHeight(height int64) bool {
+	return po.KeepEvery == 0 || height%int64(po.KeepEvery) != 0
 }
 
-// SnapshotVersion returns a boolean signaling if the provided version/height
-// should be snapshotted (kept on disk).
-func (po PruningOptions) SnapshotVersion(ver int64) bool {
-	return po.SnapshotEvery != 0 && ver%po.SnapshotEvery == 0
+// NewPruningOptionsFromString returns the PruningOptions preset matching the
+// given strategy name. Unknown names, including "custom" and the deprecated
+// "syncable", fall back to the default strategy.
+func NewPruningOptionsFromString(strategy string) PruningOptions {
+	switch strategy {
+	case PruningOptionEverything:
+		return PruneEverything
+
+	case PruningOptionNothing:
+		return PruneNothing
+
+	default:
+		return PruneDefault
+	}
 }
@@ -8,72 +8,40 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/types"
 )
 
-func TestPruningOptions_FlushVersion(t *testing.T) {
-	t.Parallel()
-	require.True(t, types.PruneEverything.FlushVersion(-1))
-	require.True(t, types.PruneEverything.FlushVersion(0))
-	require.True(t, types.PruneEverything.FlushVersion(1))
-	require.True(t, types.PruneEverything.FlushVersion(2))
-
-	require.True(t, types.PruneNothing.FlushVersion(-1))
-	require.True(t, types.PruneNothing.FlushVersion(0))
-	require.True(t, types.PruneNothing.FlushVersion(1))
-	require.True(t, types.PruneNothing.FlushVersion(2))
+func TestPruningOptions_Validate(t *testing.T) {
+	testCases := []struct {
+		opts      types.PruningOptions
+		expectErr bool
+	}{
+		{types.PruneDefault, false},
+		{types.PruneEverything, false},
+		{types.PruneNothing, false},
+		{types.NewPruningOptions(10, 10, 10), false},
+		{types.NewPruningOptions(100, 0, 0), true},
+		{types.NewPruningOptions(0, 1, 5), true},
+		{types.NewPruningOptions(100, 10, 0), true},
+	}
 
-	require.False(t, types.PruneSyncable.FlushVersion(-1))
-	require.True(t, types.PruneSyncable.FlushVersion(0))
-	require.False(t, types.PruneSyncable.FlushVersion(1))
-	require.True(t, types.PruneSyncable.FlushVersion(100))
-	require.False(t, types.PruneSyncable.FlushVersion(101))
+	for _, tc := range testCases {
+		err := tc.opts.Validate()
+		require.Equal(t, tc.expectErr, err != nil, "options: %v, err: %s", tc.opts, err)
+	}
 }
 
-func TestPruningOptions_SnapshotVersion(t *testing.T) {
-	t.Parallel()
-	require.False(t, types.PruneEverything.SnapshotVersion(-1))
-	require.False(t, types.PruneEverything.SnapshotVersion(0))
-	require.False(t, types.PruneEverything.SnapshotVersion(1))
-	require.False(t, types.PruneEverything.SnapshotVersion(2))
+func TestPruningOptions_PruneHeight(t *testing.T) {
+	require.True(t, types.PruneEverything.PruneHeight(1))
+	require.True(t, types.PruneEverything.PruneHeight(100))
 
-	require.True(t, types.PruneNothing.SnapshotVersion(-1))
-	require.True(t, types.PruneNothing.SnapshotVersion(0))
-	require.True(t, types.PruneNothing.SnapshotVersion(1))
-	require.True(t, types.PruneNothing.SnapshotVersion(2))
+	require.False(t, types.PruneNothing.PruneHeight(1))
+	require.False(t, types.PruneNothing.PruneHeight(100))
 
-	require.False(t, types.PruneSyncable.SnapshotVersion(-1))
-	require.True(t, types.PruneSyncable.SnapshotVersion(0))
-	require.False(t, types.PruneSyncable.SnapshotVersion(1))
-	require.True(t, types.PruneSyncable.SnapshotVersion(10000))
-	require.False(t, types.PruneSyncable.SnapshotVersion(10001))
+	require.True(t, types.PruneDefault.PruneHeight(99))
+	require.False(t, types.PruneDefault.PruneHeight(100))
 }
 
-func TestPruningOptions_IsValid(t *testing.T) {
-	t.Parallel()
-	type fields struct {
-		KeepEvery     int64
-		SnapshotEvery int64
-	}
-	tests := []struct {
-		name   string
-		fields fields
-		want   bool
-	}{
-		{"PruneEverything", fields{types.PruneEverything.KeepEvery, types.PruneEverything.SnapshotEvery}, true},
-		{"PruneNothing", fields{types.PruneNothing.KeepEvery, types.PruneNothing.SnapshotEvery}, true},
-		{"PruneSyncable", fields{types.PruneSyncable.KeepEvery, types.PruneSyncable.SnapshotEvery}, true},
-		{"KeepEvery=0", fields{0, 0}, false},
-		{"KeepEvery<0", fields{-1, 0}, false},
-		{"SnapshotEvery<0", fields{1, -1}, false},
-		{"SnapshotEvery%KeepEvery!=0", fields{15, 30}, true},
-		{"SnapshotEvery%KeepEvery!=0", fields{15, 20}, false},
-	}
-	for _, tt := range tests {
-		tt := tt
-		t.Run(tt.name, func(t *testing.T) {
-			po := types.PruningOptions{
-				KeepEvery:     tt.fields.KeepEvery,
-				SnapshotEvery: tt.fields.SnapshotEvery,
-			}
-			require.Equal(t, tt.want, po.IsValid(), "IsValid() = %v, want %v", po.IsValid(), tt.want)
-		})
-	}
+func TestNewPruningOptionsFromString(t *testing.T) {
+	require.Equal(t, types.PruneDefault, types.NewPruningOptionsFromString(types.PruningOptionDefault))
+	require.Equal(t, types.PruneEverything, types.NewPruningOptionsFromString(types.PruningOptionEverything))
+	require.Equal(t, types.PruneNothing, types.NewPruningOptionsFromString(types.PruningOptionNothing))
+	require.Equal(t, types.PruneDefault, types.NewPruningOptionsFromString("unknown"))
 }
@@ -159,9 +159,21 @@ type CommitMultiStore interface {
 	// undefined.
 	LoadVersion(ver int64) error
 
+	// RollbackToVersion deletes every persisted version above the given one and
+	// makes it the latest version, so that the next commit overwrites the
+	// deleted versions.
+	RollbackToVersion(version int64) error
+
 	// Set an inter-block (persistent) cache that maintains a mapping from
 	// StoreKeys to CommitKVStores.
 	SetInterBlockCache(MultiStorePersistentCache)
+
+	// AddListeners adds WriteListeners for the KVStore belonging to the provided StoreKey
+	// It appends the listeners to a current set, if one already exists
+	AddListeners(key StoreKey, listeners []WriteListener)
+
+	// ListeningEnabled returns if listening is enabled for the KVStore belonging the provided StoreKey
+	ListeningEnabled(key StoreKey) bool
 }
 
 //---------subsp-------------------------------
@@ -16,6 +16,36 @@ func KVStoreReversePrefixIterator(kvs KVStore, prefix []byte) Iterator {
 	return kvs.ReverseIterator(prefix, PrefixEndBytes(prefix))
 }
 
+// KVStorePrefixRangeIterator iterates over the keys with a certain prefix in
+// ascending order, restricted to the domain [prefix|start, prefix|end). The
+// start and end keys are relative to the prefix; a nil start iterates from the
+// beginning of the prefix and a nil end iterates to its end.
+func KVStorePrefixRangeIterator(kvs KVStore, prefix, start, end []byte) Iterator {
+	return kvs.Iterator(prefixRange(prefix, start, end))
+}
+
+// KVStoreReversePrefixRangeIterator iterates over the keys with a certain prefix
+// in descending order, restricted to the domain [prefix|start, prefix|end). See
+// KVStorePrefixRangeIterator for the start and end key semantics.
+func KVStoreReversePrefixRangeIterator(kvs KVStore, prefix, start, end []byte) Iterator {
+	return kvs.ReverseIterator(prefixRange(prefix, start, end))
+}
+
+// prefixRange returns the absolute domain of a range relative to prefix.
+func prefixRange(prefix, start, end []byte) ([]byte, []byte) {
+	pstart := make([]byte, 0, len(prefix)+len(start))
+	pstart = append(append(pstart, prefix...), start...)
+
+	if end == nil {
+		return pstart, PrefixEndBytes(prefix)
+	}
+
+	pend := make([]byte, 0, len(prefix)+len(end))
+	pend = append(append(pend, prefix...), end...)
+
+	return pstart, pend
+}
+
 // DiffKVStores compares two KVstores and returns all the key/value pairs
 // that differ from one another. It also skips value comparison for a set of provided prefixes.
 func DiffKVStores(a KVStore, b KVStore, prefixesToSkip [][]byte) (kvAs, kvBs []tmkv.Pair) {
@@ -91,7 +121,11 @@ func PrefixEndBytes(prefix []byte) []byte {
 }
 
 // InclusiveEndBytes returns the []byte that would end a
-// range query such that the input would be included
+// range query such that the input would be included.
+// The input slice is never modified.
 func InclusiveEndBytes(inclusiveBytes []byte) []byte {
-	return append(inclusiveBytes, byte(0x00))
+	end := make([]byte, len(inclusiveBytes), len(inclusiveBytes)+1)
+	copy(end, inclusiveBytes)
+
+	return append(end, byte(0x00))
 }
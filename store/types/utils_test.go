@@ -86,3 +86,59 @@ func TestInclusiveEndBytes(t *testing.T) {
 	bs := []byte("test")
 	require.True(t, bytes.Equal(append(bs, byte(0x00)), types.InclusiveEndBytes(bs)))
 }
+
+func TestKVStorePrefixRangeIterator(t *testing.T) {
+	t.Parallel()
+	store, _ := initTestStores(t)
+
+	prefix := []byte("p/")
+	for _, k := range []string{"a", "b", "c", "d"} {
+		store.Set(append([]byte("p/"), k...), []byte(k))
+	}
+	store.Set([]byte("o"), []byte("o"))
+	store.Set([]byte("q"), []byte("q"))
+
+	collect := func(iter types.Iterator) []string {
+		defer iter.Close()
+		var vals []string
+		for ; iter.Valid(); iter.Next() {
+			vals = append(vals, string(iter.Value()))
+		}
+		return vals
+	}
+
+	testCases := []struct {
+		name       string
+		start, end []byte
+		asc        []string
+	}{
+		{"unbounded", nil, nil, []string{"a", "b", "c", "d"}},
+		{"start only", []byte("b"), nil, []string{"b", "c", "d"}},
+		{"end only", nil, []byte("c"), []string{"a", "b"}},
+		{"bounded", []byte("b"), []byte("d"), []string{"b", "c"}},
+		{"inclusive end", []byte("b"), types.InclusiveEndBytes([]byte("c")), []string{"b", "c"}},
+		{"empty", []byte("x"), nil, nil},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.asc, collect(types.KVStorePrefixRangeIterator(store, prefix, tc.start, tc.end)), tc.name)
+
+		var desc []string
+		for i := len(tc.asc) - 1; i >= 0; i-- {
+			desc = append(desc, tc.asc[i])
+		}
+		require.Equal(t, desc, collect(types.KVStoreReversePrefixRangeIterator(store, prefix, tc.start, tc.end)), tc.name)
+	}
+}
+
+func TestInclusiveEndBytesDoesNotMutate(t *testing.T) {
+	t.Parallel()
+	buf := make([]byte, 1, 2)
+	buf[0] = 'a'
+
+	end := types.InclusiveEndBytes(buf)
+	require.Equal(t, []byte{'a', 0x00}, end)
+
+	buf = append(buf, 'b')
+	require.Equal(t, []byte{'a', 0x00}, end)
+}
@@ -0,0 +1,163 @@
+package telemetry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Formats supported by Gather.
+const (
+	FormatDefault    = ""
+	FormatPrometheus = "prometheus"
+	FormatText       = "text"
+
+	contentTypePrometheus = "text/plain; version=0.0.4; charset=utf-8"
+	contentTypeJSON       = "application/json"
+)
+
+// Config defines the telemetry configuration, read from the [telemetry]
+// section of app.toml.
+type Config struct {
+	// ServiceName is the prefix of all the metric names.
+	ServiceName string `mapstructure:"service-name"`
+
+	// Enabled defines if the application collects metrics.
+	Enabled bool `mapstructure:"enabled"`
+
+	// EnableHostnameLabel defines if all the metrics are labeled with the
+	// hostname of the node.
+	EnableHostnameLabel bool `mapstructure:"enable-hostname-label"`
+
+	// PrometheusEnabled defines if the metrics can be gathered in the
+	// Prometheus exposition format.
+	PrometheusEnabled bool `mapstructure:"prometheus-enabled"`
+
+	// GlobalLabels defines the name and value pairs of the labels attached to
+	// all the metrics, e.g. [["chain_id", "cosmoshub-1"]].
+	GlobalLabels [][]string `mapstructure:"global-labels"`
+}
+
+// Metrics collects the metrics of the application in memory and renders them
+// in JSON or in the Prometheus exposition format.
+type Metrics struct {
+	sink              *memSink
+	prometheusEnabled bool
+}
+
+// GatherResponse is the rendering of the metrics in a given format.
+type GatherResponse struct {
+	Metrics     []byte
+	ContentType string
+}
+
+// New creates the Metrics defined by cfg and installs them as the destination
+// of the package level functions, such as IncrCounter.
+func New(cfg Config) (*Metrics, error) {
+	labels := make([]Label, 0, len(cfg.GlobalLabels)+1)
+	for _, pair := range cfg.GlobalLabels {
+		if len(pair) != 2 {
+			return nil, fmt.Errorf("invalid global label %v: expected a name and a value", pair)
+		}
+
+		labels = append(labels, NewLabel(pair[0], pair[1]))
+	}
+
+	if cfg.EnableHostnameLabel {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, err
+		}
+
+		labels = append(labels, NewLabel("host", hostname))
+	}
+
+	m := &Metrics{
+		sink:              newMemSink(cfg.ServiceName, labels),
+		prometheusEnabled: cfg.PrometheusEnabled,
+	}
+	setGlobalSink(m.sink)
+
+	return m, nil
+}
+
+// Gather renders the metrics collected so far in the given format. The text
+// format is the JSON encoding of the in-memory series.
+func (m *Metrics) Gather(format string) (GatherResponse, error) {
+	switch format {
+	case FormatPrometheus:
+		return m.gatherPrometheus()
+
+	case FormatText, FormatDefault:
+		return m.gatherJSON()
+
+	default:
+		return GatherResponse{}, fmt.Errorf("unsupported metrics format: %s", format)
+	}
+}
+
+func (m *Metrics) gatherJSON() (GatherResponse, error) {
+	bz, err := json.Marshal(m.sink.snapshot())
+	if err != nil {
+		return GatherResponse{}, err
+	}
+
+	return GatherResponse{Metrics: bz, ContentType: contentTypeJSON}, nil
+}
+
+func (m *Metrics) gatherPrometheus() (GatherResponse, error) {
+	if !m.prometheusEnabled {
+		return GatherResponse{}, fmt.Errorf("prometheus metrics are not enabled")
+	}
+
+	var buf bytes.Buffer
+
+	lastName := ""
+	for _, series := range m.sink.snapshot() {
+		if series.Name != lastName {
+			fmt.Fprintf(&buf, "# TYPE %s %s\n", series.Name, series.Type)
+			lastName = series.Name
+		}
+
+		if series.Type != TypeHistogram {
+			fmt.Fprintf(&buf, "%s%s %s\n", series.Name, formatLabels(series.labels), formatFloat(series.Value))
+			continue
+		}
+
+		for i, bound := range DefaultBuckets {
+			labels := append(append([]Label{}, series.labels...), NewLabel("le", formatFloat(bound)))
+			fmt.Fprintf(&buf, "%s_bucket%s %d\n", series.Name, formatLabels(labels), series.Buckets[i])
+		}
+
+		labels := append(append([]Label{}, series.labels...), NewLabel("le", "+Inf"))
+		fmt.Fprintf(&buf, "%s_bucket%s %d\n", series.Name, formatLabels(labels), series.Count)
+		fmt.Fprintf(&buf, "%s_sum%s %s\n", series.Name, formatLabels(series.labels), formatFloat(series.Sum))
+		fmt.Fprintf(&buf, "%s_count%s %d\n", series.Name, formatLabels(series.labels), series.Count)
+	}
+
+	return GatherResponse{Metrics: buf.Bytes(), ContentType: contentTypePrometheus}, nil
+}
+
+// labelValueEscaper escapes label values as required by the Prometheus
+// exposition format.
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func formatLabels(labels []Label) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	pairs := make([]string, len(labels))
+	for i, label := range labels {
+		pairs[i] = fmt.Sprintf(`%s="%s"`, sanitize(label.Name), labelValueEscaper.Replace(label.Value))
+	}
+
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
@@ -0,0 +1,94 @@
+package telemetry
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics(t *testing.T) {
+	m, err := New(Config{
+		ServiceName:       "test",
+		Enabled:           true,
+		PrometheusEnabled: true,
+		GlobalLabels:      [][]string{{"chain_id", "test-chain"}},
+	})
+	require.NoError(t, err)
+
+	IncrCounter(1, "tx", "count")
+	IncrCounter(2, "tx", "count")
+	IncrCounterWithLabels([]string{"tx", "msg"}, 1, []Label{NewLabel("type", `send"`)})
+	SetGauge(10, "tx", "gas")
+	SetGauge(20, "tx", "gas")
+	MeasureSince(time.Now().Add(-time.Second), "abci", "commit")
+	ModuleMeasureSince("bank", time.Now(), MetricKeyBeginBlocker)
+
+	gr, err := m.Gather(FormatDefault)
+	require.NoError(t, err)
+	require.Equal(t, "application/json", gr.ContentType)
+
+	var series []Series
+	require.NoError(t, json.Unmarshal(gr.Metrics, &series))
+	require.Len(t, series, 5)
+
+	byName := make(map[string]Series)
+	for _, s := range series {
+		byName[s.Name] = s
+		require.Equal(t, "test-chain", s.Labels["chain_id"])
+	}
+
+	require.Equal(t, float64(3), byName["test_tx_count"].Value)
+	require.Equal(t, float64(20), byName["test_tx_gas"].Value)
+	require.Equal(t, "bank", byName["test_begin_blocker"].Labels[MetricLabelNameModule])
+
+	commit := byName["test_abci_commit"]
+	require.Equal(t, TypeHistogram, commit.Type)
+	require.Equal(t, uint64(1), commit.Count)
+	require.True(t, commit.Sum >= 1000)
+	require.Equal(t, uint64(1), commit.Buckets[len(commit.Buckets)-1])
+	require.Equal(t, uint64(0), commit.Buckets[0])
+
+	gr, err = m.Gather(FormatPrometheus)
+	require.NoError(t, err)
+
+	text := string(gr.Metrics)
+	require.Contains(t, text, "# TYPE test_tx_count counter\ntest_tx_count{chain_id=\"test-chain\"} 3\n")
+	require.Contains(t, text, "test_tx_msg{chain_id=\"test-chain\",type=\"send\\\"\"} 1\n")
+	require.Contains(t, text, "# TYPE test_abci_commit histogram\n")
+	require.Contains(t, text, "test_abci_commit_bucket{chain_id=\"test-chain\",le=\"+Inf\"} 1\n")
+	require.Contains(t, text, "test_abci_commit_count{chain_id=\"test-chain\"} 1\n")
+	require.Equal(t, 1, strings.Count(text, "# TYPE test_tx_gas gauge"))
+
+	_, err = m.Gather("xml")
+	require.Error(t, err)
+}
+
+func TestMetricsTypeConflict(t *testing.T) {
+	m, err := New(Config{Enabled: true})
+	require.NoError(t, err)
+
+	IncrCounter(1, "value")
+	SetGauge(5, "value")
+
+	gr, err := m.Gather(FormatText)
+	require.NoError(t, err)
+
+	var series []Series
+	require.NoError(t, json.Unmarshal(gr.Metrics, &series))
+	require.Equal(t, []Series{
+		{Name: "value", Type: TypeCounter, Value: 1},
+		{Name: "value_gauge", Type: TypeGauge, Value: 5},
+	}, series)
+
+	// prometheus rendering must be enabled explicitly
+	_, err = m.Gather(FormatPrometheus)
+	require.Error(t, err)
+}
+
+func TestInvalidGlobalLabels(t *testing.T) {
+	_, err := New(Config{Enabled: true, GlobalLabels: [][]string{{"chain_id"}}})
+	require.Error(t, err)
+}
@@ -0,0 +1,192 @@
+package telemetry
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Metric types, as named by the Prometheus exposition format.
+const (
+	TypeCounter   = "counter"
+	TypeGauge     = "gauge"
+	TypeHistogram = "histogram"
+)
+
+// DefaultBuckets are the upper bounds, in milliseconds, of the histogram
+// buckets used for timings.
+var DefaultBuckets = []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000}
+
+// Label is a name and value pair attached to a metric.
+type Label struct {
+	Name  string
+	Value string
+}
+
+// NewLabel creates a new Label.
+func NewLabel(name, value string) Label {
+	return Label{Name: name, Value: value}
+}
+
+// Series is the current value of a metric for a given set of labels.
+type Series struct {
+	Name   string            `json:"name"`
+	Type   string            `json:"type"`
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Value is the value of counters and gauges.
+	Value float64 `json:"value"`
+
+	// Count, Sum and Buckets summarize the samples of histograms. Buckets
+	// holds the number of samples lower than or equal to each bound of
+	// DefaultBuckets.
+	Count   uint64   `json:"count,omitempty"`
+	Sum     float64  `json:"sum,omitempty"`
+	Buckets []uint64 `json:"buckets,omitempty"`
+
+	labels []Label
+}
+
+// memSink aggregates metrics in memory. It is safe for concurrent use.
+type memSink struct {
+	mtx sync.Mutex
+
+	prefix       string
+	globalLabels []Label
+	series       map[string]*Series
+}
+
+func newMemSink(prefix string, globalLabels []Label) *memSink {
+	return &memSink{
+		prefix:       prefix,
+		globalLabels: globalLabels,
+		series:       make(map[string]*Series),
+	}
+}
+
+func (s *memSink) incrCounter(keys []string, val float32, labels []Label) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.getSeries(TypeCounter, keys, labels).Value += float64(val)
+}
+
+func (s *memSink) setGauge(keys []string, val float32, labels []Label) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.getSeries(TypeGauge, keys, labels).Value = float64(val)
+}
+
+func (s *memSink) addSample(keys []string, val float32, labels []Label) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	series := s.getSeries(TypeHistogram, keys, labels)
+	series.Count++
+	series.Sum += float64(val)
+
+	for i, bound := range DefaultBuckets {
+		if float64(val) <= bound {
+			series.Buckets[i]++
+		}
+	}
+}
+
+// getSeries returns the series of the metric named after keys, creating it
+// if needed. A metric always keeps the type it was created with; updates
+// with another type are recorded under a name suffixed with the type.
+func (s *memSink) getSeries(typ string, keys []string, labels []Label) *Series {
+	name := metricName(s.prefix, keys)
+	labels = append(append([]Label{}, s.globalLabels...), labels...)
+
+	key := seriesKey(name, labels)
+	if series, ok := s.series[key]; ok {
+		if series.Type == typ {
+			return series
+		}
+
+		name = name + "_" + typ
+		key = seriesKey(name, labels)
+		if series, ok := s.series[key]; ok {
+			return series
+		}
+	}
+
+	series := &Series{
+		Name:   name,
+		Type:   typ,
+		labels: labels,
+	}
+	if len(labels) > 0 {
+		series.Labels = make(map[string]string, len(labels))
+		for _, label := range labels {
+			series.Labels[sanitize(label.Name)] = label.Value
+		}
+	}
+	if typ == TypeHistogram {
+		series.Buckets = make([]uint64, len(DefaultBuckets))
+	}
+
+	s.series[key] = series
+	return series
+}
+
+// snapshot returns a copy of all the series, sorted by name and labels.
+func (s *memSink) snapshot() []Series {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	keys := make([]string, 0, len(s.series))
+	for key := range s.series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	snapshot := make([]Series, len(keys))
+	for i, key := range keys {
+		series := *s.series[key]
+		series.Buckets = append([]uint64(nil), series.Buckets...)
+		snapshot[i] = series
+	}
+
+	return snapshot
+}
+
+// metricName joins the prefix and keys with underscores, replacing the
+// characters not allowed in Prometheus metric names.
+func metricName(prefix string, keys []string) string {
+	parts := keys
+	if prefix != "" {
+		parts = append([]string{prefix}, keys...)
+	}
+
+	return sanitize(strings.Join(parts, "_"))
+}
+
+func seriesKey(name string, labels []Label) string {
+	var b strings.Builder
+
+	b.WriteString(name)
+	for _, label := range labels {
+		b.WriteByte(0)
+		b.WriteString(label.Name)
+		b.WriteByte(0)
+		b.WriteString(label.Value)
+	}
+
+	return b.String()
+}
+
+// sanitize replaces the characters which are not valid in Prometheus metric
+// and label names with underscores.
+func sanitize(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == ':':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
@@ -0,0 +1,79 @@
+package telemetry
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Common metric keys and label names.
+const (
+	MetricKeyBeginBlocker = "begin_blocker"
+	MetricKeyEndBlocker   = "end_blocker"
+	MetricLabelNameModule = "module"
+)
+
+// globalSink holds the *memSink installed by New. The package level functions
+// are no-ops until telemetry is enabled.
+var globalSink atomic.Value
+
+func setGlobalSink(sink *memSink) {
+	globalSink.Store(sink)
+}
+
+func getGlobalSink() *memSink {
+	sink, _ := globalSink.Load().(*memSink)
+	return sink
+}
+
+// IncrCounter increments the counter named after keys by val.
+func IncrCounter(val float32, keys ...string) {
+	IncrCounterWithLabels(keys, val, nil)
+}
+
+// IncrCounterWithLabels increments the counter named after keys and labeled
+// with labels by val.
+func IncrCounterWithLabels(keys []string, val float32, labels []Label) {
+	if sink := getGlobalSink(); sink != nil {
+		sink.incrCounter(keys, val, labels)
+	}
+}
+
+// SetGauge sets the gauge named after keys to val.
+func SetGauge(val float32, keys ...string) {
+	SetGaugeWithLabels(keys, val, nil)
+}
+
+// SetGaugeWithLabels sets the gauge named after keys and labeled with labels
+// to val.
+func SetGaugeWithLabels(keys []string, val float32, labels []Label) {
+	if sink := getGlobalSink(); sink != nil {
+		sink.setGauge(keys, val, labels)
+	}
+}
+
+// MeasureSince records the time elapsed since start, in milliseconds, in the
+// histogram named after keys.
+func MeasureSince(start time.Time, keys ...string) {
+	MeasureSinceWithLabels(keys, start, nil)
+}
+
+// MeasureSinceWithLabels records the time elapsed since start, in
+// milliseconds, in the histogram named after keys and labeled with labels.
+func MeasureSinceWithLabels(keys []string, start time.Time, labels []Label) {
+	if sink := getGlobalSink(); sink != nil {
+		elapsed := float32(time.Since(start)) / float32(time.Millisecond)
+		sink.addSample(keys, elapsed, labels)
+	}
+}
+
+// ModuleMeasureSince records the time elapsed since start in the histogram
+// named after keys, labeled with the module name.
+func ModuleMeasureSince(module string, start time.Time, keys ...string) {
+	MeasureSinceWithLabels(keys, start, []Label{NewLabel(MetricLabelNameModule, module)})
+}
+
+// ModuleSetGauge sets the gauge named after keys, labeled with the module name,
+// to val.
+func ModuleSetGauge(module string, val float32, keys ...string) {
+	SetGaugeWithLabels(keys, val, []Label{NewLabel(MetricLabelNameModule, module)})
+}
@@ -0,0 +1,58 @@
+/*
+Package network implements and exposes a fully operational in-process Tendermint
+test network that consists of at least one or potentially many validators. This
+test network can be used primarily for integration tests or unit test suites.
+
+The test network utilizes SimApp as the ABCI application by default and uses
+all the modules defined in SimApp. Each validator has its own home directory,
+in-memory keyring, API server and gRPC server, and the network is ready for use
+once New returns, that is once the first block has been committed.
+
+Only a single network may run at a time in a given process, since the nodes
+share global state such as the SDK configuration. Calling Cleanup releases the
+network so that another one may be started.
+
+A typical testing flow might look like the following:
+
+	type IntegrationTestSuite struct {
+		suite.Suite
+
+		cfg     network.Config
+		network *network.Network
+	}
+
+	func (s *IntegrationTestSuite) SetupSuite() {
+		s.T().Log("setting up integration test suite")
+
+		cfg := network.DefaultConfig()
+		cfg.NumValidators = 1
+
+		s.cfg = cfg
+		s.network = network.New(s.T(), cfg)
+
+		_, err := s.network.WaitForHeight(1)
+		s.Require().NoError(err)
+	}
+
+	func (s *IntegrationTestSuite) TearDownSuite() {
+		s.T().Log("tearing down integration test suite")
+
+		// This is important and must be called to ensure other tests can create
+		// a network!
+		s.network.Cleanup()
+	}
+
+	func (s *IntegrationTestSuite) TestQueryBalancesRequestHandlerFn() {
+		val := s.network.Validators[0]
+		baseURL := val.APIAddress
+
+		// Use baseURL to make API HTTP requests or use val.RPCClient to make direct
+		// Tendermint RPC calls.
+		// ...
+	}
+
+	func TestIntegrationTestSuite(t *testing.T) {
+		suite.Run(t, new(IntegrationTestSuite))
+	}
+*/
+package network
@@ -0,0 +1,55 @@
+package network_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	network *network.Network
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.T().Log("setting up integration test suite")
+
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = 2
+
+	s.network = network.New(s.T(), cfg)
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.network.Cleanup()
+}
+
+func (s *IntegrationTestSuite) TestNetwork_Liveness() {
+	h, err := s.network.WaitForHeightWithTimeout(5, time.Minute)
+	s.Require().NoError(err, "expected to reach 5 blocks; got %d", h)
+}
+
+func (s *IntegrationTestSuite) TestValidatorBalance() {
+	val := s.network.Validators[0]
+
+	resp, err := http.Get(fmt.Sprintf("%s/bank/balances/%s", val.APIAddress, val.Address))
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	bz, err := ioutil.ReadAll(resp.Body)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, string(bz))
+	s.Require().Contains(string(bz), "node0token")
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
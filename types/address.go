@@ -122,14 +122,7 @@ func AccAddressFromBech32(address string) (addr AccAddress, err error) {
 		return AccAddress{}, nil
 	}
 
-	bech32PrefixAccAddr := GetConfig().GetBech32AccountAddrPrefix()
-
-	bz, err := GetFromBech32(address, bech32PrefixAccAddr)
-	if err != nil {
-		return nil, err
-	}
-
-	err = VerifyAddressFormat(bz)
+	bz, err := GetConfig().GetAccountAddressCodec().StringToBytes(address)
 	if err != nil {
 		return nil, err
 	}
@@ -224,9 +217,7 @@ func (aa AccAddress) String() string {
 		return ""
 	}
 
-	bech32PrefixAccAddr := GetConfig().GetBech32AccountAddrPrefix()
-
-	bech32Addr, err := bech32.ConvertAndEncode(bech32PrefixAccAddr, aa.Bytes())
+	bech32Addr, err := GetConfig().GetAccountAddressCodec().BytesToString(aa.Bytes())
 	if err != nil {
 		panic(err)
 	}
@@ -267,14 +258,7 @@ func ValAddressFromBech32(address string) (addr ValAddress, err error) {
 		return ValAddress{}, nil
 	}
 
-	bech32PrefixValAddr := GetConfig().GetBech32ValidatorAddrPrefix()
-
-	bz, err := GetFromBech32(address, bech32PrefixValAddr)
-	if err != nil {
-		return nil, err
-	}
-
-	err = VerifyAddressFormat(bz)
+	bz, err := GetConfig().GetValidatorAddressCodec().StringToBytes(address)
 	if err != nil {
 		return nil, err
 	}
@@ -371,9 +355,7 @@ func (va ValAddress) String() string {
 		return ""
 	}
 
-	bech32PrefixValAddr := GetConfig().GetBech32ValidatorAddrPrefix()
-
-	bech32Addr, err := bech32.ConvertAndEncode(bech32PrefixValAddr, va.Bytes())
+	bech32Addr, err := GetConfig().GetValidatorAddressCodec().BytesToString(va.Bytes())
 	if err != nil {
 		panic(err)
 	}
@@ -414,14 +396,7 @@ func ConsAddressFromBech32(address string) (addr ConsAddress, err error) {
 		return ConsAddress{}, nil
 	}
 
-	bech32PrefixConsAddr := GetConfig().GetBech32ConsensusAddrPrefix()
-
-	bz, err := GetFromBech32(address, bech32PrefixConsAddr)
-	if err != nil {
-		return nil, err
-	}
-
-	err = VerifyAddressFormat(bz)
+	bz, err := GetConfig().GetConsensusAddressCodec().StringToBytes(address)
 	if err != nil {
 		return nil, err
 	}
@@ -523,9 +498,7 @@ func (ca ConsAddress) String() string {
 		return ""
 	}
 
-	bech32PrefixConsAddr := GetConfig().GetBech32ConsensusAddrPrefix()
-
-	bech32Addr, err := bech32.ConvertAndEncode(bech32PrefixConsAddr, ca.Bytes())
+	bech32Addr, err := GetConfig().GetConsensusAddressCodec().BytesToString(ca.Bytes())
 	if err != nil {
 		panic(err)
 	}
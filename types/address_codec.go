@@ -0,0 +1,52 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// AddressCodec defines an interface that converts addresses between their raw
+// bytes and their string representation.
+type AddressCodec interface {
+	// StringToBytes decodes text to the raw address bytes.
+	StringToBytes(text string) ([]byte, error)
+	// BytesToString encodes the raw address bytes to a string.
+	BytesToString(bz []byte) (string, error)
+}
+
+var _ AddressCodec = Bech32Codec{}
+
+// Bech32Codec is an AddressCodec which encodes addresses with Bech32 using a
+// given human readable prefix.
+type Bech32Codec struct {
+	Bech32Prefix string
+}
+
+// NewBech32Codec creates a new Bech32Codec for the given prefix.
+func NewBech32Codec(prefix string) Bech32Codec {
+	return Bech32Codec{Bech32Prefix: prefix}
+}
+
+// StringToBytes decodes a Bech32 address with the codec's prefix and checks
+// the decoded bytes with VerifyAddressFormat.
+func (bc Bech32Codec) StringToBytes(text string) ([]byte, error) {
+	bz, err := GetFromBech32(text, bc.Bech32Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := VerifyAddressFormat(bz); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// BytesToString encodes the address bytes with the codec's prefix. Empty
+// addresses are encoded as an empty string.
+func (bc Bech32Codec) BytesToString(bz []byte) (string, error) {
+	if len(bz) == 0 {
+		return "", nil
+	}
+
+	return bech32.ConvertAndEncode(bc.Bech32Prefix, bz)
+}
@@ -0,0 +1,112 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBech32Codec(t *testing.T) {
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	codec := sdk.NewBech32Codec("fork")
+
+	text, err := codec.BytesToString(addr)
+	require.NoError(t, err)
+	require.Contains(t, text, "fork1")
+
+	bz, err := codec.StringToBytes(text)
+	require.NoError(t, err)
+	require.Equal(t, addr.Bytes(), bz)
+
+	// addresses with another prefix are rejected
+	_, err = codec.StringToBytes(addr.String())
+	require.Error(t, err)
+
+	// decoded addresses must pass the address format verification
+	short, err := codec.BytesToString([]byte{1, 2, 3})
+	require.NoError(t, err)
+	_, err = codec.StringToBytes(short)
+	require.Error(t, err)
+
+	_, err = codec.StringToBytes("")
+	require.Error(t, err)
+
+	text, err = codec.BytesToString(nil)
+	require.NoError(t, err)
+	require.Empty(t, text)
+}
+
+func TestConfigAddressCodecs(t *testing.T) {
+	config := sdk.GetConfig()
+	addr := ed25519.GenPrivKey().PubKey().Address()
+
+	text, err := config.GetAccountAddressCodec().BytesToString(addr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(addr).String(), text)
+
+	text, err = config.GetValidatorAddressCodec().BytesToString(addr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.ValAddress(addr).String(), text)
+
+	text, err = config.GetConsensusAddressCodec().BytesToString(addr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.ConsAddress(addr).String(), text)
+}
+
+// hexCodec is a non Bech32 AddressCodec encoding addresses as hex strings
+type hexCodec struct{}
+
+func (hexCodec) StringToBytes(text string) ([]byte, error) {
+	bz, err := hex.DecodeString(strings.TrimPrefix(text, "0x"))
+	if err != nil {
+		return nil, err
+	}
+
+	return bz, sdk.VerifyAddressFormat(bz)
+}
+
+func (hexCodec) BytesToString(bz []byte) (string, error) {
+	return "0x" + hex.EncodeToString(bz), nil
+}
+
+func TestConfig_SetAddressCodec(t *testing.T) {
+	config := sdk.NewConfig()
+	require.IsType(t, sdk.Bech32Codec{}, config.GetAccountAddressCodec())
+
+	config.SetAddressCodec(hexCodec{})
+	config.SetValidatorAddressCodec(hexCodec{})
+	config.SetConsensusAddressCodec(hexCodec{})
+	require.Equal(t, hexCodec{}, config.GetAccountAddressCodec())
+	require.Equal(t, hexCodec{}, config.GetValidatorAddressCodec())
+	require.Equal(t, hexCodec{}, config.GetConsensusAddressCodec())
+
+	config.Seal()
+	require.Panics(t, func() { config.SetAddressCodec(hexCodec{}) })
+	require.Panics(t, func() { config.SetValidatorAddressCodec(hexCodec{}) })
+	require.Panics(t, func() { config.SetConsensusAddressCodec(hexCodec{}) })
+}
+
+func TestAccAddressWithAddressCodec(t *testing.T) {
+	config := sdk.GetConfig()
+	config.SetAddressCodec(hexCodec{})
+	defer config.SetAddressCodec(nil)
+
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	text := "0x" + hex.EncodeToString(addr)
+	require.Equal(t, text, addr.String())
+
+	parsed, err := sdk.AccAddressFromBech32(text)
+	require.NoError(t, err)
+	require.Equal(t, addr, parsed)
+
+	// Bech32 addresses are no longer accepted
+	bech32Addr, err := sdk.NewBech32Codec(sdk.Bech32PrefixAccAddr).BytesToString(addr)
+	require.NoError(t, err)
+	_, err = sdk.AccAddressFromBech32(bech32Addr)
+	require.Error(t, err)
+}
@@ -17,6 +17,9 @@ type Config struct {
 	bech32AddressPrefix map[string]string
 	txEncoder           TxEncoder
 	addressVerifier     func([]byte) error
+	accAddressCodec     AddressCodec
+	valAddressCodec     AddressCodec
+	consAddressCodec    AddressCodec
 	mtx                 sync.RWMutex
 	coinType            uint32
 	sealed              bool
@@ -112,6 +115,32 @@ func (config *Config) SetAddressVerifier(addressVerifier func([]byte) error) {
 	config.addressVerifier = addressVerifier
 }
 
+// SetAddressCodec builds the Config with the codec used to convert account
+// addresses to and from their string form, in place of the Bech32 codec built
+// from the account address prefix. A nil codec restores the Bech32 codec.
+func (config *Config) SetAddressCodec(codec AddressCodec) {
+	config.assertNotSealed()
+	config.accAddressCodec = codec
+}
+
+// SetValidatorAddressCodec builds the Config with the codec used to convert
+// validator operator addresses to and from their string form, in place of the
+// Bech32 codec built from the validator address prefix. A nil codec restores
+// the Bech32 codec.
+func (config *Config) SetValidatorAddressCodec(codec AddressCodec) {
+	config.assertNotSealed()
+	config.valAddressCodec = codec
+}
+
+// SetConsensusAddressCodec builds the Config with the codec used to convert
+// consensus node addresses to and from their string form, in place of the
+// Bech32 codec built from the consensus address prefix. A nil codec restores
+// the Bech32 codec.
+func (config *Config) SetConsensusAddressCodec(codec AddressCodec) {
+	config.assertNotSealed()
+	config.consAddressCodec = codec
+}
+
 // Set the BIP-0044 CoinType code on the config
 func (config *Config) SetCoinType(coinType uint32) {
 	config.assertNotSealed()
@@ -171,6 +200,36 @@ func (config *Config) GetBech32ConsensusPubPrefix() string {
 	return config.bech32AddressPrefix["consensus_pub"]
 }
 
+// GetAccountAddressCodec returns the codec used to convert account addresses
+// to and from their string form, which defaults to Bech32.
+func (config *Config) GetAccountAddressCodec() AddressCodec {
+	if config.accAddressCodec != nil {
+		return config.accAddressCodec
+	}
+
+	return NewBech32Codec(config.GetBech32AccountAddrPrefix())
+}
+
+// GetValidatorAddressCodec returns the codec used to convert validator
+// operator addresses to and from their string form, which defaults to Bech32.
+func (config *Config) GetValidatorAddressCodec() AddressCodec {
+	if config.valAddressCodec != nil {
+		return config.valAddressCodec
+	}
+
+	return NewBech32Codec(config.GetBech32ValidatorAddrPrefix())
+}
+
+// GetConsensusAddressCodec returns the codec used to convert consensus node
+// addresses to and from their string form, which defaults to Bech32.
+func (config *Config) GetConsensusAddressCodec() AddressCodec {
+	if config.consAddressCodec != nil {
+		return config.consAddressCodec
+	}
+
+	return NewBech32Codec(config.GetBech32ConsensusAddrPrefix())
+}
+
 // GetTxEncoder return function to encode transactions
 func (config *Config) GetTxEncoder() TxEncoder {
 	return config.txEncoder
@@ -33,8 +33,10 @@ type Context struct {
 	checkTx       bool
 	recheckTx     bool // if recheckTx == true, then checkTx must also be true
 	minGasPrice   DecCoins
+	sigWorkers    int // the number of goroutines used to verify tx signatures in CheckTx
 	consParams    *abci.ConsensusParams
 	eventManager  *EventManager
+	priority      int64 // the priority of the tx being checked, set by the AnteHandler
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -54,7 +56,9 @@ func (c Context) BlockGasMeter() GasMeter     { return c.blockGasMeter }
 func (c Context) IsCheckTx() bool             { return c.checkTx }
 func (c Context) IsReCheckTx() bool           { return c.recheckTx }
 func (c Context) MinGasPrices() DecCoins      { return c.minGasPrice }
+func (c Context) SigVerifyWorkers() int       { return c.sigWorkers }
 func (c Context) EventManager() *EventManager { return c.eventManager }
+func (c Context) Priority() int64             { return c.priority }
 
 // clone the header before returning
 func (c Context) BlockHeader() abci.Header {
@@ -169,6 +173,11 @@ func (c Context) WithMinGasPrices(gasPrices DecCoins) Context {
 	return c
 }
 
+func (c Context) WithSigVerifyWorkers(workers int) Context {
+	c.sigWorkers = workers
+	return c
+}
+
 func (c Context) WithConsensusParams(params *abci.ConsensusParams) Context {
 	c.consParams = params
 	return c
@@ -179,6 +188,12 @@ func (c Context) WithEventManager(em *EventManager) Context {
 	return c
 }
 
+// WithPriority returns a Context with an updated tx priority
+func (c Context) WithPriority(priority int64) Context {
+	c.priority = priority
+	return c
+}
+
 // TODO: remove???
 func (c Context) IsZero() bool {
 	return c.ms == nil
@@ -106,6 +106,13 @@ var (
 	// ErrInvalidType defines an error an invalid type.
 	ErrInvalidType = Register(RootCodespace, 29, "invalid type")
 
+	// ErrTxTimeoutHeight defines an ABCI typed error for when a tx is rejected out
+	// due to a timeout height.
+	ErrTxTimeoutHeight = Register(RootCodespace, 30, "tx timeout height")
+
+	// ErrUnknownExtensionOptions defines an error for unknown extension options.
+	ErrUnknownExtensionOptions = Register(RootCodespace, 31, "unknown extension options")
+
 	// ErrPanic is only set when we recover from a panic, so we know to
 	// redact potentially sensitive system info
 	ErrPanic = Register(UndefinedCodespace, 111222, "panic")
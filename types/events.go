@@ -1,12 +1,18 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"sort"
 	"strings"
 
+	"github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmkv "github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 )
 
 // ----------------------------------------------------------------------------
@@ -35,6 +41,33 @@ func (em *EventManager) EmitEvents(events Events) {
 	em.events = em.events.AppendEvents(events)
 }
 
+// EmitTypedEvent takes a typed event and emits it, converted into an Event
+// object.
+func (em *EventManager) EmitTypedEvent(tev proto.Message) error {
+	event, err := TypedEventToEvent(tev)
+	if err != nil {
+		return err
+	}
+
+	em.EmitEvent(event)
+	return nil
+}
+
+// EmitTypedEvents takes a series of typed events and emits them.
+func (em *EventManager) EmitTypedEvents(tevs ...proto.Message) error {
+	events := make(Events, len(tevs))
+	for i, tev := range tevs {
+		event, err := TypedEventToEvent(tev)
+		if err != nil {
+			return err
+		}
+		events[i] = event
+	}
+
+	em.EmitEvents(events)
+	return nil
+}
+
 // ABCIEvents returns all stored Event objects as abci.Event objects.
 func (em EventManager) ABCIEvents() []abci.Event {
 	return em.events.ToABCIEvents()
@@ -119,6 +152,79 @@ func (e Events) ToABCIEvents() []abci.Event {
 	return res
 }
 
+// TypedEventToEvent takes a typed event and converts it into an Event object.
+// The event type is the fully qualified proto name of the message and each
+// top-level field of its proto3 JSON encoding becomes an attribute, whose
+// value is the JSON encoded field value. Attributes are sorted by key so the
+// conversion is deterministic.
+func TypedEventToEvent(tev proto.Message) (Event, error) {
+	evtType := proto.MessageName(tev)
+	if evtType == "" {
+		return Event{}, fmt.Errorf("%T is not a registered proto message", tev)
+	}
+
+	evtJSON, err := codec.ProtoMarshalJSON(tev)
+	if err != nil {
+		return Event{}, err
+	}
+
+	var attrMap map[string]json.RawMessage
+	if err := json.Unmarshal(evtJSON, &attrMap); err != nil {
+		return Event{}, err
+	}
+
+	keys := make([]string, 0, len(attrMap))
+	for k := range attrMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	attrs := make([]tmkv.Pair, len(keys))
+	for i, k := range keys {
+		attrs[i] = tmkv.Pair{Key: []byte(k), Value: attrMap[k]}
+	}
+
+	return Event{Type: evtType, Attributes: attrs}, nil
+}
+
+// ParseTypedEvent converts an abci.Event emitted through EmitTypedEvent back
+// into its typed event. The concrete type must be registered with the proto
+// registry.
+func ParseTypedEvent(event abci.Event) (proto.Message, error) {
+	concreteGoType := proto.MessageType(event.Type)
+	if concreteGoType == nil {
+		return nil, fmt.Errorf("failed to retrieve the message of type %q", event.Type)
+	}
+
+	var value reflect.Value
+	if concreteGoType.Kind() == reflect.Ptr {
+		value = reflect.New(concreteGoType.Elem())
+	} else {
+		value = reflect.Zero(concreteGoType)
+	}
+
+	protoMsg, ok := value.Interface().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%q does not implement proto.Message", event.Type)
+	}
+
+	attrMap := make(map[string]json.RawMessage, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attrMap[string(attr.Key)] = attr.Value
+	}
+
+	attrBytes, err := json.Marshal(attrMap)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := jsonpb.Unmarshal(strings.NewReader(string(attrBytes)), protoMsg); err != nil {
+		return nil, err
+	}
+
+	return protoMsg, nil
+}
+
 func toBytes(i interface{}) []byte {
 	switch x := i.(type) {
 	case []uint8:
@@ -133,11 +239,13 @@ func toBytes(i interface{}) []byte {
 // Common event types and attribute keys
 var (
 	EventTypeMessage = "message"
+	EventTypeTx      = "tx"
 
-	AttributeKeyAction = "action"
-	AttributeKeyModule = "module"
-	AttributeKeySender = "sender"
-	AttributeKeyAmount = "amount"
+	AttributeKeyAction   = "action"
+	AttributeKeyModule   = "module"
+	AttributeKeySender   = "sender"
+	AttributeKeyAmount   = "amount"
+	AttributeKeyPriority = "priority"
 )
 
 type (
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func TestAppendEvents(t *testing.T) {
@@ -53,6 +54,29 @@ func TestEventManager(t *testing.T) {
 	require.Equal(t, em.Events(), events.AppendEvent(event))
 }
 
+func TestEmitTypedEvent(t *testing.T) {
+	em := NewEventManager()
+	coin := NewInt64Coin("foo", 10)
+
+	require.NoError(t, em.EmitTypedEvent(&coin))
+	require.Len(t, em.Events(), 1)
+
+	event := em.Events()[0]
+	require.Equal(t, "cosmos_sdk.v1.Coin", event.Type)
+	require.Equal(t, "amount", string(event.Attributes[0].Key))
+	require.Equal(t, `"10"`, string(event.Attributes[0].Value))
+	require.Equal(t, "denom", string(event.Attributes[1].Key))
+	require.Equal(t, `"foo"`, string(event.Attributes[1].Value))
+
+	msg, err := ParseTypedEvent(em.ABCIEvents()[0])
+	require.NoError(t, err)
+	require.Equal(t, &coin, msg)
+
+	// unknown event types can't be parsed
+	_, err = ParseTypedEvent(abci.Event{Type: "transfer"})
+	require.Error(t, err)
+}
+
 func TestStringifyEvents(t *testing.T) {
 	e := Events{
 		NewEvent("message", NewAttribute("sender", "foo")),
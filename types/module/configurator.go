@@ -0,0 +1,79 @@
+package module
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VersionMap is a map of moduleName -> consensus version
+type VersionMap map[string]uint64
+
+// MigrationHandler is the migration function that each module registers to
+// migrate its state in place from one consensus version to the next.
+type MigrationHandler func(sdk.Context) error
+
+// Configurator collects the in-place store migrations registered by modules.
+type Configurator interface {
+	// RegisterMigration registers the migration of a module's state from
+	// fromVersion to fromVersion+1.
+	RegisterMigration(moduleName string, fromVersion uint64, handler MigrationHandler) error
+}
+
+type configurator struct {
+	cdc codec.JSONMarshaler
+
+	// migrations is a map of moduleName -> fromVersion -> migration handler
+	migrations map[string]map[uint64]MigrationHandler
+}
+
+// NewConfigurator returns a new Configurator instance. The codec is used to
+// initialize modules that are added by an upgrade from their default genesis.
+func NewConfigurator(cdc codec.JSONMarshaler) Configurator {
+	return configurator{
+		cdc:        cdc,
+		migrations: map[string]map[uint64]MigrationHandler{},
+	}
+}
+
+var _ Configurator = configurator{}
+
+// RegisterMigration implements the Configurator.RegisterMigration method
+func (c configurator) RegisterMigration(moduleName string, fromVersion uint64, handler MigrationHandler) error {
+	if fromVersion == 0 {
+		return fmt.Errorf("module %s: migrations must start from version 1", moduleName)
+	}
+
+	if c.migrations[moduleName] == nil {
+		c.migrations[moduleName] = map[uint64]MigrationHandler{}
+	}
+
+	if c.migrations[moduleName][fromVersion] != nil {
+		return fmt.Errorf("module %s: a migration from version %d has already been registered", moduleName, fromVersion)
+	}
+
+	c.migrations[moduleName][fromVersion] = handler
+	return nil
+}
+
+// runModuleMigrations runs all the registered migrations of a module from
+// fromVersion up to toVersion, in order.
+func (c configurator) runModuleMigrations(ctx sdk.Context, moduleName string, fromVersion, toVersion uint64) error {
+	if fromVersion > toVersion {
+		return fmt.Errorf("module %s: cannot migrate down from version %d to %d", moduleName, fromVersion, toVersion)
+	}
+
+	for v := fromVersion; v < toVersion; v++ {
+		handler := c.migrations[moduleName][v]
+		if handler == nil {
+			return fmt.Errorf("module %s: no migration registered from version %d to %d", moduleName, v, v+1)
+		}
+
+		if err := handler(ctx); err != nil {
+			return fmt.Errorf("module %s: migration from version %d failed: %w", moduleName, v, err)
+		}
+	}
+
+	return nil
+}
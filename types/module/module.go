@@ -30,6 +30,11 @@ package module
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/gogo/protobuf/grpc"
 
@@ -39,6 +44,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -154,6 +160,31 @@ type AppModule interface {
 	EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate
 }
 
+// MsgServiceAppModule is an AppModule that handles its messages through a
+// protobuf Msg service. Msgs accepted by a registered Msg service are routed to
+// it instead of the module's legacy Route handler.
+type MsgServiceAppModule interface {
+	AppModule
+
+	// RegisterMsgService allows a module to register a gRPC Msg service
+	RegisterMsgService(grpc.Server)
+}
+
+// VersionedAppModule is an AppModule that declares the consensus version of its
+// state and registers the in-place store migrations between versions. Modules
+// that do not implement it are considered to be at consensus version 1.
+type VersionedAppModule interface {
+	AppModule
+
+	// ConsensusVersion is a sequence number for state-breaking changes of the
+	// module. It must be incremented, and a migration registered, on each
+	// consensus-breaking change introduced by the module.
+	ConsensusVersion() uint64
+
+	// RegisterMigrations registers the module's migrations with the Configurator
+	RegisterMigrations(Configurator)
+}
+
 //___________________________
 
 // GenesisOnlyAppModule is an AppModule that only has import/export functionality
@@ -200,6 +231,7 @@ type Manager struct {
 	OrderExportGenesis []string
 	OrderBeginBlockers []string
 	OrderEndBlockers   []string
+	OrderMigrations    []string
 }
 
 // NewManager creates a new Manager object
@@ -221,26 +253,71 @@ func NewManager(modules ...AppModule) *Manager {
 	}
 }
 
-// SetOrderInitGenesis sets the order of init genesis calls
+// SetOrderInitGenesis sets the order of init genesis calls. It panics unless
+// every module appears exactly once.
 func (m *Manager) SetOrderInitGenesis(moduleNames ...string) {
+	m.assertModuleOrder("SetOrderInitGenesis", moduleNames)
 	m.OrderInitGenesis = moduleNames
 }
 
-// SetOrderExportGenesis sets the order of export genesis calls
+// SetOrderExportGenesis sets the order of export genesis calls. It panics
+// unless every module appears exactly once.
 func (m *Manager) SetOrderExportGenesis(moduleNames ...string) {
+	m.assertModuleOrder("SetOrderExportGenesis", moduleNames)
 	m.OrderExportGenesis = moduleNames
 }
 
-// SetOrderBeginBlockers sets the order of set begin-blocker calls
+// SetOrderBeginBlockers sets the order of set begin-blocker calls. It panics
+// unless every module appears exactly once.
 func (m *Manager) SetOrderBeginBlockers(moduleNames ...string) {
+	m.assertModuleOrder("SetOrderBeginBlockers", moduleNames)
 	m.OrderBeginBlockers = moduleNames
 }
 
-// SetOrderEndBlockers sets the order of set end-blocker calls
+// SetOrderEndBlockers sets the order of set end-blocker calls. It panics
+// unless every module appears exactly once.
 func (m *Manager) SetOrderEndBlockers(moduleNames ...string) {
+	m.assertModuleOrder("SetOrderEndBlockers", moduleNames)
 	m.OrderEndBlockers = moduleNames
 }
 
+// SetOrderMigrations sets the order of the modules in RunMigrations. It panics
+// unless every module appears exactly once. If not set, the init genesis order
+// is used.
+func (m *Manager) SetOrderMigrations(moduleNames ...string) {
+	m.assertModuleOrder("SetOrderMigrations", moduleNames)
+	m.OrderMigrations = moduleNames
+}
+
+// assertModuleOrder panics if moduleNames does not contain every module of the
+// manager exactly once.
+func (m *Manager) assertModuleOrder(setOrderFnName string, moduleNames []string) {
+	seen := make(map[string]bool, len(moduleNames))
+	for _, name := range moduleNames {
+		if _, ok := m.Modules[name]; !ok {
+			panic(fmt.Sprintf("%s: unknown module %s", setOrderFnName, name))
+		}
+
+		if seen[name] {
+			panic(fmt.Sprintf("%s: module %s appears more than once", setOrderFnName, name))
+		}
+
+		seen[name] = true
+	}
+
+	var missing []string
+	for name := range m.Modules {
+		if !seen[name] {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		panic(fmt.Sprintf("%s: missing modules %s", setOrderFnName, strings.Join(missing, ", ")))
+	}
+}
+
 // RegisterInvariants registers all module routes and module querier routes
 func (m *Manager) RegisterInvariants(ir sdk.InvariantRegistry) {
 	for _, module := range m.Modules {
@@ -267,6 +344,92 @@ func (m *Manager) RegisterQueryServices(grpcRouter grpc.Server) {
 	}
 }
 
+// RegisterMsgServices registers the Msg services of all modules implementing
+// MsgServiceAppModule
+func (m *Manager) RegisterMsgServices(msgServiceRouter grpc.Server) {
+	for _, module := range m.Modules {
+		if msgModule, ok := module.(MsgServiceAppModule); ok {
+			msgModule.RegisterMsgService(msgServiceRouter)
+		}
+	}
+}
+
+// RegisterMigrations registers the store migrations of all modules implementing
+// VersionedAppModule
+func (m *Manager) RegisterMigrations(cfg Configurator) {
+	for _, module := range m.Modules {
+		if versioned, ok := module.(VersionedAppModule); ok {
+			versioned.RegisterMigrations(cfg)
+		}
+	}
+}
+
+// GetVersionMap gets the consensus version of all modules
+func (m *Manager) GetVersionMap() VersionMap {
+	vm := make(VersionMap, len(m.Modules))
+	for name, module := range m.Modules {
+		vm[name] = consensusVersion(module)
+	}
+
+	return vm
+}
+
+// RunMigrations performs in-place store migrations for all modules, in the
+// migrations order, and returns the new consensus versions. It is meant to be
+// called from an upgrade handler with the version map stored before the
+// upgrade. Modules absent from fromVM are new and are initialized with their
+// default genesis. Modules whose consensus version did not change are left
+// untouched.
+//
+// An empty fromVM is rejected, as it would initialize every module over the
+// existing state. Chains that did not store a version map at genesis must seed
+// it with the consensus versions of their modules before the upgrade.
+func (m *Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM VersionMap) (VersionMap, error) {
+	c, ok := cfg.(configurator)
+	if !ok {
+		return nil, fmt.Errorf("expected %T, got %T", configurator{}, cfg)
+	}
+
+	if len(fromVM) == 0 {
+		return nil, errors.New("the version map to migrate from is empty; it must contain the consensus versions of all the existing modules")
+	}
+
+	order := m.OrderMigrations
+	if order == nil {
+		order = m.OrderInitGenesis
+	}
+
+	updatedVM := make(VersionMap, len(m.Modules))
+	for _, moduleName := range order {
+		module := m.Modules[moduleName]
+		toVersion := consensusVersion(module)
+
+		fromVersion, exists := fromVM[moduleName]
+		if !exists {
+			validatorUpdates := module.InitGenesis(ctx, c.cdc, module.DefaultGenesis(c.cdc))
+			if len(validatorUpdates) > 0 {
+				return nil, fmt.Errorf("module %s: validator set updates are not supported when adding a module in an upgrade", moduleName)
+			}
+		} else if err := c.runModuleMigrations(ctx, moduleName, fromVersion, toVersion); err != nil {
+			return nil, err
+		}
+
+		updatedVM[moduleName] = toVersion
+	}
+
+	return updatedVM, nil
+}
+
+// consensusVersion returns the consensus version of a module, defaulting to 1
+// for modules that do not declare one.
+func consensusVersion(module AppModule) uint64 {
+	if versioned, ok := module.(VersionedAppModule); ok {
+		return versioned.ConsensusVersion()
+	}
+
+	return 1
+}
+
 // InitGenesis performs init genesis functionality for modules
 func (m *Manager) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, genesisData map[string]json.RawMessage) abci.ResponseInitChain {
 	var validatorUpdates []abci.ValidatorUpdate
@@ -309,7 +472,9 @@ func (m *Manager) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) abci.R
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 
 	for _, moduleName := range m.OrderBeginBlockers {
+		start := time.Now()
 		m.Modules[moduleName].BeginBlock(ctx, req)
+		telemetry.ModuleMeasureSince(moduleName, start, telemetry.MetricKeyBeginBlocker)
 	}
 
 	return abci.ResponseBeginBlock{
@@ -325,7 +490,9 @@ func (m *Manager) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) abci.Respo
 	validatorUpdates := []abci.ValidatorUpdate{}
 
 	for _, moduleName := range m.OrderEndBlockers {
+		start := time.Now()
 		moduleValUpdates := m.Modules[moduleName].EndBlock(ctx, req)
+		telemetry.ModuleMeasureSince(moduleName, start, telemetry.MetricKeyEndBlocker)
 
 		// use these validator updates if provided, the module manager assumes
 		// only one module will update the validator set
@@ -106,6 +106,25 @@ func TestManagerOrderSetters(t *testing.T) {
 	require.Equal(t, []string{"module1", "module2"}, mm.OrderEndBlockers)
 	mm.SetOrderEndBlockers("module2", "module1")
 	require.Equal(t, []string{"module2", "module1"}, mm.OrderEndBlockers)
+
+	require.Nil(t, mm.OrderMigrations)
+	mm.SetOrderMigrations("module2", "module1")
+	require.Equal(t, []string{"module2", "module1"}, mm.OrderMigrations)
+
+	// every module must appear exactly once
+	require.PanicsWithValue(t, "SetOrderInitGenesis: missing modules module1", func() {
+		mm.SetOrderInitGenesis("module2")
+	})
+	require.PanicsWithValue(t, "SetOrderBeginBlockers: module module1 appears more than once", func() {
+		mm.SetOrderBeginBlockers("module1", "module2", "module1")
+	})
+	require.PanicsWithValue(t, "SetOrderEndBlockers: unknown module module3", func() {
+		mm.SetOrderEndBlockers("module1", "module2", "module3")
+	})
+	require.PanicsWithValue(t, "SetOrderMigrations: missing modules module1, module2", func() {
+		mm.SetOrderMigrations()
+	})
+	require.Equal(t, []string{"module2", "module1"}, mm.OrderInitGenesis)
 }
 
 func TestManager_RegisterInvariants(t *testing.T) {
@@ -272,3 +291,84 @@ func TestManager_EndBlock(t *testing.T) {
 	mockAppModule2.EXPECT().EndBlock(gomock.Any(), gomock.Eq(req)).Times(1).Return([]abci.ValidatorUpdate{{}})
 	require.Panics(t, func() { mm.EndBlock(sdk.Context{}, req) })
 }
+
+type versionedAppModule struct {
+	*mocks.MockAppModule
+
+	version            uint64
+	registerMigrations func(module.Configurator)
+}
+
+func (m versionedAppModule) ConsensusVersion() uint64 { return m.version }
+
+func (m versionedAppModule) RegisterMigrations(cfg module.Configurator) { m.registerMigrations(cfg) }
+
+func TestManager_RunMigrations(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	var migrated []uint64
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := versionedAppModule{
+		MockAppModule: mocks.NewMockAppModule(mockCtrl),
+		version:       3,
+		registerMigrations: func(cfg module.Configurator) {
+			for _, v := range []uint64{1, 2} {
+				v := v
+				require.NoError(t, cfg.RegisterMigration("module2", v, func(sdk.Context) error {
+					migrated = append(migrated, v)
+					return nil
+				}))
+			}
+
+			require.Error(t, cfg.RegisterMigration("module2", 1, func(sdk.Context) error { return nil }))
+		},
+	}
+	mockAppModule3 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mockAppModule3.EXPECT().Name().Times(2).Return("module3")
+	mm := module.NewManager(mockAppModule1, mockAppModule2, mockAppModule3)
+
+	require.Equal(t, module.VersionMap{"module1": 1, "module2": 3, "module3": 1}, mm.GetVersionMap())
+
+	cdc, ctx := codec.New(), sdk.Context{}
+	cfg := module.NewConfigurator(cdc)
+	mm.RegisterMigrations(cfg)
+
+	// an empty version map would initialize every module over the existing state
+	_, err := mm.RunMigrations(ctx, cfg, module.VersionMap{})
+	require.Error(t, err)
+
+	// module3 is new and gets initialized from its default genesis
+	defaultGenesis := json.RawMessage(`{"key": "value"}`)
+	mockAppModule3.EXPECT().DefaultGenesis(gomock.Eq(cdc)).Times(1).Return(defaultGenesis)
+	mockAppModule3.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(defaultGenesis)).Times(1).Return(nil)
+
+	vm, err := mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 1, "module2": 1})
+	require.NoError(t, err)
+	require.Equal(t, mm.GetVersionMap(), vm)
+	require.Equal(t, []uint64{1, 2}, migrated)
+
+	// migrations already applied are not run again
+	migrated = nil
+	_, err = mm.RunMigrations(ctx, cfg, vm)
+	require.NoError(t, err)
+	require.Empty(t, migrated)
+
+	// a missing migration fails the upgrade
+	_, err = mm.RunMigrations(ctx, module.NewConfigurator(cdc), module.VersionMap{"module1": 1, "module2": 2, "module3": 1})
+	require.Error(t, err)
+
+	// the migrations order takes precedence over the init genesis order
+	mm.SetOrderInitGenesis("module1", "module2", "module3")
+	mm.SetOrderMigrations("module3", "module2", "module1")
+	mockAppModule3.EXPECT().DefaultGenesis(gomock.Eq(cdc)).Times(1).Return(defaultGenesis)
+	mockAppModule3.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(defaultGenesis)).Times(1).Return(nil)
+
+	migrated = nil
+	vm, err = mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 1, "module2": 2})
+	require.NoError(t, err)
+	require.Equal(t, mm.GetVersionMap(), vm)
+	require.Equal(t, []uint64{2}, migrated)
+}
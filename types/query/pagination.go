@@ -0,0 +1,171 @@
+package query
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// DefaultLimit is the default `limit` for queries
+// if the `limit` is not supplied, paginate will use `DefaultLimit`
+const DefaultLimit = 100
+
+// NewPageRequestFromPage converts the 1-indexed page and limit parameters used
+// by the legacy queriers into an offset based PageRequest. A non-positive limit
+// is replaced by defLimit. It returns false if the page is out of range, in
+// which case callers should return an empty result set.
+func NewPageRequestFromPage(page, limit, defLimit int) (*PageRequest, bool) {
+	if page <= 0 {
+		return nil, false
+	}
+
+	if limit <= 0 {
+		limit = defLimit
+	}
+
+	if limit <= 0 {
+		return nil, false
+	}
+
+	return &PageRequest{
+		Offset: uint64((page - 1) * limit),
+		Limit:  uint64(limit),
+	}, true
+}
+
+// Paginate does pagination of all the results in the PrefixStore based on the
+// provided PageRequest. onResult should be used to do actual unmarshaling.
+//
+// Ex:
+//
+//	prefixStore := prefix.NewStore(store, someRequestParam)
+//	var results []Result
+//	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(key []byte, value []byte) error {
+//		var result Result
+//		if err := Unmarshal(value, &result); err != nil {
+//			return err
+//		}
+//		results = append(results, result)
+//		return nil
+//	})
+func Paginate(
+	prefixStore types.KVStore,
+	req *PageRequest,
+	onResult func(key []byte, value []byte) error,
+) (*PageResponse, error) {
+	return FilteredPaginate(prefixStore, req, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			if err := onResult(key, value); err != nil {
+				return false, err
+			}
+		}
+
+		return true, nil
+	})
+}
+
+// FilteredPaginate does pagination of all the results in the PrefixStore based
+// on the provided PageRequest. onResult reports whether the entry matches the
+// query filter; only matching entries count towards the offset, limit and
+// total. accumulate is true when a matching entry falls within the requested
+// page and should be added to the result set.
+func FilteredPaginate(
+	prefixStore types.KVStore,
+	req *PageRequest,
+	onResult func(key []byte, value []byte, accumulate bool) (bool, error),
+) (*PageResponse, error) {
+	// if the PageRequest is nil, use default PageRequest
+	if req == nil {
+		req = &PageRequest{}
+	}
+
+	offset := req.Offset
+	key := req.Key
+	limit := req.Limit
+	countTotal := req.CountTotal
+
+	if offset > 0 && key != nil {
+		return nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	if limit == 0 {
+		limit = DefaultLimit
+
+		// count total results when the limit is zero/not supplied
+		countTotal = true
+	}
+
+	if len(key) != 0 {
+		iterator := prefixStore.Iterator(key, nil)
+		defer iterator.Close()
+
+		var (
+			count   uint64
+			nextKey []byte
+		)
+
+		for ; iterator.Valid(); iterator.Next() {
+			if err := iterator.Error(); err != nil {
+				return nil, err
+			}
+
+			if count == limit {
+				nextKey = iterator.Key()
+				break
+			}
+
+			hit, err := onResult(iterator.Key(), iterator.Value(), true)
+			if err != nil {
+				return nil, err
+			}
+
+			if hit {
+				count++
+			}
+		}
+
+		return &PageResponse{NextKey: nextKey}, nil
+	}
+
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	end := offset + limit
+
+	var (
+		count   uint64
+		nextKey []byte
+	)
+
+	for ; iterator.Valid(); iterator.Next() {
+		if err := iterator.Error(); err != nil {
+			return nil, err
+		}
+
+		hit, err := onResult(iterator.Key(), iterator.Value(), count >= offset && count < end)
+		if err != nil {
+			return nil, err
+		}
+
+		if !hit {
+			continue
+		}
+
+		if count == end {
+			nextKey = iterator.Key()
+
+			if !countTotal {
+				break
+			}
+		}
+
+		count++
+	}
+
+	res := &PageResponse{NextKey: nextKey}
+	if countTotal {
+		res.Total = count
+	}
+
+	return res, nil
+}
@@ -0,0 +1,115 @@
+package query_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func setupStore(t *testing.T, n uint64) dbadapter.Store {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	for i := uint64(0); i < n; i++ {
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, i)
+		store.Set(key, key)
+	}
+
+	return store
+}
+
+func collect(t *testing.T, store dbadapter.Store, req *query.PageRequest) ([]uint64, *query.PageResponse) {
+	var values []uint64
+	res, err := query.Paginate(store, req, func(key []byte, value []byte) error {
+		values = append(values, binary.BigEndian.Uint64(value))
+		return nil
+	})
+	require.NoError(t, err)
+
+	return values, res
+}
+
+func TestPaginateOffset(t *testing.T) {
+	store := setupStore(t, 25)
+
+	values, res := collect(t, store, &query.PageRequest{Limit: 10, CountTotal: true})
+	require.Len(t, values, 10)
+	require.Equal(t, uint64(0), values[0])
+	require.Equal(t, uint64(25), res.Total)
+	require.NotNil(t, res.NextKey)
+
+	values, res = collect(t, store, &query.PageRequest{Offset: 20, Limit: 10})
+	require.Equal(t, []uint64{20, 21, 22, 23, 24}, values)
+	require.Nil(t, res.NextKey)
+	require.Zero(t, res.Total)
+
+	values, _ = collect(t, store, &query.PageRequest{Offset: 30, Limit: 10})
+	require.Empty(t, values)
+}
+
+func TestPaginateDefaultLimit(t *testing.T) {
+	store := setupStore(t, query.DefaultLimit+5)
+
+	values, res := collect(t, store, nil)
+	require.Len(t, values, query.DefaultLimit)
+	require.Equal(t, uint64(query.DefaultLimit+5), res.Total)
+}
+
+func TestPaginateKey(t *testing.T) {
+	store := setupStore(t, 25)
+
+	values, res := collect(t, store, &query.PageRequest{Limit: 10})
+	require.Len(t, values, 10)
+
+	values, res = collect(t, store, &query.PageRequest{Key: res.NextKey, Limit: 10})
+	require.Equal(t, uint64(10), values[0])
+	require.Len(t, values, 10)
+
+	values, res = collect(t, store, &query.PageRequest{Key: res.NextKey, Limit: 10})
+	require.Len(t, values, 5)
+	require.Nil(t, res.NextKey)
+
+	_, err := query.Paginate(store, &query.PageRequest{Key: []byte{0x01}, Offset: 1}, func(_, _ []byte) error { return nil })
+	require.Error(t, err)
+}
+
+func TestFilteredPaginate(t *testing.T) {
+	store := setupStore(t, 25)
+
+	var values []uint64
+	res, err := query.FilteredPaginate(store, &query.PageRequest{Offset: 2, Limit: 3, CountTotal: true},
+		func(key []byte, value []byte, accumulate bool) (bool, error) {
+			v := binary.BigEndian.Uint64(value)
+			if v%2 != 0 {
+				return false, nil
+			}
+
+			if accumulate {
+				values = append(values, v)
+			}
+
+			return true, nil
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{4, 6, 8}, values)
+	require.Equal(t, uint64(13), res.Total)
+	require.Equal(t, uint64(10), binary.BigEndian.Uint64(res.NextKey))
+}
+
+func TestNewPageRequestFromPage(t *testing.T) {
+	req, ok := query.NewPageRequestFromPage(3, 10, 100)
+	require.True(t, ok)
+	require.Equal(t, &query.PageRequest{Offset: 20, Limit: 10}, req)
+
+	req, ok = query.NewPageRequestFromPage(1, 0, 100)
+	require.True(t, ok)
+	require.Equal(t, uint64(100), req.Limit)
+
+	_, ok = query.NewPageRequestFromPage(0, 10, 100)
+	require.False(t, ok)
+}
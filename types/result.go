@@ -7,6 +7,7 @@ import (
 	"math"
 	"strings"
 
+	"github.com/gogo/protobuf/proto"
 	yaml "gopkg.in/yaml.v2"
 
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
@@ -34,6 +35,28 @@ func (r Result) GetEvents() Events {
 	return events
 }
 
+// WrapServiceResult wraps the response of a Msg service method into a Result.
+// The response is proto encoded into the Result data and the events emitted on
+// the context's EventManager are attached.
+func WrapServiceResult(ctx Context, res proto.Message, err error) (*Result, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	if res != nil {
+		data, err = proto.Marshal(res)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Result{
+		Data:   data,
+		Events: ctx.EventManager().ABCIEvents(),
+	}, nil
+}
+
 // ABCIMessageLogs represents a slice of ABCIMessageLog.
 type ABCIMessageLogs []ABCIMessageLog
 
@@ -0,0 +1,665 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: types/simulate/simulate.proto
+
+package simulate
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SimulateRequest is the request type for the SimulateService.Simulate RPC method
+type SimulateRequest struct {
+	// tx_bytes is the encoded transaction to simulate
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+}
+
+func (m *SimulateRequest) Reset()         { *m = SimulateRequest{} }
+func (m *SimulateRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateRequest) ProtoMessage()    {}
+func (*SimulateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf618abe59d45bd, []int{0}
+}
+func (m *SimulateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateRequest.Merge(m, src)
+}
+func (m *SimulateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateRequest proto.InternalMessageInfo
+
+func (m *SimulateRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+// SimulateResponse is the response type for the SimulateService.Simulate RPC method
+type SimulateResponse struct {
+	// gas_info is the gas wanted and used by the simulated transaction
+	GasInfo *types.GasInfo `protobuf:"bytes,1,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
+	// result is the result of executing the transaction's messages
+	Result *types.Result `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *SimulateResponse) Reset()         { *m = SimulateResponse{} }
+func (m *SimulateResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateResponse) ProtoMessage()    {}
+func (*SimulateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf618abe59d45bd, []int{1}
+}
+func (m *SimulateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateResponse.Merge(m, src)
+}
+func (m *SimulateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateResponse proto.InternalMessageInfo
+
+func (m *SimulateResponse) GetGasInfo() *types.GasInfo {
+	if m != nil {
+		return m.GasInfo
+	}
+	return nil
+}
+
+func (m *SimulateResponse) GetResult() *types.Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SimulateRequest)(nil), "cosmos_sdk.simulate.v1.SimulateRequest")
+	proto.RegisterType((*SimulateResponse)(nil), "cosmos_sdk.simulate.v1.SimulateResponse")
+}
+
+func init() { proto.RegisterFile("types/simulate/simulate.proto", fileDescriptor_ecf618abe59d45bd) }
+
+var fileDescriptor_ecf618abe59d45bd = []byte{
+	// 270 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2d, 0xa9, 0x2c, 0x48,
+	0x2d, 0xd6, 0x2f, 0xce, 0xcc, 0x2d, 0xcd, 0x49, 0x2c, 0x49, 0x85, 0x33, 0xf4, 0x0a, 0x8a, 0xf2,
+	0x4b, 0xf2, 0x85, 0xc4, 0x92, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xe3, 0x8b, 0x53, 0xb2, 0xf5, 0xe0,
+	0x52, 0x65, 0x86, 0x52, 0x82, 0x10, 0x6d, 0x60, 0x12, 0xa2, 0x54, 0x49, 0x87, 0x8b, 0x3f, 0x18,
+	0xaa, 0x22, 0x28, 0xb5, 0xb0, 0x34, 0xb5, 0xb8, 0x44, 0x48, 0x92, 0x8b, 0xa3, 0xa4, 0x22, 0x3e,
+	0xa9, 0xb2, 0x24, 0xb5, 0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0x27, 0x88, 0xbd, 0xa4, 0xc2, 0x09,
+	0xc4, 0x55, 0x2a, 0xe1, 0x12, 0x40, 0xa8, 0x2e, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x15, 0x32, 0xe4,
+	0xe2, 0x48, 0x4f, 0x2c, 0x8e, 0xcf, 0xcc, 0x4b, 0xcb, 0x07, 0x2b, 0xe7, 0x36, 0x12, 0xd3, 0x43,
+	0xb2, 0xbf, 0xcc, 0x50, 0xcf, 0x3d, 0xb1, 0xd8, 0x33, 0x2f, 0x2d, 0x3f, 0x88, 0x3d, 0x1d, 0xc2,
+	0x10, 0xd2, 0xe5, 0x62, 0x2b, 0x4a, 0x2d, 0x2e, 0xcd, 0x29, 0x91, 0x60, 0x02, 0x6b, 0x10, 0x45,
+	0xd3, 0x10, 0x04, 0x96, 0x0c, 0x82, 0x2a, 0x32, 0x2a, 0x42, 0xb8, 0x31, 0x38, 0xb5, 0xa8, 0x2c,
+	0x33, 0x39, 0x55, 0x28, 0x9e, 0x8b, 0x03, 0x26, 0x24, 0xa4, 0xae, 0x87, 0xdd, 0xbb, 0x7a, 0x68,
+	0x1e, 0x93, 0xd2, 0x20, 0xac, 0x10, 0xe2, 0x27, 0x25, 0x06, 0x27, 0xd7, 0x13, 0x8f, 0xe4, 0x18,
+	0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5,
+	0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4e, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce,
+	0xcf, 0xd5, 0x87, 0x98, 0x07, 0xa5, 0x74, 0x8b, 0x53, 0xb2, 0xf5, 0x51, 0x23, 0x26, 0x89, 0x0d,
+	0x1c, 0xca, 0xc6, 0x80, 0x01, 0x00, 0xcb, 0xb0, 0x47, 0x55, 0xb1, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SimulateServiceClient is the client API for SimulateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SimulateServiceClient interface {
+	// Simulate runs the transaction through the AnteHandler and message
+	// handlers against the latest committed state and returns the gas used and
+	// the result
+	Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error)
+}
+
+type simulateServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewSimulateServiceClient(cc grpc1.ClientConn) SimulateServiceClient {
+	return &simulateServiceClient{cc}
+}
+
+func (c *simulateServiceClient) Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error) {
+	out := new(SimulateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_sdk.simulate.v1.SimulateService/Simulate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SimulateServiceServer is the server API for SimulateService service.
+type SimulateServiceServer interface {
+	// Simulate runs the transaction through the AnteHandler and message
+	// handlers against the latest committed state and returns the gas used and
+	// the result
+	Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error)
+}
+
+// UnimplementedSimulateServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedSimulateServiceServer struct {
+}
+
+func (*UnimplementedSimulateServiceServer) Simulate(ctx context.Context, req *SimulateRequest) (*SimulateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Simulate not implemented")
+}
+
+func RegisterSimulateServiceServer(s grpc1.Server, srv SimulateServiceServer) {
+	s.RegisterService(&_SimulateService_serviceDesc, srv)
+}
+
+func _SimulateService_Simulate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimulateServiceServer).Simulate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_sdk.simulate.v1.SimulateService/Simulate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimulateServiceServer).Simulate(ctx, req.(*SimulateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SimulateService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos_sdk.simulate.v1.SimulateService",
+	HandlerType: (*SimulateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Simulate",
+			Handler:    _SimulateService_Simulate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "types/simulate/simulate.proto",
+}
+
+func (m *SimulateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintSimulate(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSimulate(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GasInfo != nil {
+		{
+			size, err := m.GasInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSimulate(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSimulate(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSimulate(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SimulateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovSimulate(uint64(l))
+	}
+	return n
+}
+
+func (m *SimulateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasInfo != nil {
+		l = m.GasInfo.Size()
+		n += 1 + l + sovSimulate(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovSimulate(uint64(l))
+	}
+	return n
+}
+
+func sovSimulate(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSimulate(x uint64) (n int) {
+	return sovSimulate(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSimulate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSimulate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSimulate
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSimulate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSimulate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSimulate
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSimulate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSimulate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSimulate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSimulate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSimulate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasInfo == nil {
+				m.GasInfo = &types.GasInfo{}
+			}
+			if err := m.GasInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSimulate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSimulate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSimulate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &types.Result{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSimulate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSimulate
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSimulate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSimulate(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSimulate
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSimulate
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSimulate
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSimulate
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSimulate
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSimulate
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSimulate        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSimulate          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSimulate = fmt.Errorf("proto: unexpected end of group")
+)
@@ -21,6 +21,7 @@ type (
 	MultiStorePersistentCache = types.MultiStorePersistentCache
 	KVStore                   = types.KVStore
 	Iterator                  = types.Iterator
+	WriteListener             = types.WriteListener
 )
 
 // StoreDecoderRegistry defines each of the modules store decoders. Used for ImportExport
@@ -46,7 +47,21 @@ func KVStorePrefixIteratorPaginated(kvs KVStore, prefix []byte, page, limit uint
 // KVStoreReversePrefixIteratorPaginated returns iterator over items in the selected page.
 // Items iterated and skipped in descending order.
 func KVStoreReversePrefixIteratorPaginated(kvs KVStore, prefix []byte, page, limit uint) Iterator {
-	return types.KVStorePrefixIteratorPaginated(kvs, prefix, page, limit)
+	return types.KVStoreReversePrefixIteratorPaginated(kvs, prefix, page, limit)
+}
+
+// KVStorePrefixRangeIterator iterates over the keys with a certain prefix in
+// ascending order, restricted to the domain [prefix|start, prefix|end). The
+// start and end keys are relative to the prefix; a nil start iterates from the
+// beginning of the prefix and a nil end iterates to its end.
+func KVStorePrefixRangeIterator(kvs KVStore, prefix, start, end []byte) Iterator {
+	return types.KVStorePrefixRangeIterator(kvs, prefix, start, end)
+}
+
+// KVStoreReversePrefixRangeIterator iterates over the keys with a certain prefix
+// in descending order, restricted to the domain [prefix|start, prefix|end).
+func KVStoreReversePrefixRangeIterator(kvs KVStore, prefix, start, end []byte) Iterator {
+	return types.KVStoreReversePrefixRangeIterator(kvs, prefix, start, end)
 }
 
 // DiffKVStores compares two KVstores and returns all the key/value pairs
@@ -122,3 +122,20 @@ func checkDiffResults(t *testing.T, store1, store2 types.KVStore) {
 	require.Equal(t, kvAs1, kvAs2)
 	require.Equal(t, kvBs1, kvBs2)
 }
+
+func TestKVStoreReversePrefixIteratorPaginated(t *testing.T) {
+	t.Parallel()
+	store, _ := initTestStores(t)
+	for i := 0; i < 5; i++ {
+		store.Set([]byte{byte(i)}, []byte{byte(i)})
+	}
+
+	iter := sdk.KVStoreReversePrefixIteratorPaginated(store, nil, 1, 2)
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	require.Equal(t, [][]byte{{4}, {3}}, keys)
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultTxDecoder returns a TxDecoder for protobuf encoded Tx's. Unknown
+// critical fields are rejected, unknown non-critical ones are ignored, and all
+// Any's, including extension options, must resolve to a type registered in
+// registry.
+func DefaultTxDecoder(registry codectypes.InterfaceRegistry) sdk.TxDecoder {
+	return func(txBytes []byte) (sdk.Tx, error) {
+		if _, err := unknownproto.RejectUnknownFields(txBytes, &Tx{}, true, registry); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+
+		var tx Tx
+		if err := tx.Unmarshal(txBytes); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+
+		if err := tx.UnpackInterfaces(registry); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+
+		return &tx, nil
+	}
+}
@@ -0,0 +1,130 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec/testdata"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestDefaultTxDecoder(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	sdk.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	txtypes.RegisterInterfaces(registry)
+	registry.RegisterImplementations((*txtypes.TxExtensionOptionI)(nil), &testdata.Dog{})
+
+	addr := sdk.AccAddress("addr________________")
+	msg, err := codectypes.NewAnyWithValue(banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))))
+	require.NoError(t, err)
+
+	extOpt, err := codectypes.NewAnyWithValue(&testdata.Dog{Name: "Spot"})
+	require.NoError(t, err)
+
+	unknownOpt, err := codectypes.NewAnyWithValue(&testdata.Cat{Moniker: "Garfield"})
+	require.NoError(t, err)
+
+	modeInfo := &txtypes.ModeInfo{Sum: &txtypes.ModeInfo_Single_{Single: &txtypes.ModeInfo_Single{Mode: signing.SignMode_SIGN_MODE_DIRECT}}}
+	authInfo := &txtypes.AuthInfo{
+		SignerInfos: []*txtypes.SignerInfo{{ModeInfo: modeInfo}},
+		Fee:         &txtypes.Fee{GasLimit: 200000},
+	}
+
+	multisigPubKey, err := codectypes.NewAnyWithValue(&cryptotypes.PublicKey{Sum: &cryptotypes.PublicKey_Multisig{
+		Multisig: &cryptotypes.PubKeyMultisigThreshold{K: 2},
+	}})
+	require.NoError(t, err)
+
+	// txBytes encodes a tx with the given body and auth info, with extra
+	// appended to the encoded body
+	txBytes := func(body *txtypes.TxBody, authInfo *txtypes.AuthInfo, extra []byte) []byte {
+		bodyBz, err := body.Marshal()
+		require.NoError(t, err)
+
+		authInfoBz, err := authInfo.Marshal()
+		require.NoError(t, err)
+
+		var bz []byte
+		for i, field := range [][]byte{append(bodyBz, extra...), authInfoBz, []byte("sig")} {
+			bz = append(bz, proto.EncodeVarint(uint64(i+1)<<3|proto.WireBytes)...)
+			bz = append(bz, proto.EncodeVarint(uint64(len(field)))...)
+			bz = append(bz, field...)
+		}
+
+		return bz
+	}
+
+	// unknownField encodes a varint field with the given number
+	unknownField := func(fieldNum int) []byte {
+		return append(proto.EncodeVarint(uint64(fieldNum)<<3|proto.WireVarint), 1)
+	}
+
+	body := &txtypes.TxBody{Messages: []*codectypes.Any{msg}, Memo: "memo"}
+
+	testCases := []struct {
+		name   string
+		bz     []byte
+		expErr bool
+	}{
+		{"valid tx", txBytes(body, authInfo, nil), false},
+		{
+			"registered extension options",
+			txBytes(&txtypes.TxBody{
+				Messages:                    body.Messages,
+				ExtensionOptions:            []*codectypes.Any{extOpt},
+				NonCriticalExtensionOptions: []*codectypes.Any{extOpt},
+			}, authInfo, nil),
+			false,
+		},
+		{
+			"unregistered extension option",
+			txBytes(&txtypes.TxBody{Messages: body.Messages, ExtensionOptions: []*codectypes.Any{unknownOpt}}, authInfo, nil),
+			true,
+		},
+		{
+			"unregistered non-critical extension option",
+			txBytes(&txtypes.TxBody{Messages: body.Messages, NonCriticalExtensionOptions: []*codectypes.Any{unknownOpt}}, authInfo, nil),
+			true,
+		},
+		{"unknown critical field", txBytes(body, authInfo, unknownField(4)), true},
+		{"unknown non-critical field", txBytes(body, authInfo, unknownField(1030)), false},
+		{
+			"multisig public key",
+			txBytes(body, &txtypes.AuthInfo{
+				SignerInfos: []*txtypes.SignerInfo{{PublicKey: multisigPubKey, ModeInfo: modeInfo}},
+				Fee:         authInfo.Fee,
+			}, nil),
+			true,
+		},
+		{"invalid bytes", []byte("invalid"), true},
+	}
+
+	decoder := txtypes.DefaultTxDecoder(registry)
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			tx, err := decoder(tc.bz)
+			if tc.expErr {
+				require.Error(t, err)
+				require.True(t, sdkerrors.ErrTxDecode.Is(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.NoError(t, tx.ValidateBasic())
+			require.Len(t, tx.GetMsgs(), 1)
+			require.Equal(t, []sdk.AccAddress{addr}, tx.(*txtypes.Tx).GetSigners())
+		})
+	}
+}
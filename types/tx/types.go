@@ -0,0 +1,300 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/crypto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var (
+	_ sdk.Tx                             = (*Tx)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*Tx)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*TxBody)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*AuthInfo)(nil)
+)
+
+// TxExtensionOptionI defines the interface implemented by tx extension
+// options. Only the implementations registered against it in the
+// InterfaceRegistry are accepted when decoding a transaction.
+type TxExtensionOptionI interface {
+	proto.Message
+}
+
+// PublicKeyI defines the interface implemented by the public keys of the
+// signers of a transaction. Only cosmos_sdk.crypto.v1.PublicKey is supported.
+type PublicKeyI interface {
+	proto.Message
+}
+
+// RegisterInterfaces registers the tx extension option and signer public key
+// interfaces. Chains accepting extension options register their
+// implementations against TxExtensionOptionI.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterInterface("cosmos_sdk.tx.v1.TxExtensionOptionI", (*TxExtensionOptionI)(nil))
+	registry.RegisterInterface("cosmos_sdk.tx.v1.PublicKeyI", (*PublicKeyI)(nil), &cryptotypes.PublicKey{})
+}
+
+// GetMsgs implements the sdk.Tx interface. It panics if the messages have not
+// been unpacked with UnpackInterfaces.
+func (t *Tx) GetMsgs() []sdk.Msg {
+	if t == nil || t.Body == nil {
+		return nil
+	}
+
+	msgs := make([]sdk.Msg, len(t.Body.Messages))
+	for i, any := range t.Body.Messages {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			panic(fmt.Sprintf("message %s has not been unpacked", any.TypeUrl))
+		}
+
+		msgs[i] = msg
+	}
+
+	return msgs
+}
+
+// ValidateBasic implements the sdk.Tx interface.
+func (t *Tx) ValidateBasic() error {
+	if t == nil || t.Body == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrTxDecode, "missing tx body")
+	}
+
+	if t.AuthInfo == nil || t.AuthInfo.Fee == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrTxDecode, "missing fee")
+	}
+
+	if len(t.Body.Messages) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "must contain at least one message")
+	}
+
+	if t.Body.TimeoutHeight < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid timeout height: %d", t.Body.TimeoutHeight)
+	}
+
+	if t.AuthInfo.Fee.Amount.IsAnyNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "invalid fee provided: %s", t.AuthInfo.Fee.Amount)
+	}
+
+	if len(t.Signatures) == 0 {
+		return sdkerrors.ErrNoSignatures
+	}
+
+	if len(t.Signatures) != len(t.GetSigners()) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"wrong number of signers; expected %d, got %d", len(t.GetSigners()), len(t.Signatures),
+		)
+	}
+
+	if len(t.AuthInfo.SignerInfos) != len(t.Signatures) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"wrong number of signer infos; expected %d, got %d", len(t.Signatures), len(t.AuthInfo.SignerInfos),
+		)
+	}
+
+	return nil
+}
+
+// GetSigners returns the addresses that must sign the transaction, in the
+// order they first appear in its messages.
+func (t *Tx) GetSigners() []sdk.AccAddress {
+	var signers []sdk.AccAddress
+	seen := map[string]bool{}
+
+	for _, msg := range t.GetMsgs() {
+		for _, addr := range msg.GetSigners() {
+			if !seen[addr.String()] {
+				signers = append(signers, addr)
+				seen[addr.String()] = true
+			}
+		}
+	}
+
+	return signers
+}
+
+// GetMemo returns the memo of the tx.
+func (t *Tx) GetMemo() string {
+	return t.GetBody().GetMemo()
+}
+
+// GetGas returns the gas limit of the tx.
+func (t *Tx) GetGas() uint64 {
+	return t.GetAuthInfo().GetFee().GetGasLimit()
+}
+
+// GetFee returns the fee of the tx.
+func (t *Tx) GetFee() sdk.Coins {
+	return t.GetAuthInfo().GetFee().GetAmount()
+}
+
+// FeePayer returns the address that pays the fee of the tx, which is the
+// first signer. It returns nil if the tx has no signers.
+func (t *Tx) FeePayer() sdk.AccAddress {
+	if signers := t.GetSigners(); len(signers) > 0 {
+		return signers[0]
+	}
+
+	return nil
+}
+
+// GetPubKeys returns the public keys of the signers of the tx, in the order of
+// GetSigners. The public key of a signer whose account already holds one in
+// state may be omitted from the tx, in which case it is nil. It panics if a
+// public key cannot be decoded, which UnpackInterfaces rejects.
+func (t *Tx) GetPubKeys() []crypto.PubKey {
+	signerInfos := t.GetAuthInfo().GetSignerInfos()
+	pubKeys := make([]crypto.PubKey, len(signerInfos))
+
+	for i, si := range signerInfos {
+		if si.PublicKey == nil {
+			continue
+		}
+
+		pk, err := decodePubKey(si.PublicKey)
+		if err != nil {
+			panic(err)
+		}
+
+		pubKeys[i] = pk
+	}
+
+	return pubKeys
+}
+
+// GetSignatureData returns the signatures of the tx along with the sign mode
+// each of them was made with, in the order of GetSigners. Multisig signers are
+// not supported yet.
+func (t *Tx) GetSignatureData() ([]signing.SignatureData, error) {
+	signerInfos := t.GetAuthInfo().GetSignerInfos()
+	if len(signerInfos) != len(t.Signatures) {
+		return nil, fmt.Errorf("expected %d signer infos, got %d", len(t.Signatures), len(signerInfos))
+	}
+
+	sigData := make([]signing.SignatureData, len(signerInfos))
+	for i, si := range signerInfos {
+		single, ok := si.GetModeInfo().GetSum().(*ModeInfo_Single_)
+		if !ok {
+			return nil, fmt.Errorf("unsupported mode info %T for signer %d", si.GetModeInfo().GetSum(), i)
+		}
+
+		sigData[i] = &signing.SingleSignatureData{
+			SignMode:  single.Single.Mode,
+			Signature: t.Signatures[i],
+		}
+	}
+
+	return sigData, nil
+}
+
+// GetTimeoutHeight returns the block height after which the tx can no longer
+// be included in a block, or zero if it never times out. A negative timeout
+// height, which ValidateBasic rejects, is returned as zero.
+func (t *Tx) GetTimeoutHeight() uint64 {
+	if height := t.GetBody().GetTimeoutHeight(); height > 0 {
+		return uint64(height)
+	}
+
+	return 0
+}
+
+// GetExtensionOptions returns the critical extension options of the tx.
+func (t *Tx) GetExtensionOptions() []*codectypes.Any {
+	return t.GetBody().GetExtensionOptions()
+}
+
+// GetNonCriticalExtensionOptions returns the non-critical extension options of
+// the tx.
+func (t *Tx) GetNonCriticalExtensionOptions() []*codectypes.Any {
+	return t.GetBody().GetNonCriticalExtensionOptions()
+}
+
+// UnpackInterfaces implements the UnpackInterfacesMessage interface.
+func (t *Tx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if t.Body != nil {
+		if err := t.Body.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	if t.AuthInfo != nil {
+		return t.AuthInfo.UnpackInterfaces(unpacker)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements the UnpackInterfacesMessage interface.
+func (m *TxBody) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range m.Messages {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+
+	for _, any := range m.ExtensionOptions {
+		var opt TxExtensionOptionI
+		if err := unpacker.UnpackAny(any, &opt); err != nil {
+			return err
+		}
+	}
+
+	for _, any := range m.NonCriticalExtensionOptions {
+		var opt TxExtensionOptionI
+		if err := unpacker.UnpackAny(any, &opt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements the UnpackInterfacesMessage interface. It also
+// rejects the signer public keys that cannot be decoded.
+func (m *AuthInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, si := range m.SignerInfos {
+		if si.PublicKey == nil {
+			continue
+		}
+
+		var pk PublicKeyI
+		if err := unpacker.UnpackAny(si.PublicKey, &pk); err != nil {
+			return err
+		}
+
+		if _, err := decodePubKey(si.PublicKey); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// decodePubKey decodes a signer public key packed in an Any. Multisig public
+// keys are rejected as multisig signers are not supported yet.
+func decodePubKey(any *codectypes.Any) (crypto.PubKey, error) {
+	pk, ok := any.GetCachedValue().(*cryptotypes.PublicKey)
+	if !ok {
+		pk = &cryptotypes.PublicKey{}
+		if err := pk.Unmarshal(any.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, ok := pk.Sum.(*cryptotypes.PublicKey_Multisig); ok {
+		return nil, fmt.Errorf("multisig public keys are not supported")
+	}
+
+	return cryptocodec.DefaultPublicKeyCodec{}.Decode(pk)
+}
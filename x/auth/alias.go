@@ -20,6 +20,8 @@ const (
 	DefaultTxSizeCostPerByte      = types.DefaultTxSizeCostPerByte
 	DefaultSigVerifyCostED25519   = types.DefaultSigVerifyCostED25519
 	DefaultSigVerifyCostSecp256k1 = types.DefaultSigVerifyCostSecp256k1
+	DefaultSigVerifyCostSecp256r1 = types.DefaultSigVerifyCostSecp256r1
+	DefaultPubKeyChangeCost       = types.DefaultPubKeyChangeCost
 	QueryAccount                  = types.QueryAccount
 	QueryParams                   = types.QueryParams
 	MaxGasWanted                  = types.MaxGasWanted
@@ -75,6 +77,8 @@ var (
 	KeyTxSizeCostPerByte      = types.KeyTxSizeCostPerByte
 	KeySigVerifyCostED25519   = types.KeySigVerifyCostED25519
 	KeySigVerifyCostSecp256k1 = types.KeySigVerifyCostSecp256k1
+	KeySigVerifyCostSecp256r1 = types.KeySigVerifyCostSecp256r1
+	KeyPubKeyChangeCost       = types.KeyPubKeyChangeCost
 )
 
 type (
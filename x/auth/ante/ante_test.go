@@ -13,11 +13,20 @@ import (
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // run the tx through the anteHandler and ensure its valid
@@ -38,7 +47,7 @@ func TestSimulateGasCost(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
 	ctx = ctx.WithBlockHeight(1)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer, authtx.DefaultSignModeHandler())
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -92,7 +101,7 @@ func TestSimulateGasCost(t *testing.T) {
 func TestAnteHandlerSigErrors(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer, authtx.DefaultSignModeHandler())
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -142,7 +151,7 @@ func TestAnteHandlerAccountNumbers(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(false)
 	ctx = ctx.WithBlockHeight(1)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer, authtx.DefaultSignModeHandler())
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -201,7 +210,7 @@ func TestAnteHandlerAccountNumbersAtBlockHeightZero(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(false)
 	ctx = ctx.WithBlockHeight(0)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer, authtx.DefaultSignModeHandler())
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -259,7 +268,7 @@ func TestAnteHandlerSequences(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(false)
 	ctx = ctx.WithBlockHeight(1)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer, authtx.DefaultSignModeHandler())
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -335,7 +344,7 @@ func TestAnteHandlerSequences(t *testing.T) {
 func TestAnteHandlerFees(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer, authtx.DefaultSignModeHandler())
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -377,7 +386,7 @@ func TestAnteHandlerMemoGas(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
 	ctx = ctx.WithBlockHeight(1)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer, authtx.DefaultSignModeHandler())
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -417,7 +426,7 @@ func TestAnteHandlerMultiSigner(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(false)
 	ctx = ctx.WithBlockHeight(1)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer, authtx.DefaultSignModeHandler())
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -467,7 +476,7 @@ func TestAnteHandlerBadSignBytes(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
 	ctx = ctx.WithBlockHeight(1)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer, authtx.DefaultSignModeHandler())
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -521,7 +530,7 @@ func TestAnteHandlerBadSignBytes(t *testing.T) {
 	for _, cs := range cases {
 		tx := types.NewTestTxWithSignBytes(
 			msgs, privs, accnums, seqs, fee,
-			types.StdSignBytes(cs.chainID, cs.accnum, cs.seq, cs.fee, cs.msgs, ""),
+			types.StdSignBytes(cs.chainID, cs.accnum, cs.seq, 0, cs.fee, cs.msgs, ""),
 			"",
 		)
 		checkInvalidTx(t, anteHandler, ctx, tx, false, cs.err)
@@ -544,7 +553,7 @@ func TestAnteHandlerSetPubKey(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
 	ctx = ctx.WithBlockHeight(1)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer, authtx.DefaultSignModeHandler())
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -592,15 +601,52 @@ func TestAnteHandlerSetPubKey(t *testing.T) {
 	require.Nil(t, acc2.GetPubKey())
 }
 
+// Test that an account whose public key was rotated can only sign with its new key.
+func TestAnteHandlerRotatedPubKey(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(1)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer, authtx.DefaultSignModeHandler())
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	priv2, _, _ := types.KeyTestPubAddr()
+
+	// set the account with a rotated public key
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	require.NoError(t, acc1.SetAccountNumber(0))
+	require.NoError(t, acc1.SetPubKey(priv2.PubKey()))
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	app.BankKeeper.SetBalances(ctx, addr1, types.NewTestCoins())
+
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	fee := types.NewTestStdFee()
+
+	// the previous key is rejected
+	tx := types.NewTestTx(ctx, msgs, []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdkerrors.ErrInvalidPubKey)
+
+	// the new key is accepted even though it does not match the address
+	tx = types.NewTestTx(ctx, msgs, []crypto.PrivKey{priv2}, []uint64{0}, []uint64{0}, fee)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+
+	acc1 = app.AccountKeeper.GetAccount(ctx, addr1)
+	require.Equal(t, priv2.PubKey(), acc1.GetPubKey())
+	require.Equal(t, uint64(1), acc1.GetSequence())
+}
+
 func generatePubKeysAndSignatures(n int, msg []byte, _ bool) (pubkeys []crypto.PubKey, signatures [][]byte) {
 	pubkeys = make([]crypto.PubKey, n)
 	signatures = make([][]byte, n)
 	for i := 0; i < n; i++ {
 		var privkey crypto.PrivKey
-		if rand.Int63()%2 == 0 {
+		switch rand.Int63() % 3 {
+		case 0:
 			privkey = ed25519.GenPrivKey()
-		} else {
+		case 1:
 			privkey = secp256k1.GenPrivKey()
+		default:
+			privkey = secp256r1.GenPrivKey()
 		}
 		pubkeys[i] = privkey.PubKey()
 		signatures[i], _ = privkey.Sign(msg)
@@ -617,6 +663,8 @@ func expectedGasCostByKeys(pubkeys []crypto.PubKey) uint64 {
 			cost += types.DefaultParams().SigVerifyCostED25519
 		case strings.Contains(pubkeyType, "secp256k1"):
 			cost += types.DefaultParams().SigVerifyCostSecp256k1
+		case strings.Contains(pubkeyType, "secp256r1"):
+			cost += types.DefaultParams().SigVerifyCostSecp256r1
 		default:
 			panic("unexpected key type")
 		}
@@ -662,7 +710,7 @@ func TestAnteHandlerSigLimitExceeded(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
 	ctx = ctx.WithBlockHeight(1)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer, authtx.DefaultSignModeHandler())
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -711,7 +759,7 @@ func TestCustomSignatureVerificationGasConsumer(t *testing.T) {
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "unrecognized public key type: %T", pubkey)
 		}
-	})
+	}, authtx.DefaultSignModeHandler())
 
 	// verify that an secp256k1 account gets rejected
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -744,6 +792,34 @@ func TestCustomSignatureVerificationGasConsumer(t *testing.T) {
 	checkValidTx(t, anteHandler, ctx, tx, false)
 }
 
+// Test that the default ante handler accepts accounts of every supported key type
+func TestAnteHandlerAccountKeyTypes(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(1)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer, authtx.DefaultSignModeHandler())
+
+	privs := []crypto.PrivKey{secp256k1.GenPrivKey(), ed25519.GenPrivKey(), secp256r1.GenPrivKey()}
+	for i, priv := range privs {
+		addr := sdk.AccAddress(priv.PubKey().Address())
+		acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+		require.NoError(t, acc.SetAccountNumber(uint64(i)))
+		app.AccountKeeper.SetAccount(ctx, acc)
+		require.NoError(t, app.BankKeeper.SetBalances(ctx, addr, types.NewTestCoins()))
+
+		msgs := []sdk.Msg{types.NewTestMsg(addr)}
+		tx := types.NewTestTx(ctx, msgs, []crypto.PrivKey{priv}, []uint64{uint64(i)}, []uint64{0}, types.NewTestStdFee())
+		checkValidTx(t, anteHandler, ctx, tx, false)
+
+		// the pubkey is stored on the account after the first tx
+		require.Equal(t, priv.PubKey(), app.AccountKeeper.GetAccount(ctx, addr).GetPubKey())
+
+		// a signature over the wrong sequence is rejected
+		tx = types.NewTestTx(ctx, msgs, []crypto.PrivKey{priv}, []uint64{uint64(i)}, []uint64{5}, types.NewTestStdFee())
+		checkInvalidTx(t, anteHandler, ctx, tx, false, sdkerrors.ErrUnauthorized)
+	}
+}
+
 func TestAnteHandlerReCheck(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
@@ -761,7 +837,7 @@ func TestAnteHandlerReCheck(t *testing.T) {
 	app.AccountKeeper.SetAccount(ctx, acc1)
 	app.BankKeeper.SetBalances(ctx, addr1, types.NewTestCoins())
 
-	antehandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer)
+	antehandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer, authtx.DefaultSignModeHandler())
 
 	// test that operations skipped on recheck do not run
 
@@ -790,9 +866,9 @@ func TestAnteHandlerReCheck(t *testing.T) {
 		name   string
 		params types.Params
 	}{
-		{"memo size check", types.NewParams(1, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1)},
-		{"txsize check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 10000000, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1)},
-		{"sig verify cost check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, 100000000)},
+		{"memo size check", types.NewParams(1, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultSigVerifyCostSecp256r1, types.DefaultPubKeyChangeCost)},
+		{"txsize check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 10000000, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultSigVerifyCostSecp256r1, types.DefaultPubKeyChangeCost)},
+		{"sig verify cost check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, 100000000, types.DefaultSigVerifyCostSecp256r1, types.DefaultPubKeyChangeCost)},
 	}
 	for _, tc := range testCases {
 		// set testcase parameters
@@ -824,3 +900,86 @@ func TestAnteHandlerReCheck(t *testing.T) {
 	_, err = antehandler(ctx, tx, false)
 	require.NotNil(t, err, "antehandler on recheck did not fail once feePayer no longer has sufficient funds")
 }
+
+// Test that protobuf txs decoded by the app tx decoder go through the
+// AnteHandler, whether signed with SIGN_MODE_DIRECT, SIGN_MODE_LEGACY_AMINO_JSON
+// or SIGN_MODE_TEXTUAL
+func TestAnteHandlerProtoTx(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(false)
+	ctx = ctx.WithBlockHeight(1)
+	signModeHandler := authtx.DefaultSignModeHandler()
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer, signModeHandler)
+	txDecoder := authtx.DefaultTxDecoder(app.Codec(), simapp.MakeInterfaceRegistry())
+
+	// keys and addresses
+	priv1, pub1, addr1 := types.KeyTestPubAddr()
+
+	// set the accounts
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, addr1, types.NewTestCoins()))
+
+	msg, err := codectypes.NewAnyWithValue(banktypes.NewMsgSend(addr1, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 1))))
+	require.NoError(t, err)
+
+	pk, err := cryptocodec.DefaultPublicKeyCodec{}.Encode(pub1)
+	require.NoError(t, err)
+	pkAny, err := codectypes.NewAnyWithValue(pk)
+	require.NoError(t, err)
+
+	// signTx returns the encoded tx signed by priv1 with the given sign mode,
+	// sequence and chain-id
+	signTx := func(mode signing.SignMode, seq uint64, chainID string) []byte {
+		tx := &txtypes.Tx{
+			Body: &txtypes.TxBody{Messages: []*codectypes.Any{msg}, Memo: "memo"},
+			AuthInfo: &txtypes.AuthInfo{
+				SignerInfos: []*txtypes.SignerInfo{{
+					PublicKey: pkAny,
+					ModeInfo:  &txtypes.ModeInfo{Sum: &txtypes.ModeInfo_Single_{Single: &txtypes.ModeInfo_Single{Mode: mode}}},
+				}},
+				Fee: &txtypes.Fee{Amount: types.NewTestStdFee().Amount, GasLimit: 200000},
+			},
+		}
+
+		signerData := authsigning.SignerData{ChainID: chainID, AccountNumber: acc1.GetAccountNumber(), AccountSequence: seq}
+		signBytes, err := signModeHandler.GetSignBytes(mode, signerData, tx)
+		require.NoError(t, err)
+
+		sig, err := priv1.Sign(signBytes)
+		require.NoError(t, err)
+		tx.Signatures = [][]byte{sig}
+
+		bz, err := tx.Marshal()
+		require.NoError(t, err)
+
+		return bz
+	}
+
+	testCases := []struct {
+		name   string
+		txBz   []byte
+		expErr error
+	}{
+		{"direct", signTx(signing.SignMode_SIGN_MODE_DIRECT, 0, ctx.ChainID()), nil},
+		{"amino json", signTx(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, 1, ctx.ChainID()), nil},
+		{"textual", signTx(signing.SignMode_SIGN_MODE_TEXTUAL, 2, ctx.ChainID()), nil},
+		{"replayed sequence", signTx(signing.SignMode_SIGN_MODE_DIRECT, 0, ctx.ChainID()), sdkerrors.ErrUnauthorized},
+		{"wrong chain-id", signTx(signing.SignMode_SIGN_MODE_DIRECT, 3, "other-chain"), sdkerrors.ErrUnauthorized},
+	}
+
+	for _, tc := range testCases {
+		tx, err := txDecoder(tc.txBz)
+		require.NoError(t, err, tc.name)
+		require.IsType(t, &txtypes.Tx{}, tx, tc.name)
+
+		if tc.expErr == nil {
+			checkValidTx(t, anteHandler, ctx, tx, false)
+		} else {
+			checkInvalidTx(t, anteHandler, ctx, tx, false, tc.expErr)
+		}
+	}
+
+	require.Equal(t, uint64(3), app.AccountKeeper.GetAccount(ctx, addr1).GetSequence())
+	require.Equal(t, pub1, app.AccountKeeper.GetAccount(ctx, addr1).GetPubKey())
+}
@@ -7,11 +7,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 var (
-	_ TxWithMemo = (*types.StdTx)(nil) // assert StdTx implements TxWithMemo
+	_ TxWithMemo          = (*types.StdTx)(nil) // assert StdTx implements TxWithMemo
+	_ TxWithTimeoutHeight = (*types.StdTx)(nil) // assert StdTx implements TxWithTimeoutHeight
+	_ TxWithTimeoutHeight = (*txtypes.Tx)(nil)  // assert Tx implements TxWithTimeoutHeight
 )
 
 // ValidateBasicDecorator will call tx.ValidateBasic and return any non-nil error.
@@ -75,6 +78,41 @@ func (vmd ValidateMemoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 	return next(ctx, tx, simulate)
 }
 
+// TxWithTimeoutHeight defines the interface a tx must implement in order for
+// TxTimeoutHeightDecorator to process the tx.
+type TxWithTimeoutHeight interface {
+	sdk.Tx
+	GetTimeoutHeight() uint64
+}
+
+// TxTimeoutHeightDecorator defines an AnteHandler decorator that checks for a
+// tx height timeout. A tx with a timeout height of zero never times out.
+type TxTimeoutHeightDecorator struct{}
+
+func NewTxTimeoutHeightDecorator() TxTimeoutHeightDecorator {
+	return TxTimeoutHeightDecorator{}
+}
+
+// AnteHandle implements an AnteHandler decorator for the TxTimeoutHeightDecorator
+// type where the current block height is checked against the tx's height timeout.
+// If a height timeout is provided (non-zero) and is less than the current block
+// height, then an error is returned.
+func (txh TxTimeoutHeightDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	timeoutTx, ok := tx.(TxWithTimeoutHeight)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "expected tx to implement TxWithTimeoutHeight")
+	}
+
+	timeoutHeight := timeoutTx.GetTimeoutHeight()
+	if timeoutHeight > 0 && uint64(ctx.BlockHeight()) > timeoutHeight {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrTxTimeoutHeight, "block height: %d, timeout height: %d", ctx.BlockHeight(), timeoutHeight,
+		)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
 // ConsumeTxSizeGasDecorator will take in parameters and consume gas proportional
 // to the size of tx before calling next AnteHandler. Note, the gas costs will be
 // slightly over estimated due to the fact that any given signing account may need
@@ -9,6 +9,8 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -80,6 +82,55 @@ func TestValidateMemo(t *testing.T) {
 	require.Nil(t, err, "ValidateBasicDecorator returned error on valid tx. err: %v", err)
 }
 
+func TestTxTimeoutHeight(t *testing.T) {
+	// setup
+	_, ctx := createTestApp(true)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+
+	// msg and signatures
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	fee := types.NewTestStdFee()
+
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewTxTimeoutHeightDecorator())
+
+	testCases := []struct {
+		name      string
+		timeout   uint64
+		height    int64
+		expectErr bool
+	}{
+		{"no timeout", 0, 10, false},
+		{"timeout (height > timeout)", 5, 10, true},
+		{"timeout (height == timeout)", 10, 10, false},
+		{"timeout (height < timeout)", 15, 10, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee).(types.StdTx)
+			tx.TimeoutHeight = tc.timeout
+
+			_, err := antehandler(ctx.WithBlockHeight(tc.height), tx, false)
+			require.Equal(t, tc.expectErr, err != nil, err)
+			if tc.expectErr {
+				require.True(t, sdkerrors.ErrTxTimeoutHeight.Is(err))
+			}
+
+			// the timeout height of a protobuf tx is read from its body
+			protoTx := &txtypes.Tx{Body: &txtypes.TxBody{TimeoutHeight: int64(tc.timeout)}}
+
+			_, err = antehandler(ctx.WithBlockHeight(tc.height), protoTx, false)
+			require.Equal(t, tc.expectErr, err != nil, err)
+		})
+	}
+}
+
 func TestConsumeGasForTxSize(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
@@ -0,0 +1,33 @@
+package ante
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// HasExtensionOptionsTx defines the interface a tx must implement in order for
+// RejectExtensionOptionsDecorator to process the tx.
+type HasExtensionOptionsTx interface {
+	GetExtensionOptions() []*codectypes.Any
+	GetNonCriticalExtensionOptions() []*codectypes.Any
+}
+
+// RejectExtensionOptionsDecorator is an AnteDecorator that rejects all
+// critical extension options, which can optionally be included in protobuf
+// transactions, as none are handled by default. Chains that handle extension
+// options should replace it with their own decorator. Non-critical extension
+// options are ignored.
+type RejectExtensionOptionsDecorator struct{}
+
+func NewRejectExtensionOptionsDecorator() RejectExtensionOptionsDecorator {
+	return RejectExtensionOptionsDecorator{}
+}
+
+func (r RejectExtensionOptionsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if extTx, ok := tx.(HasExtensionOptionsTx); ok && len(extTx.GetExtensionOptions()) != 0 {
+		return ctx, sdkerrors.ErrUnknownExtensionOptions
+	}
+
+	return next(ctx, tx, simulate)
+}
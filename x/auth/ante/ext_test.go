@@ -0,0 +1,42 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec/testdata"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestRejectExtensionOptions(t *testing.T) {
+	// setup
+	_, ctx := createTestApp(true)
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewRejectExtensionOptionsDecorator())
+
+	extOpt, err := codectypes.NewAnyWithValue(&testdata.Dog{Name: "Spot"})
+	require.NoError(t, err)
+
+	// txs without extension options are accepted
+	_, err = antehandler(ctx, types.StdTx{}, false)
+	require.NoError(t, err)
+
+	_, err = antehandler(ctx, &txtypes.Tx{Body: &txtypes.TxBody{}}, false)
+	require.NoError(t, err)
+
+	// non-critical extension options are ignored
+	tx := &txtypes.Tx{Body: &txtypes.TxBody{NonCriticalExtensionOptions: []*codectypes.Any{extOpt}}}
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	// critical extension options are rejected
+	tx = &txtypes.Tx{Body: &txtypes.TxBody{ExtensionOptions: []*codectypes.Any{extOpt}}}
+	_, err = antehandler(ctx, tx, false)
+	require.True(t, sdkerrors.ErrUnknownExtensionOptions.Is(err))
+}
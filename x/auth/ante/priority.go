@@ -0,0 +1,83 @@
+package ante
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TxPriorityGasPriceScale is the factor gas prices are multiplied by before
+// being truncated into a priority, so that gas prices below one unit per gas
+// still produce distinct priorities.
+const TxPriorityGasPriceScale = 1000000
+
+// TxPriorityCalculator defines the function signature used to compute the
+// mempool priority of a transaction during CheckTx. Higher values are ordered
+// first by a priority mempool.
+type TxPriorityCalculator func(ctx sdk.Context, tx FeeTx) int64
+
+// DefaultTxPriority computes a transaction's priority from its gas price,
+// i.e. the fee offered per unit of gas, scaled by TxPriorityGasPriceScale.
+// When multiple fee denominations are provided the smallest gas price among
+// them is used. A tx that provides no fee or no gas has a priority of zero.
+func DefaultTxPriority(_ sdk.Context, tx FeeTx) int64 {
+	gas := tx.GetGas()
+	if gas == 0 {
+		return 0
+	}
+
+	var priority int64
+	for i, fee := range tx.GetFee() {
+		var p int64
+
+		gasPrice := fee.Amount.ToDec().Quo(sdk.NewDecFromInt(sdk.NewIntFromUint64(gas)))
+		scaled := gasPrice.MulInt64(TxPriorityGasPriceScale).TruncateInt()
+		if scaled.IsInt64() {
+			p = scaled.Int64()
+		} else {
+			p = math.MaxInt64
+		}
+
+		if i == 0 || p < priority {
+			priority = p
+		}
+	}
+
+	return priority
+}
+
+// TxPriorityDecorator assigns a priority to the transaction being checked
+// using the provided TxPriorityCalculator. The priority is only computed in
+// CheckTx and is returned to Tendermint in the CheckTx response events so
+// that validators running a priority mempool can order transactions by fee
+// rather than by arrival time.
+// CONTRACT: Tx must implement FeeTx interface to use TxPriorityDecorator
+type TxPriorityDecorator struct {
+	calculator TxPriorityCalculator
+}
+
+// NewTxPriorityDecorator returns a new TxPriorityDecorator. If calculator is
+// nil, DefaultTxPriority is used.
+func NewTxPriorityDecorator(calculator TxPriorityCalculator) TxPriorityDecorator {
+	if calculator == nil {
+		calculator = DefaultTxPriority
+	}
+
+	return TxPriorityDecorator{
+		calculator: calculator,
+	}
+}
+
+func (tpd TxPriorityDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if ctx.IsCheckTx() && !simulate {
+		ctx = ctx.WithPriority(tpd.calculator(ctx, feeTx))
+	}
+
+	return next(ctx, tx, simulate)
+}
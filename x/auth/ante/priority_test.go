@@ -0,0 +1,90 @@
+package ante_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestDefaultTxPriority(t *testing.T) {
+	_, ctx := createTestApp(true)
+
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+
+	testCases := []struct {
+		name     string
+		fee      types.StdFee
+		expected int64
+	}{
+		{"no fee", types.NewStdFee(100000, sdk.NewCoins()), 0},
+		{"no gas", types.NewStdFee(0, sdk.NewCoins(sdk.NewInt64Coin("atom", 150))), 0},
+		{"single denom", types.NewStdFee(100, sdk.NewCoins(sdk.NewInt64Coin("atom", 250))), 2500000},
+		{"gas price below one", types.NewStdFee(100000, sdk.NewCoins(sdk.NewInt64Coin("atom", 150))), 1500},
+		{"gas price below one is not truncated", types.NewStdFee(100000, sdk.NewCoins(sdk.NewInt64Coin("atom", 151))), 1510},
+		{
+			"lowest gas price wins",
+			types.NewStdFee(100, sdk.NewCoins(sdk.NewInt64Coin("atom", 500), sdk.NewInt64Coin("stake", 300))),
+			3000000,
+		},
+		{
+			"overflow is capped",
+			types.NewStdFee(1, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(math.MaxInt64).MulRaw(2)))),
+			math.MaxInt64,
+		},
+	}
+
+	for _, tc := range testCases {
+		tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, tc.fee)
+		require.Equal(t, tc.expected, ante.DefaultTxPriority(ctx, tx.(ante.FeeTx)), tc.name)
+	}
+}
+
+func TestTxPriorityDecorator(t *testing.T) {
+	_, ctx := createTestApp(true)
+
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, types.NewTestStdFee())
+
+	var priority int64
+	capture := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		priority = ctx.Priority()
+		return ctx, nil
+	}
+
+	// the default calculator uses the scaled gas price: 150atom / 100000gas
+	tpd := ante.NewTxPriorityDecorator(nil)
+	_, err := tpd.AnteHandle(ctx.WithIsCheckTx(true), tx, false, capture)
+	require.NoError(t, err)
+	require.Equal(t, int64(1500), priority)
+
+	custom := func(_ sdk.Context, tx ante.FeeTx) int64 {
+		return tx.GetFee().AmountOf("atom").Int64()
+	}
+	tpd = ante.NewTxPriorityDecorator(custom)
+
+	_, err = tpd.AnteHandle(ctx.WithIsCheckTx(true), tx, false, capture)
+	require.NoError(t, err)
+	require.Equal(t, int64(150), priority)
+
+	// priority is not assigned outside of CheckTx
+	priority = -1
+	_, err = tpd.AnteHandle(ctx.WithIsCheckTx(false), tx, false, capture)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), priority)
+
+	// nor during simulation
+	priority = -1
+	_, err = tpd.AnteHandle(ctx.WithIsCheckTx(true), tx, true, capture)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), priority)
+}
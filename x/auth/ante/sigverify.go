@@ -3,15 +3,20 @@ package ante
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
+	"sync"
 
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -21,6 +26,7 @@ var (
 	simSecp256k1Sig    [64]byte
 
 	_ SigVerifiableTx = (*types.StdTx)(nil) // assert StdTx implements SigVerifiableTx
+	_ TxWithSignBytes = (*types.StdTx)(nil) // assert StdTx implements TxWithSignBytes
 )
 
 func init() {
@@ -40,9 +46,24 @@ type SigVerifiableTx interface {
 	GetSignatures() [][]byte
 	GetSigners() []sdk.AccAddress
 	GetPubKeys() []crypto.PubKey // If signer already has pubkey in context, this list will have nil in its place
+}
+
+// TxWithSignBytes defines the interface implemented by txs whose signatures are
+// all made over the same sign bytes for a given signer, such as StdTx.
+type TxWithSignBytes interface {
+	SigVerifiableTx
 	GetSignBytes(ctx sdk.Context, acc types.AccountI) []byte
 }
 
+// TxWithSignatureData defines the interface implemented by txs recording the
+// sign mode of each of their signatures, such as the protobuf Tx. Their
+// signatures are verified with the SignModeHandler of the
+// SigVerificationDecorator.
+type TxWithSignatureData interface {
+	SigVerifiableTx
+	GetSignatureData() ([]signing.SignatureData, error)
+}
+
 // SetPubKeyDecorator sets PubKeys in context for any signer which does not already have pubkey set
 // PubKeys must be set in context for all signers before any other sigverify decorators run
 // CONTRACT: Tx must implement SigVerifiableTx interface
@@ -73,20 +94,29 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 			}
 			pk = simSecp256k1Pubkey
 		}
-		// Only make check if simulate=false
-		if !simulate && !bytes.Equal(pk.Address(), signers[i]) {
-			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
-				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
-		}
 
 		acc, err := GetSignerAcc(ctx, spkd.ak, signers[i])
 		if err != nil {
 			return ctx, err
 		}
-		// account already has pubkey set,no need to reset
-		if acc.GetPubKey() != nil {
+		// account already has pubkey set, no need to reset. The pubkey may have
+		// been rotated with MsgChangePubKey, in which case it no longer matches
+		// the account address.
+		if accPubKey := acc.GetPubKey(); accPubKey != nil {
+			// Only make check if simulate=false
+			if !simulate && !accPubKey.Equals(pk) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
+					"pubKey does not match the pubKey of signer %s with signer index: %d", signers[i], i)
+			}
 			continue
 		}
+
+		// Only make check if simulate=false
+		if !simulate && !bytes.Equal(pk.Address(), signers[i]) {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
+				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
+		}
+
 		err = acc.SetPubKey(pk)
 		if err != nil {
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
@@ -153,16 +183,21 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 
 // Verify all signatures for a tx and return an error if any are invalid. Note,
 // the SigVerificationDecorator decorator will not get executed on ReCheck.
+// In CheckTx, the signatures of a multi-signer tx are verified concurrently by
+// the number of goroutines set with the context's SigVerifyWorkers.
 //
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
-// CONTRACT: Tx must implement SigVerifiableTx interface
+// CONTRACT: Tx must implement either the TxWithSignBytes or the
+// TxWithSignatureData interface
 type SigVerificationDecorator struct {
-	ak AccountKeeper
+	ak              AccountKeeper
+	signModeHandler authsigning.SignModeHandler
 }
 
-func NewSigVerificationDecorator(ak AccountKeeper) SigVerificationDecorator {
+func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler authsigning.SignModeHandler) SigVerificationDecorator {
 	return SigVerificationDecorator{
-		ak: ak,
+		ak:              ak,
+		signModeHandler: signModeHandler,
 	}
 }
 
@@ -190,32 +225,138 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signerAddrs), len(sigs))
 	}
 
+	// sigData holds the sign mode of each signature for the txs recording it
+	var sigData []signing.SignatureData
+	if modeTx, ok := tx.(TxWithSignatureData); ok {
+		sigData, err = modeTx.GetSignatureData()
+		if err != nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+	} else if _, ok := tx.(TxWithSignBytes); !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	// the signer accounts and sign data are read from the store sequentially,
+	// only the verification of the signatures may run concurrently
+	verifications := make([]func() error, 0, len(sigs))
 	for i, sig := range sigs {
 		signerAccs[i], err = GetSignerAcc(ctx, svd.ak, signerAddrs[i])
 		if err != nil {
 			return ctx, err
 		}
 
-		// retrieve signBytes of tx
-		signBytes := sigTx.GetSignBytes(ctx, signerAccs[i])
-
 		// retrieve pubkey
 		pubKey := signerAccs[i].GetPubKey()
 		if !simulate && pubKey == nil {
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
 		}
 
-		// verify signature
-		if !simulate && !pubKey.VerifyBytes(signBytes, sig) {
-			return ctx, sdkerrors.Wrapf(
-				sdkerrors.ErrUnauthorized,
-				"signature verification failed; verify correct account sequence (%d) and chain-id (%s)", signerAccs[i].GetSequence(), ctx.ChainID())
+		if simulate {
+			continue
+		}
+
+		if sigData == nil {
+			signBytes, sig := tx.(TxWithSignBytes).GetSignBytes(ctx, signerAccs[i]), sig
+			verifications = append(verifications, func() error {
+				if !pubKey.VerifyBytes(signBytes, sig) {
+					return errors.New("invalid signature")
+				}
+				return nil
+			})
+
+			continue
+		}
+
+		// use accNum 0 at genesis, as StdTx.GetSignBytes does
+		accNum := signerAccs[i].GetAccountNumber()
+		if ctx.BlockHeight() == 0 {
+			accNum = 0
 		}
+
+		signerData := authsigning.SignerData{
+			ChainID:         ctx.ChainID(),
+			AccountNumber:   accNum,
+			AccountSequence: signerAccs[i].GetSequence(),
+		}
+
+		data := sigData[i]
+		verifications = append(verifications, func() error {
+			return authsigning.VerifySignature(pubKey, signerData, data, svd.signModeHandler, tx)
+		})
+	}
+
+	// signatures are only verified concurrently in CheckTx so that the
+	// consensus critical DeliverTx path stays sequential
+	workers := 1
+	if ctx.IsCheckTx() {
+		workers = ctx.SigVerifyWorkers()
+	}
+
+	// verify signatures
+	if i, err := verifySignatures(verifications, workers); err != nil {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"signature verification failed; verify correct account sequence (%d) and chain-id (%s): %s", signerAccs[i].GetSequence(), ctx.ChainID(), err)
 	}
 
 	return next(ctx, tx, simulate)
 }
 
+// verifySignatures runs the given signature verifications and returns the
+// index and error of the first failed one in signer order, or -1 and nil if
+// they all succeed. When workers is greater than one, the verifications are
+// split between that many goroutines, otherwise they run sequentially.
+//
+// NOTE: ed25519 signatures go through the same worker pool, as neither
+// tendermint nor golang.org/x/crypto provide an ed25519 batch verifier in the
+// versions this module depends on.
+func verifySignatures(verifications []func() error, workers int) (int, error) {
+	if workers > len(verifications) {
+		workers = len(verifications)
+	}
+
+	if workers < 2 {
+		for i, verify := range verifications {
+			if err := verify(); err != nil {
+				return i, err
+			}
+		}
+
+		return -1, nil
+	}
+
+	errs := make([]error, len(verifications))
+	indices := make(chan int)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+
+			for i := range indices {
+				errs[i] = verifications[i]()
+			}
+		}()
+	}
+
+	for i := range verifications {
+		indices <- i
+	}
+
+	close(indices)
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return i, err
+		}
+	}
+
+	return -1, nil
+}
+
 // IncrementSequenceDecorator handles incrementing sequences of all signers.
 // Use the IncrementSequenceDecorator decorator to prevent replay attacks. Note,
 // there is no need to execute IncrementSequenceDecorator on RecheckTX since
@@ -299,12 +440,16 @@ func DefaultSigVerificationGasConsumer(
 	switch pubkey := pubkey.(type) {
 	case ed25519.PubKeyEd25519:
 		meter.ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "ED25519 public keys are unsupported")
+		return nil
 
 	case secp256k1.PubKeySecp256k1:
 		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
 		return nil
 
+	case secp256r1.PubKeySecp256r1:
+		meter.ConsumeGas(params.SigVerifyCostSecp256r1, "ante verify: secp256r1")
+		return nil
+
 	case multisig.PubKeyMultisigThreshold:
 		var multisignature multisig.AminoMultisignature
 		legacy.Cdc.MustUnmarshalBinaryBare(sig, &multisignature)
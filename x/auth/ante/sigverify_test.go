@@ -11,9 +11,12 @@ import (
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -88,8 +91,9 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 		gasConsumed uint64
 		shouldErr   bool
 	}{
-		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostED25519, true},
+		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostED25519, false},
 		{"PubKeySecp256k1", args{sdk.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostSecp256k1, false},
+		{"PubKeySecp256r1", args{sdk.NewInfiniteGasMeter(), nil, secp256r1.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostSecp256r1, false},
 		{"Multisig", args{sdk.NewInfiniteGasMeter(), aminoMultisignature1, multisigKey1, params}, expectedCost1, false},
 		{"unknown key", args{sdk.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
@@ -133,7 +137,7 @@ func TestSigVerification(t *testing.T) {
 	fee := types.NewTestStdFee()
 
 	spkd := ante.NewSetPubKeyDecorator(app.AccountKeeper)
-	svd := ante.NewSigVerificationDecorator(app.AccountKeeper)
+	svd := ante.NewSigVerificationDecorator(app.AccountKeeper, authtx.DefaultSignModeHandler())
 	antehandler := sdk.ChainAnteDecorators(spkd, svd)
 
 	type testCase struct {
@@ -153,20 +157,106 @@ func TestSigVerification(t *testing.T) {
 		{"valid tx", []crypto.PrivKey{priv1, priv2, priv3}, []uint64{0, 1, 2}, []uint64{0, 0, 0}, false, false},
 		{"no err on recheck", []crypto.PrivKey{}, []uint64{}, []uint64{}, true, false},
 	}
-	for i, tc := range testCases {
-		ctx = ctx.WithIsReCheckTx(tc.recheck)
+	// run every case with both sequential and concurrent verification
+	for _, workers := range []int{0, 4} {
+		ctx := ctx.WithSigVerifyWorkers(workers)
+
+		for i, tc := range testCases {
+			ctx = ctx.WithIsReCheckTx(tc.recheck)
 
-		tx := types.NewTestTx(ctx, msgs, tc.privs, tc.accNums, tc.seqs, fee)
+			tx := types.NewTestTx(ctx, msgs, tc.privs, tc.accNums, tc.seqs, fee)
 
-		_, err := antehandler(ctx, tx, false)
-		if tc.shouldErr {
-			require.NotNil(t, err, "TestCase %d: %s did not error as expected", i, tc.name)
-		} else {
-			require.Nil(t, err, "TestCase %d: %s errored unexpectedly. Err: %v", i, tc.name, err)
+			_, err := antehandler(ctx, tx, false)
+			if tc.shouldErr {
+				require.NotNil(t, err, "TestCase %d: %s did not error as expected (workers %d)", i, tc.name, workers)
+			} else {
+				require.Nil(t, err, "TestCase %d: %s errored unexpectedly (workers %d). Err: %v", i, tc.name, workers, err)
+			}
 		}
 	}
 }
 
+func TestSigVerificationConcurrent(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(1)
+
+	// a tx with one signature per account, each account at a distinct sequence
+	privs := make([]crypto.PrivKey, 8)
+	msgs := make([]sdk.Msg, len(privs))
+	accNums := make([]uint64, len(privs))
+	seqs := make([]uint64, len(privs))
+	for i := range privs {
+		priv, _, addr := types.KeyTestPubAddr()
+		acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+		require.NoError(t, acc.SetPubKey(priv.PubKey()))
+		require.NoError(t, acc.SetSequence(uint64(10+i)))
+		app.AccountKeeper.SetAccount(ctx, acc)
+
+		privs[i], msgs[i], accNums[i], seqs[i] = priv, types.NewTestMsg(addr), acc.GetAccountNumber(), acc.GetSequence()
+	}
+
+	fee := types.NewTestStdFee()
+	svd := ante.NewSigVerificationDecorator(app.AccountKeeper, authtx.DefaultSignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(svd)
+
+	validTx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+
+	// the signatures of signers 3 and 6 are made for a wrong sequence
+	badSeqs := append([]uint64{}, seqs...)
+	badSeqs[3], badSeqs[6] = 0, 0
+	invalidTx := types.NewTestTx(ctx, msgs, privs, accNums, badSeqs, fee)
+
+	for _, workers := range []int{0, 1, 3, 8, 16} {
+		for _, checkTx := range []bool{true, false} {
+			ctx := ctx.WithSigVerifyWorkers(workers).WithIsCheckTx(checkTx)
+
+			_, err := antehandler(ctx, validTx, false)
+			require.NoError(t, err, "workers %d, checkTx %t", workers, checkTx)
+
+			// the first invalid signature in signer order is reported
+			_, err = antehandler(ctx, invalidTx, false)
+			require.True(t, sdkerrors.ErrUnauthorized.Is(err), "workers %d, checkTx %t", workers, checkTx)
+			require.Contains(t, err.Error(), fmt.Sprintf("account sequence (%d)", seqs[3]), "workers %d, checkTx %t", workers, checkTx)
+		}
+	}
+}
+
+func BenchmarkSigVerification(b *testing.B) {
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(1)
+
+	privs := make([]crypto.PrivKey, 8)
+	msgs := make([]sdk.Msg, len(privs))
+	accNums := make([]uint64, len(privs))
+	seqs := make([]uint64, len(privs))
+	for i := range privs {
+		priv, _, addr := types.KeyTestPubAddr()
+		acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+		if err := acc.SetPubKey(priv.PubKey()); err != nil {
+			b.Fatal(err)
+		}
+		app.AccountKeeper.SetAccount(ctx, acc)
+
+		privs[i], msgs[i], accNums[i] = priv, types.NewTestMsg(addr), acc.GetAccountNumber()
+	}
+
+	tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, types.NewTestStdFee())
+	antehandler := sdk.ChainAnteDecorators(ante.NewSigVerificationDecorator(app.AccountKeeper, authtx.DefaultSignModeHandler()))
+
+	for _, workers := range []int{1, 4} {
+		ctx := ctx.WithSigVerifyWorkers(workers)
+
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := antehandler(ctx, tx, false); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
 func TestSigIntegration(t *testing.T) {
 	// generate private keys
 	privs := []crypto.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
@@ -210,7 +300,7 @@ func runSigDecorators(t *testing.T, params types.Params, _ bool, privs ...crypto
 
 	spkd := ante.NewSetPubKeyDecorator(app.AccountKeeper)
 	svgc := ante.NewSigGasConsumeDecorator(app.AccountKeeper, ante.DefaultSigVerificationGasConsumer)
-	svd := ante.NewSigVerificationDecorator(app.AccountKeeper)
+	svd := ante.NewSigVerificationDecorator(app.AccountKeeper, authtx.DefaultSignModeHandler())
 	antehandler := sdk.ChainAnteDecorators(spkd, svgc, svd)
 
 	// Determine gas consumption of antehandler with default params
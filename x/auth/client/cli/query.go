@@ -6,7 +6,6 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
-	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -19,9 +18,8 @@ import (
 )
 
 const (
-	flagEvents = "events"
-
-	eventFormat = "{eventType}.{eventAttribute}={value}"
+	flagEvents  = "events"
+	flagOrderBy = "order-by"
 )
 
 // GetQueryCmd returns the transaction commands for this module
@@ -108,49 +106,31 @@ func QueryTxsByEventsCmd(cdc *codec.Codec) *cobra.Command {
 		Short: "Query for paginated transactions that match a set of events",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`
-Search for transactions that match the exact given events where results are paginated.
-Each event takes the form of '%s'. Please refer
+Search for transactions that match all of the given events where results are paginated.
+Each event takes the form of '%s' and events are separated by '&'. Please refer
 to each module's documentation for the full set of events to query for. Each module
 documents its respective events under 'xx_events.md'.
 
+Results are ordered by height, in ascending order unless '--%s desc' is given.
+
 Example:
 $ %s query txs --%s 'message.sender=cosmos1...&message.action=withdraw_delegator_reward' --page 1 --limit 30
-`, eventFormat, version.ClientName, flagEvents),
+`, authclient.EventFormat, flagOrderBy, version.ClientName, flagEvents),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			eventsStr := strings.Trim(viper.GetString(flagEvents), "'")
 
-			var events []string
-			if strings.Contains(eventsStr, "&") {
-				events = strings.Split(eventsStr, "&")
-			} else {
-				events = append(events, eventsStr)
-			}
-
-			var tmEvents []string
-
-			for _, event := range events {
-				if !strings.Contains(event, "=") {
-					return fmt.Errorf("invalid event; event %s should be of the format: %s", event, eventFormat)
-				} else if strings.Count(event, "=") > 1 {
-					return fmt.Errorf("invalid event; event %s should be of the format: %s", event, eventFormat)
-				}
-
-				tokens := strings.Split(event, "=")
-				if tokens[0] == tmtypes.TxHeightKey {
-					event = fmt.Sprintf("%s=%s", tokens[0], tokens[1])
-				} else {
-					event = fmt.Sprintf("%s='%s'", tokens[0], tokens[1])
-				}
-
-				tmEvents = append(tmEvents, event)
+			tmEvents, err := authclient.ParseEvents(strings.Split(eventsStr, "&"))
+			if err != nil {
+				return err
 			}
 
 			page := viper.GetInt(flags.FlagPage)
 			limit := viper.GetInt(flags.FlagLimit)
+			orderBy := viper.GetString(flagOrderBy)
 
 			clientCtx := client.NewContext().WithCodec(cdc)
-			txs, err := authclient.QueryTxsByEvents(clientCtx, tmEvents, page, limit, "")
+			txs, err := authclient.QueryTxsByEvents(clientCtx, tmEvents, page, limit, orderBy)
 			if err != nil {
 				return err
 			}
@@ -180,7 +160,8 @@ $ %s query txs --%s 'message.sender=cosmos1...&message.action=withdraw_delegator
 	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
 	viper.BindPFlag(flags.FlagKeyringBackend, cmd.Flags().Lookup(flags.FlagKeyringBackend))
 
-	cmd.Flags().String(flagEvents, "", fmt.Sprintf("list of transaction events in the form of %s", eventFormat))
+	cmd.Flags().String(flagEvents, "", fmt.Sprintf("list of transaction events in the form of %s", authclient.EventFormat))
+	cmd.Flags().String(flagOrderBy, authclient.OrderByAsc, fmt.Sprintf("Order of the results by height (%s|%s)", authclient.OrderByAsc, authclient.OrderByDesc))
 	cmd.Flags().Uint32(flags.FlagPage, rest.DefaultPage, "Query a specific page of paginated results")
 	cmd.Flags().Uint32(flags.FlagLimit, rest.DefaultLimit, "Query number of transactions results per page returned")
 	cmd.MarkFlagRequired(flagEvents)
@@ -4,12 +4,15 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/codec"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // GetTxCmd returns the transaction commands for this module
-func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+func GetTxCmd(clientCtx client.Context) *cobra.Command {
+	cdc := clientCtx.Codec
+
 	txCmd := &cobra.Command{
 		Use:                        types.ModuleName,
 		Short:                      "Auth transaction subcommands",
@@ -22,6 +25,14 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetSignCommand(cdc),
 		GetValidateSignaturesCommand(cdc),
 		GetSignBatchCommand(cdc),
+		NewChangePubKeyTxCmd(clientCtx),
 	)
 	return txCmd
 }
+
+// DefaultSignModeHandler returns the SignModeHandler used by the CLI to produce
+// and verify signatures. It is the one the ante handler verifies signatures
+// with, see authtx.DefaultSignModeHandler.
+func DefaultSignModeHandler() authsigning.SignModeHandler {
+	return authtx.DefaultSignModeHandler()
+}
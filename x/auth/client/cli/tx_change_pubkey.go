@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// NewChangePubKeyTxCmd returns a CLI command handler for creating a
+// MsgChangePubKey transaction.
+func NewChangePubKeyTxCmd(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-pubkey [pubkey]",
+		Short: "Replace the public key of the --from account",
+		Long: `Replace the public key of the --from account with the provided Bech32 account
+public key, keeping the account's address, funds and sequence. The transaction
+is signed with the current key, and every following transaction of the account
+must be signed with the new key.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx = clientCtx.InitWithInput(cmd.InOrStdin())
+
+			pubKey, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeAccPub, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChangePubKey(clientCtx.GetFromAddress(), pubKey)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
@@ -18,6 +18,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -92,6 +93,13 @@ func makeMultiSignCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string)
 			txBldr = txBldr.WithAccountNumber(accnum).WithSequence(seq)
 		}
 
+		signerData := authsigning.SignerData{
+			ChainID:         txBldr.ChainID(),
+			AccountNumber:   txBldr.AccountNumber(),
+			AccountSequence: txBldr.Sequence(),
+		}
+		signModeHandler := DefaultSignModeHandler()
+
 		// read each signature and add it to the multisig if valid
 		for i := 2; i < len(args); i++ {
 			stdSig, err := readAndUnmarshalStdSignature(cdc, args[i])
@@ -99,18 +107,14 @@ func makeMultiSignCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string)
 				return err
 			}
 
-			// Validate each signature
-			sigBytes := types.StdSignBytes(
-				txBldr.ChainID(), txBldr.AccountNumber(), txBldr.Sequence(),
-				stdTx.Fee, stdTx.GetMsgs(), stdTx.GetMemo(),
-			)
-			if ok := stdSig.GetPubKey().VerifyBytes(sigBytes, stdSig.Signature); !ok {
-				return fmt.Errorf("couldn't verify signature")
-			}
-
 			sigV2, err := types.StdSignatureToSignatureV2(cdc, stdSig)
 			if err != nil {
-				return nil
+				return err
+			}
+
+			// Validate each signature
+			if err := authsigning.VerifySignature(sigV2.PubKey, signerData, sigV2.Data, signModeHandler, stdTx); err != nil {
+				return fmt.Errorf("couldn't verify signature %s: %w", args[i], err)
 			}
 
 			if err := multisig.AddSignatureV2(multisigSig, sigV2, multisigPub.PubKeys); err != nil {
@@ -168,6 +168,9 @@ the transaction to fail.
 The --multisig=<multisig_key> flag generates a signature on behalf of a multisig account
 key. It implies --signature-only. Full multisig signed transactions may eventually
 be generated via the 'multisign' command.
+
+The --sign-mode flag selects the signing mode. StdTx signatures are always
+verified against the amino JSON sign bytes, so only amino-json is accepted.
 `,
 		PreRun: preSignCmd,
 		RunE:   makeSignCmd(codec),
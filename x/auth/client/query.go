@@ -3,10 +3,12 @@ package client
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -14,17 +16,65 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
+// Orderings supported by QueryTxsByEvents. An empty ordering defaults to
+// OrderByAsc.
+const (
+	OrderByAsc  = "asc"
+	OrderByDesc = "desc"
+)
+
+// EventFormat is the format of the events accepted by ParseEvents
+const EventFormat = "{eventType}.{eventAttribute}={value}"
+
+// ParseEvents converts events of the form EventFormat into the conditions
+// expected by QueryTxsByEvents, i.e. "{eventType}.{eventAttribute}='{value}'".
+// Values may already be quoted. The tx.height event is compared numerically
+// and is left unquoted.
+func ParseEvents(events []string) ([]string, error) {
+	if len(events) == 0 {
+		return nil, errors.New("must declare at least one event to search")
+	}
+
+	conditions := make([]string, len(events))
+	for i, event := range events {
+		tokens := strings.SplitN(event, "=", 2)
+		if len(tokens) != 2 {
+			return nil, fmt.Errorf("invalid event; event %s should be of the format: %s", event, EventFormat)
+		}
+
+		key := strings.TrimSpace(tokens[0])
+		value := strings.Trim(strings.TrimSpace(tokens[1]), "'")
+
+		if key == "" || value == "" || !strings.Contains(key, ".") {
+			return nil, fmt.Errorf("invalid event; event %s should be of the format: %s", event, EventFormat)
+		}
+
+		if key == tmtypes.TxHeightKey {
+			conditions[i] = fmt.Sprintf("%s=%s", key, value)
+		} else {
+			conditions[i] = fmt.Sprintf("%s='%s'", key, value)
+		}
+	}
+
+	return conditions, nil
+}
+
 // QueryTxsByEvents performs a search for transactions for a given set of events
 // via the Tendermint RPC. An event takes the form of:
 // "{eventAttribute}.{attributeKey} = '{attributeValue}'". Each event is
-// concatenated with an 'AND' operand. It returns a slice of Info object
-// containing txs and metadata. An error is returned if the query fails.
-// If an empty string is provided it will order txs by asc
+// concatenated with an 'AND' operand, so only txs matching all the events are
+// returned. It returns a slice of Info object containing txs and metadata. An
+// error is returned if the query fails. orderBy is either OrderByAsc or
+// OrderByDesc; if an empty string is provided it will order txs by asc
 func QueryTxsByEvents(clientCtx client.Context, events []string, page, limit int, orderBy string) (*sdk.SearchTxsResult, error) {
 	if len(events) == 0 {
 		return nil, errors.New("must declare at least one event to search")
 	}
 
+	if orderBy != "" && orderBy != OrderByAsc && orderBy != OrderByDesc {
+		return nil, fmt.Errorf("invalid order %s; must be one of: %s, %s", orderBy, OrderByAsc, OrderByDesc)
+	}
+
 	if page <= 0 {
 		return nil, errors.New("page must greater than 0")
 	}
@@ -33,7 +83,6 @@ func QueryTxsByEvents(clientCtx client.Context, events []string, page, limit int
 		return nil, errors.New("limit must greater than 0")
 	}
 
-	// XXX: implement ANY
 	query := strings.Join(events, " AND ")
 
 	node, err := clientCtx.GetNode()
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+func TestParseEvents(t *testing.T) {
+	tests := []struct {
+		events    []string
+		expected  []string
+		expectErr bool
+	}{
+		{nil, nil, true},
+		{[]string{"message.action=send"}, []string{"message.action='send'"}, false},
+		{
+			[]string{"message.sender=cosmos1foo", "message.action='send'"},
+			[]string{"message.sender='cosmos1foo'", "message.action='send'"},
+			false,
+		},
+		{[]string{"tx.height=5"}, []string{"tx.height=5"}, false},
+		{[]string{"transfer.memo=a=b"}, []string{"transfer.memo='a=b'"}, false},
+		{[]string{"message.action"}, nil, true},
+		{[]string{"action=send"}, nil, true},
+		{[]string{"message.action="}, nil, true},
+		{[]string{"message.action=send", ""}, nil, true},
+	}
+
+	for i, tt := range tests {
+		conditions, err := ParseEvents(tt.events)
+		require.Equal(t, tt.expectErr, err != nil, i)
+		require.Equal(t, tt.expected, conditions, i)
+	}
+}
+
+func TestQueryTxsByEventsInvalidOrder(t *testing.T) {
+	_, err := QueryTxsByEvents(client.Context{}, []string{"message.action='send'"}, 1, 10, "random")
+	require.Error(t, err)
+}
@@ -0,0 +1,192 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// txServer implements the tx Service on top of the node's RPC client
+type txServer struct {
+	clientCtx client.Context
+}
+
+var _ txtypes.ServiceServer = txServer{}
+
+// RegisterTxService registers the tx Service with the given gRPC server. The
+// client.Context is expected to point to the node's local RPC client.
+//
+// NOTE: the Service must only be registered with the node's gRPC server and
+// never with the app's GRPCQueryRouter, which is served by ABCI Query: calling
+// BroadcastTx while the ABCI connection is locked would deadlock the node.
+func RegisterTxService(server gogogrpc.Server, clientCtx client.Context) {
+	txtypes.RegisterServiceServer(server, txServer{clientCtx: clientCtx})
+}
+
+// BroadcastTx implements the Service.BroadcastTx RPC method.
+func (s txServer) BroadcastTx(_ context.Context, req *txtypes.BroadcastTxRequest) (*txtypes.BroadcastTxResponse, error) {
+	if req == nil || len(req.TxBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty tx bytes")
+	}
+
+	var mode string
+	switch req.Mode {
+	case txtypes.BroadcastMode_BROADCAST_MODE_UNSPECIFIED, txtypes.BroadcastMode_BROADCAST_MODE_SYNC:
+		mode = flags.BroadcastSync
+	case txtypes.BroadcastMode_BROADCAST_MODE_ASYNC:
+		mode = flags.BroadcastAsync
+	case txtypes.BroadcastMode_BROADCAST_MODE_BLOCK:
+		mode = flags.BroadcastBlock
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported broadcast mode %s", req.Mode)
+	}
+
+	res, err := s.clientCtx.WithBroadcastMode(mode).BroadcastTx(req.TxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txtypes.BroadcastTxResponse{
+		TxResponse: toProtoTxResponse(res, req.TxBytes),
+	}, nil
+}
+
+// GetTx implements the Service.GetTx RPC method.
+func (s txServer) GetTx(_ context.Context, req *txtypes.GetTxRequest) (*txtypes.GetTxResponse, error) {
+	if req == nil || req.Hash == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty tx hash")
+	}
+
+	if _, err := hex.DecodeString(req.Hash); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx hash %s", req.Hash)
+	}
+
+	res, err := QueryTx(s.clientCtx, req.Hash)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, status.Errorf(codes.NotFound, "tx %s not found", req.Hash)
+		}
+
+		return nil, err
+	}
+
+	txResponse, err := encodeTxResponse(s.clientCtx.Codec, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txtypes.GetTxResponse{TxResponse: txResponse}, nil
+}
+
+// GetTxsEvent implements the Service.GetTxsEvent RPC method. Only offset
+// based pagination is supported since the Tendermint tx search is page based.
+func (s txServer) GetTxsEvent(_ context.Context, req *txtypes.GetTxsEventRequest) (*txtypes.GetTxsEventResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	events, err := ParseEvents(req.Events)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	page, limit, err := pageFromPageRequest(req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var orderBy string
+	switch req.OrderBy {
+	case txtypes.OrderBy_ORDER_BY_UNSPECIFIED, txtypes.OrderBy_ORDER_BY_ASC:
+		orderBy = OrderByAsc
+	case txtypes.OrderBy_ORDER_BY_DESC:
+		orderBy = OrderByDesc
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported order %s", req.OrderBy)
+	}
+
+	res, err := QueryTxsByEvents(s.clientCtx, events, page, limit, orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	txResponses := make([]*txtypes.TxResponse, len(res.Txs))
+	for i, tx := range res.Txs {
+		txResponses[i], err = encodeTxResponse(s.clientCtx.Codec, tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &txtypes.GetTxsEventResponse{
+		TxResponses: txResponses,
+		Pagination:  &query.PageResponse{Total: uint64(res.TotalCount)},
+	}, nil
+}
+
+// pageFromPageRequest converts an offset based PageRequest into the 1-indexed
+// page and limit used by the Tendermint tx search.
+func pageFromPageRequest(pageReq *query.PageRequest) (page, limit int, err error) {
+	if pageReq == nil {
+		return 1, query.DefaultLimit, nil
+	}
+
+	if len(pageReq.Key) != 0 {
+		return 0, 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "key based pagination is not supported")
+	}
+
+	limit = int(pageReq.Limit)
+	if limit <= 0 {
+		limit = query.DefaultLimit
+	}
+
+	if pageReq.Offset%uint64(limit) != 0 {
+		return 0, 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "offset %d must be a multiple of limit %d", pageReq.Offset, limit)
+	}
+
+	return int(pageReq.Offset/uint64(limit)) + 1, limit, nil
+}
+
+// encodeTxResponse converts a queried TxResponse, whose transaction has been
+// decoded, into its proto representation.
+func encodeTxResponse(cdc *codec.Codec, res sdk.TxResponse) (*txtypes.TxResponse, error) {
+	var txBytes []byte
+	if res.Tx != nil {
+		var err error
+
+		txBytes, err = cdc.MarshalBinaryBare(res.Tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return toProtoTxResponse(res, txBytes), nil
+}
+
+func toProtoTxResponse(res sdk.TxResponse, txBytes []byte) *txtypes.TxResponse {
+	return &txtypes.TxResponse{
+		Height:    res.Height,
+		TxHash:    res.TxHash,
+		Codespace: res.Codespace,
+		Code:      res.Code,
+		Data:      res.Data,
+		RawLog:    res.RawLog,
+		Info:      res.Info,
+		GasWanted: res.GasWanted,
+		GasUsed:   res.GasUsed,
+		Tx:        txBytes,
+		Timestamp: res.Timestamp,
+	}
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestPageFromPageRequest(t *testing.T) {
+	tests := []struct {
+		pageReq   *query.PageRequest
+		page      int
+		limit     int
+		expectErr bool
+	}{
+		{nil, 1, query.DefaultLimit, false},
+		{&query.PageRequest{}, 1, query.DefaultLimit, false},
+		{&query.PageRequest{Limit: 10}, 1, 10, false},
+		{&query.PageRequest{Offset: 20, Limit: 10}, 3, 10, false},
+		{&query.PageRequest{Offset: 15, Limit: 10}, 0, 0, true},
+		{&query.PageRequest{Key: []byte("foo")}, 0, 0, true},
+	}
+
+	for i, tt := range tests {
+		page, limit, err := pageFromPageRequest(tt.pageReq)
+		require.Equal(t, tt.expectErr, err != nil, i)
+		require.Equal(t, tt.page, page, i)
+		require.Equal(t, tt.limit, limit, i)
+	}
+}
+
+func TestEncodeTxResponse(t *testing.T) {
+	cdc := makeCodec()
+
+	stdTx := authtypes.NewStdTx([]sdk.Msg{sdk.NewTestMsg(addr)}, authtypes.StdFee{}, nil, "memo")
+	txBytes, err := cdc.MarshalBinaryBare(stdTx)
+	require.NoError(t, err)
+
+	res := sdk.TxResponse{Height: 10, TxHash: "ABCD", GasUsed: 5, Tx: stdTx, Timestamp: "2020-01-01T00:00:00Z"}
+	txResponse, err := encodeTxResponse(cdc, res)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), txResponse.Height)
+	require.Equal(t, "ABCD", txResponse.TxHash)
+	require.Equal(t, int64(5), txResponse.GasUsed)
+	require.Equal(t, res.Timestamp, txResponse.Timestamp)
+	require.Equal(t, txBytes, txResponse.Tx)
+
+	// a broadcast response carries no decoded tx
+	txResponse, err = encodeTxResponse(cdc, sdk.TxResponse{TxHash: "ABCD"})
+	require.NoError(t, err)
+	require.Nil(t, txResponse.Tx)
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -79,6 +80,10 @@ func CompleteAndBroadcastTxCLI(txBldr authtypes.TxBuilder, clientCtx client.Cont
 		return nil
 	}
 
+	if _, err := ParseSignMode(clientCtx.SignModeStr); err != nil {
+		return err
+	}
+
 	if !clientCtx.SkipConfirm {
 		stdSignMsg, err := txBldr.BuildSignMsg(msgs)
 		if err != nil {
@@ -185,6 +190,10 @@ func SignStdTx(
 
 	var signedStdTx authtypes.StdTx
 
+	if _, err := ParseSignMode(clientCtx.SignModeStr); err != nil {
+		return signedStdTx, err
+	}
+
 	info, err := txBldr.Keybase().Key(name)
 	if err != nil {
 		return signedStdTx, err
@@ -215,6 +224,10 @@ func SignStdTxWithSignerAddress(
 	addr sdk.AccAddress, name string, stdTx authtypes.StdTx, offline bool,
 ) (signedStdTx authtypes.StdTx, err error) {
 
+	if _, err := ParseSignMode(clientCtx.SignModeStr); err != nil {
+		return signedStdTx, err
+	}
+
 	// check whether the address is a signer
 	if !isTxSigner(addr, stdTx.GetSigners()) {
 		return signedStdTx, fmt.Errorf("%s: %s", sdkerrors.ErrorInvalidSigner, name)
@@ -308,6 +321,20 @@ func GetTxEncoder(cdc *codec.Codec) (encoder sdk.TxEncoder) {
 	return encoder
 }
 
+// ParseSignMode returns the SignMode selected by the value of the --sign-mode
+// flag. An empty value selects SIGN_MODE_LEGACY_AMINO_JSON, the only mode
+// StdTx signatures can be made with: they don't record the mode they were
+// made with and are always verified against the amino JSON sign bytes.
+func ParseSignMode(signModeStr string) (signing.SignMode, error) {
+	switch signModeStr {
+	case "", flags.SignModeLegacyAminoJSON:
+		return signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, nil
+
+	default:
+		return signing.SignMode_SIGN_MODE_UNSPECIFIED, fmt.Errorf("unsupported sign mode %q, use %q", signModeStr, flags.SignModeLegacyAminoJSON)
+	}
+}
+
 // simulateMsgs simulates the transaction and returns the simulation response and
 // the adjusted gas value.
 func simulateMsgs(txBldr authtypes.TxBuilder, clientCtx client.Context, msgs []sdk.Msg) (sdk.SimulationResponse, uint64, error) {
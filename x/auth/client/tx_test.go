@@ -11,9 +11,12 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -184,6 +187,37 @@ malformed
 	}
 }
 
+func TestParseSignMode(t *testing.T) {
+	tests := []struct {
+		signModeStr string
+		expected    signing.SignMode
+		expectErr   bool
+	}{
+		{"", signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, false},
+		{flags.SignModeLegacyAminoJSON, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, false},
+		{"textual", signing.SignMode_SIGN_MODE_UNSPECIFIED, true},
+		{"direct", signing.SignMode_SIGN_MODE_UNSPECIFIED, true},
+	}
+
+	for _, tt := range tests {
+		signMode, err := ParseSignMode(tt.signModeStr)
+		require.Equal(t, tt.expectErr, err != nil, tt.signModeStr)
+		require.Equal(t, tt.expected, signMode, tt.signModeStr)
+	}
+}
+
+func TestSignStdTxSignMode(t *testing.T) {
+	stdTx := authtypes.NewStdTx([]sdk.Msg{sdk.NewTestMsg(addr)}, authtypes.StdFee{}, nil, "")
+	clientCtx := client.Context{}.WithSignModeStr("textual")
+
+	// StdTx signatures can only be made in amino JSON mode
+	_, err := SignStdTx(authtypes.TxBuilder{}, clientCtx, "foo", stdTx, false, true)
+	require.Error(t, err)
+
+	_, err = SignStdTxWithSignerAddress(authtypes.TxBuilder{}, clientCtx, addr, "foo", stdTx, true)
+	require.Error(t, err)
+}
+
 func compareEncoders(t *testing.T, expected sdk.TxEncoder, actual sdk.TxEncoder) {
 	msgs := []sdk.Msg{sdk.NewTestMsg(addr)}
 	tx := authtypes.NewStdTx(msgs, authtypes.StdFee{}, []authtypes.StdSignature{}, "")
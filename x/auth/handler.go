@@ -0,0 +1,26 @@
+package auth
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// NewHandler returns a handler for "auth" type messages.
+func NewHandler(ak AccountKeeper) sdk.Handler {
+	msgServer := keeper.NewMsgServerImpl(ak)
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *types.MsgChangePubKey:
+			res, err := msgServer.ChangePubKey(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized auth message type: %T", msg)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Migrator performs the in-place store migrations of the auth module.
+type Migrator struct {
+	keeper AccountKeeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper AccountKeeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the auth state from consensus version 1 to 2. It sets
+// the SigVerifyCostSecp256r1 and PubKeyChangeCost params, introduced in
+// version 2, to their default values, as the ante handler reads all the params
+// and panics on missing ones.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if !m.keeper.paramSubspace.Has(ctx, types.KeySigVerifyCostSecp256r1) {
+		m.keeper.paramSubspace.Set(ctx, types.KeySigVerifyCostSecp256r1, types.DefaultSigVerifyCostSecp256r1)
+	}
+
+	if !m.keeper.paramSubspace.Has(ctx, types.KeyPubKeyChangeCost) {
+		m.keeper.paramSubspace.Set(ctx, types.KeyPubKeyChangeCost, types.DefaultPubKeyChangeCost)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// drop the params, as in the state of a chain at consensus version 1
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	store.Delete(types.KeySigVerifyCostSecp256r1)
+	store.Delete(types.KeyPubKeyChangeCost)
+	require.Panics(t, func() { app.AccountKeeper.GetParams(ctx) })
+
+	require.NoError(t, keeper.NewMigrator(app.AccountKeeper).Migrate1to2(ctx))
+	require.Equal(t, types.DefaultSigVerifyCostSecp256r1, app.AccountKeeper.GetParams(ctx).SigVerifyCostSecp256r1)
+	require.Equal(t, types.DefaultPubKeyChangeCost, app.AccountKeeper.GetParams(ctx).PubKeyChangeCost)
+
+	// a param that is already set is left untouched
+	params := types.DefaultParams()
+	params.SigVerifyCostSecp256r1 = 2000
+	params.PubKeyChangeCost = 1000
+	app.AccountKeeper.SetParams(ctx, params)
+
+	require.NoError(t, keeper.NewMigrator(app.AccountKeeper).Migrate1to2(ctx))
+	require.Equal(t, params, app.AccountKeeper.GetParams(ctx))
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type msgServer struct {
+	AccountKeeper
+}
+
+// NewMsgServerImpl returns an implementation of the auth MsgServer interface
+// for the provided AccountKeeper.
+func NewMsgServerImpl(keeper AccountKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// ChangePubKey implements the Msg/ChangePubKey method. The account keeps its
+// address, account number and sequence. The sequence is deliberately not
+// reset, so that transactions signed by a key the account held before cannot
+// be replayed if the account rotates back to that key.
+func (k msgServer) ChangePubKey(goCtx context.Context, msg *types.MsgChangePubKey) (*types.MsgChangePubKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	acc := k.GetAccount(ctx, msg.Address)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", msg.Address)
+	}
+	if _, ok := acc.(types.ModuleAccountI); ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot change the public key of module account %s", msg.Address)
+	}
+	// keyless accounts, e.g. the accounts of group policies, must never get a
+	// key that would let someone sign on their behalf
+	if acc.GetPubKey() == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "account %s has no public key to change", msg.Address)
+	}
+	rotatable, ok := acc.(types.PubKeyRotatableAccountI)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot change the public key of account %s", msg.Address)
+	}
+
+	pubKey, err := msg.GetPubKey()
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+
+	// rotating a key is charged on top of the regular tx costs to discourage
+	// using it as a cheap way to bloat the account store
+	ctx.GasMeter().ConsumeGas(k.GetParams(ctx).PubKeyChangeCost, "change pubkey")
+
+	if err := rotatable.RotatePubKey(pubKey); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+	k.SetAccount(ctx, rotatable)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeChangePubKey,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyPubKey, sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pubKey)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgChangePubKeyResponse{}, nil
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestMsgChangePubKey(t *testing.T) {
+	app, ctx := createTestApp(false)
+	msgServer := keeper.NewMsgServerImpl(app.AccountKeeper)
+
+	oldPubKey := secp256k1.GenPrivKey().PubKey()
+	newPubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(oldPubKey.Address())
+
+	// unknown account
+	_, err := msgServer.ChangePubKey(sdk.WrapSDKContext(ctx), types.NewMsgChangePubKey(addr, newPubKey))
+	require.True(t, sdkerrors.ErrUnknownAddress.Is(err))
+
+	// module accounts have no key to rotate
+	macc := app.AccountKeeper.GetModuleAccount(ctx, types.FeeCollectorName)
+	_, err = msgServer.ChangePubKey(sdk.WrapSDKContext(ctx), types.NewMsgChangePubKey(macc.GetAddress(), newPubKey))
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+
+	// keyless accounts cannot get a key
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	app.AccountKeeper.SetAccount(ctx, acc)
+	_, err = msgServer.ChangePubKey(sdk.WrapSDKContext(ctx), types.NewMsgChangePubKey(addr, newPubKey))
+	require.True(t, sdkerrors.ErrInvalidPubKey.Is(err))
+	require.Nil(t, app.AccountKeeper.GetAccount(ctx, addr).GetPubKey())
+
+	require.NoError(t, acc.SetPubKey(oldPubKey))
+	require.NoError(t, acc.SetSequence(5))
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.ChangePubKey(sdk.WrapSDKContext(ctx), types.NewMsgChangePubKey(addr, newPubKey))
+	require.NoError(t, err)
+
+	// the key is replaced, while the address, account number and sequence are kept
+	updated := app.AccountKeeper.GetAccount(ctx, addr)
+	require.Equal(t, newPubKey, updated.GetPubKey())
+	require.Equal(t, acc.GetAccountNumber(), updated.GetAccountNumber())
+	require.Equal(t, uint64(5), updated.GetSequence())
+
+	// the rotated key no longer matches the address, which is still valid
+	require.True(t, updated.(*types.BaseAccount).PubKeyRotated)
+	require.NoError(t, updated.(types.GenesisAccount).Validate())
+
+	require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), types.DefaultPubKeyChangeCost)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventTypeChangePubKey, events[0].Type)
+	require.Equal(t, addr.String(), string(events[0].Attributes[0].Value))
+	require.Equal(t, sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, newPubKey), string(events[0].Attributes[1].Value))
+}
@@ -19,6 +19,7 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -28,6 +29,8 @@ var (
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
 	_ module.InterfaceModule     = AppModuleBasic{}
+	_ module.MsgServiceAppModule = AppModule{}
+	_ module.VersionedAppModule  = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the auth module.
@@ -66,7 +69,7 @@ func (AppModuleBasic) RegisterRESTRoutes(clientCtx client.Context, rtr *mux.Rout
 
 // GetTxCmd returns the root tx command for the auth module.
 func (AppModuleBasic) GetTxCmd(clientCtx client.Context) *cobra.Command {
-	return cli.GetTxCmd(clientCtx.Codec)
+	return cli.GetTxCmd(clientCtx)
 }
 
 // GetQueryCmd returns the root query command for the auth module.
@@ -105,7 +108,9 @@ func (AppModule) Name() string {
 func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 
 // Route returns the message routing key for the auth module.
-func (AppModule) Route() sdk.Route { return sdk.Route{} }
+func (am AppModule) Route() sdk.Route {
+	return sdk.NewRoute(authtypes.RouterKey, NewHandler(am.accountKeeper))
+}
 
 // QuerierRoute returns the auth module's querier route name.
 func (AppModule) QuerierRoute() string {
@@ -119,6 +124,23 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 
 func (am AppModule) RegisterQueryService(grpc.Server) {}
 
+// RegisterMsgService registers the auth Msg service.
+func (am AppModule) RegisterMsgService(server grpc.Server) {
+	authtypes.RegisterMsgServer(server, keeper.NewMsgServerImpl(am.accountKeeper))
+}
+
+// ConsensusVersion implements module.VersionedAppModule. Version 2 introduced
+// the SigVerifyCostSecp256r1 param.
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+// RegisterMigrations registers the auth module's in-place store migrations.
+func (am AppModule) RegisterMigrations(cfg module.Configurator) {
+	m := keeper.NewMigrator(am.accountKeeper)
+	if err := cfg.RegisterMigration(authtypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
+}
+
 // InitGenesis performs genesis initialization for the auth module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, data json.RawMessage) []abci.ValidatorUpdate {
@@ -41,7 +41,12 @@ func (LegacyAminoJSONHandler) GetSignBytes(mode signingtypes.SignMode, data sign
 		return nil, fmt.Errorf("expected TxWithMemo, got %T", tx)
 	}
 
+	var timeoutHeight uint64
+	if timeoutTx, ok := tx.(ante.TxWithTimeoutHeight); ok {
+		timeoutHeight = timeoutTx.GetTimeoutHeight()
+	}
+
 	return authtypes.StdSignBytes(
-		data.ChainID, data.AccountNumber, data.AccountSequence, authtypes.StdFee{Amount: feeTx.GetFee(), Gas: feeTx.GetGas()}, tx.GetMsgs(), memoTx.GetMemo(), // nolint:staticcheck // SA1019: authtypes.StdFee is deprecated, will be removed once proto migration is completed
+		data.ChainID, data.AccountNumber, data.AccountSequence, timeoutHeight, authtypes.StdFee{Amount: feeTx.GetFee(), Gas: feeTx.GetGas()}, tx.GetMsgs(), memoTx.GetMemo(), // nolint:staticcheck // SA1019: authtypes.StdFee is deprecated, will be removed once proto migration is completed
 	), nil
 }
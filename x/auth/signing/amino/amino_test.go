@@ -58,7 +58,7 @@ func TestLegacyAminoJSONHandler_GetSignBytes(t *testing.T) {
 	signBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signingData, tx)
 	require.NoError(t, err)
 
-	expectedSignBz := auth.StdSignBytes(chainId, accNum, seqNum, fee, msgs, memo)
+	expectedSignBz := auth.StdSignBytes(chainId, accNum, seqNum, 0, fee, msgs, memo)
 
 	require.Equal(t, expectedSignBz, signBz)
 
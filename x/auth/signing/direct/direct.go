@@ -0,0 +1,48 @@
+package direct
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// DirectModeHandler is a SignModeHandler that handles SIGN_MODE_DIRECT. The
+// sign bytes are the protobuf encoded SignDoc of a protobuf Tx.
+type DirectModeHandler struct{}
+
+var _ signing.SignModeHandler = DirectModeHandler{}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (DirectModeHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_DIRECT
+}
+
+// Modes implements SignModeHandler.Modes
+func (DirectModeHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_DIRECT}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes
+func (DirectModeHandler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_DIRECT {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_DIRECT, mode)
+	}
+
+	protoTx, ok := tx.(*txtypes.Tx)
+	if !ok {
+		return nil, fmt.Errorf("expected %T, got %T", (*txtypes.Tx)(nil), tx)
+	}
+
+	signDoc := txtypes.SignDoc{
+		Body:            protoTx.Body,
+		AuthInfo:        protoTx.AuthInfo,
+		ChainId:         data.ChainID,
+		AccountNumber:   data.AccountNumber,
+		AccountSequence: data.AccountSequence,
+	}
+
+	return signDoc.Marshal()
+}
@@ -0,0 +1,67 @@
+package direct_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/direct"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestDirectModeHandler_GetSignBytes(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg, err := codectypes.NewAnyWithValue(banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))))
+	require.NoError(t, err)
+
+	tx := &txtypes.Tx{
+		Body:     &txtypes.TxBody{Messages: []*codectypes.Any{msg}, Memo: "foo"},
+		AuthInfo: &txtypes.AuthInfo{Fee: &txtypes.Fee{GasLimit: 10000}},
+	}
+
+	var (
+		chainId        = "test-chain"
+		accNum  uint64 = 7
+		seqNum  uint64 = 7
+	)
+
+	handler := direct.DirectModeHandler{}
+	signingData := signing.SignerData{
+		ChainID:         chainId,
+		AccountNumber:   accNum,
+		AccountSequence: seqNum,
+	}
+	signBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signingData, tx)
+	require.NoError(t, err)
+
+	expectedSignBz, err := (&txtypes.SignDoc{
+		Body:            tx.Body,
+		AuthInfo:        tx.AuthInfo,
+		ChainId:         chainId,
+		AccountNumber:   accNum,
+		AccountSequence: seqNum,
+	}).Marshal()
+	require.NoError(t, err)
+	require.Equal(t, expectedSignBz, signBz)
+
+	// the signatures are not part of the sign bytes
+	tx.Signatures = [][]byte{[]byte("sig")}
+	sigBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signingData, tx)
+	require.NoError(t, err)
+	require.Equal(t, signBz, sigBz)
+
+	// expect error with wrong sign mode
+	_, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signingData, tx)
+	require.Error(t, err)
+
+	// expect error with a tx other than the protobuf Tx
+	_, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signingData, auth.StdTx{})
+	require.Error(t, err)
+}
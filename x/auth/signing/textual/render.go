@@ -0,0 +1,210 @@
+package textual
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// indent is prepended once per nesting level to rendered lines
+const indent = "> "
+
+// FormatCoins renders coins as a comma separated list of "<amount> <denom>"
+// entries sorted by denom, e.g. "10 atom, 5 stake". Empty coins render as
+// "zero".
+func FormatCoins(coins sdk.Coins) string {
+	if coins.Empty() {
+		return "zero"
+	}
+
+	coins = append(sdk.Coins{}, coins...).Sort()
+	parts := make([]string, len(coins))
+	for i, coin := range coins {
+		parts[i] = fmt.Sprintf("%s %s", coin.Amount, coin.Denom)
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// FormatDuration renders a duration as days, hours, minutes and seconds,
+// omitting zero components, e.g. "1 day, 2 hours, 30.5 seconds". A zero
+// duration renders as "0 seconds".
+func FormatDuration(d time.Duration) string {
+	if d == 0 {
+		return "0 seconds"
+	}
+
+	var sign string
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	hours := d / time.Hour
+	d -= hours * time.Hour
+	minutes := d / time.Minute
+	d -= minutes * time.Minute
+
+	var parts []string
+	if days > 0 {
+		parts = append(parts, pluralize(fmt.Sprintf("%d", days), "day"))
+	}
+	if hours > 0 {
+		parts = append(parts, pluralize(fmt.Sprintf("%d", hours), "hour"))
+	}
+	if minutes > 0 {
+		parts = append(parts, pluralize(fmt.Sprintf("%d", minutes), "minute"))
+	}
+	if d > 0 {
+		seconds := fmt.Sprintf("%d.%09d", d/time.Second, d%time.Second)
+		seconds = strings.TrimRight(strings.TrimRight(seconds, "0"), ".")
+		parts = append(parts, pluralize(seconds, "second"))
+	}
+
+	return sign + strings.Join(parts, ", ")
+}
+
+// FormatAddress renders an account address in its bech32 form using the
+// configured account prefix.
+func FormatAddress(addr sdk.AccAddress) string {
+	return addr.String()
+}
+
+// formatString returns s unchanged when it only contains printable
+// characters and its quoted, escaped form otherwise, so that values such as
+// a memo can't inject additional lines into the rendered output.
+func formatString(s string) string {
+	for _, r := range s {
+		if !unicode.IsPrint(r) {
+			return strconv.Quote(s)
+		}
+	}
+	return s
+}
+
+func pluralize(amount, unit string) string {
+	if amount == "1" {
+		return amount + " " + unit
+	}
+	return amount + " " + unit + "s"
+}
+
+// formatKey turns a JSON field name such as "from_address" into the label
+// "From address".
+func formatKey(key string) string {
+	key = strings.ReplaceAll(key, "_", " ")
+	if key == "" {
+		return key
+	}
+	return strings.ToUpper(key[:1]) + key[1:]
+}
+
+// renderJSON renders the canonical JSON sign bytes of a message as a list of
+// "Label: value" lines. Objects are rendered with their keys sorted and
+// nested values indented, and lists of coins are rendered with FormatCoins.
+func renderJSON(bz []byte) ([]string, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	// unwrap the amino {"type": ..., "value": ...} envelope since the message
+	// type is already part of the message header line
+	if obj, ok := v.(map[string]interface{}); ok && len(obj) == 2 {
+		if _, ok := obj["type"].(string); ok {
+			if value, ok := obj["value"]; ok {
+				v = value
+			}
+		}
+	}
+
+	return renderValue(nil, "", v, 0), nil
+}
+
+func renderValue(lines []string, label string, v interface{}, depth int) []string {
+	prefix := strings.Repeat(indent, depth)
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if label != "" {
+			lines = append(lines, prefix+label+":")
+			depth++
+		}
+
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			lines = renderValue(lines, formatKey(k), v[k], depth)
+		}
+
+	case []interface{}:
+		if coins, ok := parseCoins(v); ok {
+			return append(lines, fmt.Sprintf("%s%s: %s", prefix, label, FormatCoins(coins)))
+		}
+
+		lines = append(lines, fmt.Sprintf("%s%s (%d):", prefix, label, len(v)))
+		for i, item := range v {
+			lines = renderValue(lines, fmt.Sprintf("%d", i+1), item, depth+1)
+		}
+
+	case nil:
+		lines = append(lines, fmt.Sprintf("%s%s: <empty>", prefix, label))
+
+	case string:
+		lines = append(lines, fmt.Sprintf("%s%s: %s", prefix, label, formatString(v)))
+
+	default:
+		lines = append(lines, fmt.Sprintf("%s%s: %v", prefix, label, v))
+	}
+
+	return lines
+}
+
+// parseCoins returns the coins represented by a JSON list of
+// {"amount": ..., "denom": ...} objects.
+func parseCoins(list []interface{}) (sdk.Coins, bool) {
+	if len(list) == 0 {
+		return nil, false
+	}
+
+	coins := make(sdk.Coins, len(list))
+	for i, item := range list {
+		obj, ok := item.(map[string]interface{})
+		if !ok || len(obj) != 2 {
+			return nil, false
+		}
+
+		denom, ok := obj["denom"].(string)
+		if !ok {
+			return nil, false
+		}
+		amountStr, ok := obj["amount"].(string)
+		if !ok {
+			return nil, false
+		}
+		amount, ok := sdk.NewIntFromString(amountStr)
+		if !ok {
+			return nil, false
+		}
+
+		coins[i] = sdk.Coin{Denom: denom, Amount: amount}
+	}
+
+	return coins, true
+}
@@ -0,0 +1,108 @@
+package textual
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// TextualHandler is a SignModeHandler that handles SIGN_MODE_TEXTUAL. The
+// sign bytes it produces are a deterministic, human-readable rendering of the
+// transaction so that it can be displayed and verified on devices such as
+// hardware wallets before signing.
+type TextualHandler struct{}
+
+var _ signing.SignModeHandler = TextualHandler{}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (TextualHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_TEXTUAL
+}
+
+// Modes implements SignModeHandler.Modes
+func (TextualHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes
+func (h TextualHandler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_TEXTUAL {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_TEXTUAL, mode)
+	}
+
+	text, err := h.Render(data, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(text), nil
+}
+
+// Render returns the textual representation of tx for the provided signer.
+// Each line is a "Label: value" pair; nested values are prefixed with "> "
+// once per level. The last line holds the hash of the tx's amino JSON sign
+// bytes, which makes the rendering unique to the transaction.
+func (TextualHandler) Render(data signing.SignerData, tx sdk.Tx) (string, error) {
+	feeTx, ok := tx.(ante.FeeTx)
+	if !ok {
+		return "", fmt.Errorf("expected FeeTx, got %T", tx)
+	}
+
+	memoTx, ok := tx.(ante.TxWithMemo)
+	if !ok {
+		return "", fmt.Errorf("expected TxWithMemo, got %T", tx)
+	}
+
+	lines := []string{
+		fmt.Sprintf("Chain id: %s", data.ChainID),
+		fmt.Sprintf("Account number: %d", data.AccountNumber),
+		fmt.Sprintf("Sequence: %d", data.AccountSequence),
+	}
+
+	msgs := tx.GetMsgs()
+	for i, msg := range msgs {
+		lines = append(lines, fmt.Sprintf("Message (%d/%d): %s/%s", i+1, len(msgs), msg.Route(), msg.Type()))
+
+		msgLines, err := renderJSON(msg.GetSignBytes())
+		if err != nil {
+			return "", fmt.Errorf("failed to render message %d: %w", i+1, err)
+		}
+
+		for _, l := range msgLines {
+			lines = append(lines, indent+l)
+		}
+	}
+
+	lines = append(lines,
+		fmt.Sprintf("Fee: %s", FormatCoins(feeTx.GetFee())),
+		fmt.Sprintf("Gas limit: %d", feeTx.GetGas()),
+	)
+
+	if memo := memoTx.GetMemo(); memo != "" {
+		lines = append(lines, fmt.Sprintf("Memo: %s", formatString(memo)))
+	}
+
+	var timeoutHeight uint64
+	if timeoutTx, ok := tx.(ante.TxWithTimeoutHeight); ok {
+		timeoutHeight = timeoutTx.GetTimeoutHeight()
+	}
+
+	if timeoutHeight > 0 {
+		lines = append(lines, fmt.Sprintf("Timeout height: %d", timeoutHeight))
+	}
+
+	// The rendering above is lossy, so bind the signature to the exact
+	// transaction by including the hash of its canonical sign bytes.
+	signBytes := authtypes.StdSignBytes(
+		data.ChainID, data.AccountNumber, data.AccountSequence, timeoutHeight, authtypes.StdFee{Amount: feeTx.GetFee(), Gas: feeTx.GetGas()}, msgs, memoTx.GetMemo(), // nolint:staticcheck // SA1019: authtypes.StdFee is deprecated, will be removed once proto migration is completed
+	)
+	lines = append(lines, fmt.Sprintf("Sign bytes hash: %X", sha256.Sum256(signBytes)))
+
+	return strings.Join(lines, "\n"), nil
+}
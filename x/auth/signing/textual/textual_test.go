@@ -0,0 +1,129 @@
+package textual_test
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/textual"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestTextualHandler_GetSignBytes(t *testing.T) {
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	coins := sdk.Coins{sdk.NewInt64Coin("foocoin", 10)}
+	fee := auth.StdFee{
+		Amount: sdk.Coins{sdk.NewInt64Coin("atom", 150), sdk.NewInt64Coin("stake", 1)},
+		Gas:    10000,
+	}
+	msgs := []sdk.Msg{
+		&banktypes.MsgSend{
+			FromAddress: addr1,
+			ToAddress:   addr2,
+			Amount:      coins,
+		},
+	}
+	tx := auth.StdTx{
+		Msgs: msgs,
+		Fee:  fee,
+		Memo: "foo",
+	}
+
+	handler := textual.TextualHandler{}
+	signerData := signing.SignerData{
+		ChainID:         "test-chain",
+		AccountNumber:   7,
+		AccountSequence: 3,
+	}
+
+	signBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, tx)
+	require.NoError(t, err)
+
+	expectedHash := sha256.Sum256(auth.StdSignBytes("test-chain", 7, 3, 0, fee, msgs, "foo"))
+	expected := strings.Join([]string{
+		"Chain id: test-chain",
+		"Account number: 7",
+		"Sequence: 3",
+		"Message (1/1): bank/send",
+		"> Amount: 10 foocoin",
+		"> From address: " + addr1.String(),
+		"> To address: " + addr2.String(),
+		"Fee: 150 atom, 1 stake",
+		"Gas limit: 10000",
+		"Memo: foo",
+		fmt.Sprintf("Sign bytes hash: %X", expectedHash),
+	}, "\n")
+	require.Equal(t, expected, string(signBz))
+
+	// rendering is deterministic
+	signBz2, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, tx)
+	require.NoError(t, err)
+	require.Equal(t, signBz, signBz2)
+
+	// a different signer produces different sign bytes
+	signerData.AccountSequence = 4
+	signBz3, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, tx)
+	require.NoError(t, err)
+	require.NotEqual(t, signBz, signBz3)
+
+	// control characters in the memo are escaped
+	tx.Memo = "foo\nFee: zero"
+	signBz, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, tx)
+	require.NoError(t, err)
+	require.Contains(t, string(signBz), `Memo: "foo\nFee: zero"`)
+
+	// expect error with wrong sign mode
+	_, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, tx)
+	require.Error(t, err)
+}
+
+func TestTextualHandler_Modes(t *testing.T) {
+	handler := textual.TextualHandler{}
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_TEXTUAL, handler.DefaultMode())
+	require.Equal(t, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}, handler.Modes())
+}
+
+func TestFormatCoins(t *testing.T) {
+	require.Equal(t, "zero", textual.FormatCoins(nil))
+	require.Equal(t, "1 atom", textual.FormatCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 1))))
+
+	unsorted := sdk.Coins{sdk.NewInt64Coin("stake", 5), sdk.NewInt64Coin("atom", 10)}
+	require.Equal(t, "10 atom, 5 stake", textual.FormatCoins(unsorted))
+	require.Equal(t, "stake", unsorted[0].Denom, "input coins must not be modified")
+}
+
+func TestFormatDuration(t *testing.T) {
+	testCases := []struct {
+		duration time.Duration
+		expected string
+	}{
+		{0, "0 seconds"},
+		{time.Second, "1 second"},
+		{1500 * time.Millisecond, "1.5 seconds"},
+		{time.Nanosecond, "0.000000001 seconds"},
+		{2 * time.Minute, "2 minutes"},
+		{24*time.Hour + 2*time.Hour + 30*time.Second, "1 day, 2 hours, 30 seconds"},
+		{21 * 24 * time.Hour, "21 days"},
+		{-time.Hour - time.Minute, "-1 hour, 1 minute"},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, textual.FormatDuration(tc.duration), tc.duration.String())
+	}
+}
+
+func TestFormatAddress(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	require.Equal(t, addr.String(), textual.FormatAddress(addr))
+}
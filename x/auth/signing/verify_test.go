@@ -0,0 +1,72 @@
+package signing_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestVerifySignature(t *testing.T) {
+	priv1 := secp256k1.GenPrivKey()
+	priv2 := secp256k1.GenPrivKey()
+	priv3 := secp256k1.GenPrivKey()
+	addr1 := sdk.AccAddress(priv1.PubKey().Address())
+
+	coins := sdk.Coins{sdk.NewInt64Coin("foocoin", 10)}
+	tx := authtypes.StdTx{
+		Msgs: []sdk.Msg{
+			&banktypes.MsgSend{FromAddress: addr1, ToAddress: addr1, Amount: coins},
+		},
+		Fee:  authtypes.StdFee{Amount: coins, Gas: 10000},
+		Memo: "foo",
+	}
+
+	handler := MakeTestHandlerMap()
+	signerData := signing.SignerData{ChainID: "test-chain", AccountNumber: 7, AccountSequence: 7}
+	mode := signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+
+	signBytes, err := handler.GetSignBytes(mode, signerData, tx)
+	require.NoError(t, err)
+
+	sign := func(priv crypto.PrivKey) *signingtypes.SingleSignatureData {
+		sig, err := priv.Sign(signBytes)
+		require.NoError(t, err)
+		return &signingtypes.SingleSignatureData{SignMode: mode, Signature: sig}
+	}
+
+	// single signature
+	require.NoError(t, signing.VerifySignature(priv1.PubKey(), signerData, sign(priv1), handler, tx))
+	require.Error(t, signing.VerifySignature(priv2.PubKey(), signerData, sign(priv1), handler, tx))
+
+	wrongData := signerData
+	wrongData.AccountSequence = 8
+	require.Error(t, signing.VerifySignature(priv1.PubKey(), wrongData, sign(priv1), handler, tx))
+
+	// unsupported sign mode
+	unsupported := sign(priv1)
+	unsupported.SignMode = signingtypes.SignMode_SIGN_MODE_TEXTUAL
+	require.Error(t, signing.VerifySignature(priv1.PubKey(), signerData, unsupported, handler, tx))
+
+	// 2 of 3 multisig
+	pubKeys := []crypto.PubKey{priv1.PubKey(), priv2.PubKey(), priv3.PubKey()}
+	multisigKey := multisig.NewPubKeyMultisigThreshold(2, pubKeys)
+	multiSig := multisig.NewMultisig(len(pubKeys))
+
+	require.NoError(t, multisig.AddSignatureFromPubKey(multiSig, sign(priv1), pubKeys[0], pubKeys))
+	require.Error(t, signing.VerifySignature(multisigKey, signerData, multiSig, handler, tx))
+
+	require.NoError(t, multisig.AddSignatureFromPubKey(multiSig, sign(priv3), pubKeys[2], pubKeys))
+	require.NoError(t, signing.VerifySignature(multisigKey, signerData, multiSig, handler, tx))
+
+	// a multisig can't be verified against a single key
+	require.Error(t, signing.VerifySignature(priv1.PubKey(), signerData, multiSig, handler, tx))
+}
@@ -0,0 +1,26 @@
+package tx
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// DefaultTxDecoder returns a TxDecoder accepting both amino encoded StdTx's
+// and protobuf encoded Tx's. The amino decoding is tried first, so that the
+// transactions accepted so far keep being decoded as StdTx's.
+func DefaultTxDecoder(cdc *codec.Codec, registry codectypes.InterfaceRegistry) sdk.TxDecoder {
+	aminoDecoder := authtypes.DefaultTxDecoder(cdc)
+	protoDecoder := txtypes.DefaultTxDecoder(registry)
+
+	return func(txBytes []byte) (sdk.Tx, error) {
+		tx, err := aminoDecoder(txBytes)
+		if err == nil {
+			return tx, nil
+		}
+
+		return protoDecoder(txBytes)
+	}
+}
@@ -0,0 +1,24 @@
+package tx
+
+import (
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/amino"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/direct"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing/textual"
+)
+
+// DefaultSignModeHandler returns the SignModeHandler used by the ante handler
+// to verify the signatures of protobuf transactions, and by the CLI to produce
+// them. It supports SIGN_MODE_LEGACY_AMINO_JSON, which is the default,
+// SIGN_MODE_DIRECT and SIGN_MODE_TEXTUAL.
+func DefaultSignModeHandler() signing.SignModeHandler {
+	return signing.NewSignModeHandlerMap(
+		signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		[]signing.SignModeHandler{
+			amino.LegacyAminoJSONHandler{},
+			direct.DirectModeHandler{},
+			textual.TextualHandler{},
+		},
+	)
+}
@@ -103,10 +103,30 @@ func (acc *BaseAccount) SetSequence(seq uint64) error {
 	return nil
 }
 
-// Validate checks for errors on the account fields
+// RotatePubKey replaces the pubkey of the account with one that may not match
+// its address, as done by MsgChangePubKey.
+func (acc *BaseAccount) RotatePubKey(pubKey crypto.PubKey) error {
+	if err := acc.SetPubKey(pubKey); err != nil {
+		return err
+	}
+
+	acc.PubKeyRotated = true
+	return nil
+}
+
+// Validate checks for errors on the account fields. The pubkey of an account
+// must match its address, unless it has been rotated with MsgChangePubKey.
 func (acc BaseAccount) Validate() error {
-	if len(acc.PubKey) != 0 && acc.Address != nil &&
-		!bytes.Equal(acc.GetPubKey().Address().Bytes(), acc.Address.Bytes()) {
+	if len(acc.PubKey) == 0 {
+		return nil
+	}
+
+	var pk crypto.PubKey
+	if err := amino.UnmarshalBinaryBare(acc.PubKey, &pk); err != nil {
+		return fmt.Errorf("invalid account pubkey: %w", err)
+	}
+
+	if !acc.PubKeyRotated && acc.Address != nil && !bytes.Equal(pk.Address().Bytes(), acc.Address.Bytes()) {
 		return errors.New("account address and pubkey address do not match")
 	}
 
@@ -123,6 +143,7 @@ type baseAccountPretty struct {
 	PubKey        string         `json:"public_key" yaml:"public_key"`
 	AccountNumber uint64         `json:"account_number" yaml:"account_number"`
 	Sequence      uint64         `json:"sequence" yaml:"sequence"`
+	PubKeyRotated bool           `json:"pub_key_rotated,omitempty" yaml:"pub_key_rotated,omitempty"`
 }
 
 // MarshalYAML returns the YAML representation of an account.
@@ -131,6 +152,7 @@ func (acc BaseAccount) MarshalYAML() (interface{}, error) {
 		Address:       acc.Address,
 		AccountNumber: acc.AccountNumber,
 		Sequence:      acc.Sequence,
+		PubKeyRotated: acc.PubKeyRotated,
 	}
 
 	if acc.PubKey != nil {
@@ -318,6 +340,14 @@ type ModuleAccountI interface {
 	HasPermission(string) bool
 }
 
+// PubKeyRotatableAccountI defines an account whose pubkey can be rotated with
+// MsgChangePubKey to one that does not match its address.
+type PubKeyRotatableAccountI interface {
+	AccountI
+
+	RotatePubKey(crypto.PubKey) error
+}
+
 // GenesisAccounts defines a slice of GenesisAccount objects
 type GenesisAccounts []GenesisAccount
 
@@ -100,6 +100,16 @@ func TestGenesisAccountValidate(t *testing.T) {
 			types.NewBaseAccount(addr, secp256k1.GenPrivKey().PubKey(), 0, 0),
 			true,
 		},
+		{
+			"base account with rotated pubkey",
+			&types.BaseAccount{Address: addr, PubKey: secp256k1.GenPrivKey().PubKey().Bytes(), PubKeyRotated: true},
+			false,
+		},
+		{
+			"invalid pubkey",
+			&types.BaseAccount{Address: addr, PubKey: []byte("invalid")},
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -69,9 +69,14 @@ func (s *StdTxBuilder) SetMemo(memo string) {
 	s.Memo = memo
 }
 
+// SetTimeoutHeight implements TxBuilder.SetTimeoutHeight
+func (s *StdTxBuilder) SetTimeoutHeight(height uint64) {
+	s.TimeoutHeight = height
+}
+
 // CanonicalSignBytes implements TxBuilder.CanonicalSignBytes
 func (s StdTxBuilder) CanonicalSignBytes(cid string, num, seq uint64) ([]byte, error) {
-	return StdSignBytes(cid, num, seq, s.Fee, s.Msgs, s.Memo), nil
+	return StdSignBytes(cid, num, seq, s.TimeoutHeight, s.Fee, s.Msgs, s.Memo), nil
 }
 
 // StdTxGenerator is a context.TxGenerator for StdTx
@@ -4,6 +4,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // RegisterCodec registers the account interfaces and concrete types on the
@@ -15,6 +16,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(&BaseAccount{}, "cosmos-sdk/BaseAccount", nil)
 	cdc.RegisterConcrete(&ModuleAccount{}, "cosmos-sdk/ModuleAccount", nil)
 	cdc.RegisterConcrete(StdTx{}, "cosmos-sdk/StdTx", nil)
+	cdc.RegisterConcrete(&MsgChangePubKey{}, "cosmos-sdk/MsgChangePubKey", nil)
 }
 
 // RegisterInterface associates protoName with AccountI interface
@@ -26,6 +28,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&BaseAccount{},
 		&ModuleAccount{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgChangePubKey{},
+	)
 }
 
 // RegisterKeyTypeCodec registers an external concrete type defined in
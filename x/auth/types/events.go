@@ -0,0 +1,10 @@
+package types
+
+// auth module events
+const (
+	EventTypeChangePubKey = "change_pubkey"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyAddress    = "address"
+	AttributeKeyPubKey     = "pub_key"
+)
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// auth message types
+const (
+	TypeMsgChangePubKey = "change_pubkey"
+)
+
+var _ sdk.Msg = &MsgChangePubKey{}
+
+// NewMsgChangePubKey creates a new MsgChangePubKey replacing the public key of
+// the account at address with pubKey.
+func NewMsgChangePubKey(address sdk.AccAddress, pubKey crypto.PubKey) *MsgChangePubKey {
+	return &MsgChangePubKey{
+		Address: address,
+		PubKey:  pubKey.Bytes(),
+	}
+}
+
+// GetPubKey decodes the new public key of the account.
+func (msg MsgChangePubKey) GetPubKey() (pk crypto.PubKey, err error) {
+	err = amino.UnmarshalBinaryBare(msg.PubKey, &pk)
+	return pk, err
+}
+
+// Route returns the MsgChangePubKey's route.
+func (msg MsgChangePubKey) Route() string { return RouterKey }
+
+// Type returns the MsgChangePubKey's type.
+func (msg MsgChangePubKey) Type() string { return TypeMsgChangePubKey }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a
+// MsgChangePubKey.
+func (msg MsgChangePubKey) ValidateBasic() error {
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing address")
+	}
+	if len(msg.PubKey) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "missing public key")
+	}
+	if _, err := msg.GetPubKey(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when
+// submitting a MsgChangePubKey message.
+func (msg MsgChangePubKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the account whose public key is replaced, which must sign
+// with its current key.
+func (msg MsgChangePubKey) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Address}
+}
@@ -26,15 +26,19 @@ func TestParams_Validate(t *testing.T) {
 	}{
 		{"default params", types.DefaultParams(), nil},
 		{"invalid tx signature limit", types.NewParams(types.DefaultMaxMemoCharacters, 0, types.DefaultTxSizeCostPerByte,
-			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid tx signature limit: 0")},
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultSigVerifyCostSecp256r1, types.DefaultPubKeyChangeCost), fmt.Errorf("invalid tx signature limit: 0")},
 		{"invalid ED25519 signature verification cost", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
-			0, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid ED25519 signature verification cost: 0")},
+			0, types.DefaultSigVerifyCostSecp256k1, types.DefaultSigVerifyCostSecp256r1, types.DefaultPubKeyChangeCost), fmt.Errorf("invalid ED25519 signature verification cost: 0")},
 		{"invalid SECK256k1 signature verification cost", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
-			types.DefaultSigVerifyCostED25519, 0), fmt.Errorf("invalid SECK256k1 signature verification cost: 0")},
+			types.DefaultSigVerifyCostED25519, 0, types.DefaultSigVerifyCostSecp256r1, types.DefaultPubKeyChangeCost), fmt.Errorf("invalid SECK256k1 signature verification cost: 0")},
+		{"invalid SECP256r1 signature verification cost", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, 0, types.DefaultPubKeyChangeCost), fmt.Errorf("invalid SECP256r1 signature verification cost: 0")},
+		{"invalid public key change cost", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultSigVerifyCostSecp256r1, 0), fmt.Errorf("invalid public key change cost: 0")},
 		{"invalid max memo characters", types.NewParams(0, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
-			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid max memo characters: 0")},
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultSigVerifyCostSecp256r1, types.DefaultPubKeyChangeCost), fmt.Errorf("invalid max memo characters: 0")},
 		{"invalid tx size cost per byte", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 0,
-			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid tx size cost per byte: 0")},
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultSigVerifyCostSecp256r1, types.DefaultPubKeyChangeCost), fmt.Errorf("invalid tx size cost per byte: 0")},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -15,11 +15,12 @@ type StdSignMsg struct {
 	Fee           StdFee    `json:"fee" yaml:"fee"`
 	Msgs          []sdk.Msg `json:"msgs" yaml:"msgs"`
 	Memo          string    `json:"memo" yaml:"memo"`
+	TimeoutHeight uint64    `json:"timeout_height" yaml:"timeout_height"`
 }
 
 // get message bytes
 func (msg StdSignMsg) Bytes() []byte {
-	return StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Fee, msg.Msgs, msg.Memo)
+	return StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.TimeoutHeight, msg.Fee, msg.Msgs, msg.Memo)
 }
 
 var _ types.UnpackInterfacesMessage = StdSignMsg{}
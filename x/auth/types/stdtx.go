@@ -148,10 +148,11 @@ var _ sdk.Tx = (*StdTx)(nil)
 // StdTx is a standard way to wrap a Msg with Fee and Signatures.
 // NOTE: the first signature is the fee payer (Signatures must not be nil).
 type StdTx struct {
-	Msgs       []sdk.Msg      `json:"msg" yaml:"msg"`
-	Fee        StdFee         `json:"fee" yaml:"fee"`
-	Signatures []StdSignature `json:"signatures" yaml:"signatures"`
-	Memo       string         `json:"memo" yaml:"memo"`
+	Msgs          []sdk.Msg      `json:"msg" yaml:"msg"`
+	Fee           StdFee         `json:"fee" yaml:"fee"`
+	Signatures    []StdSignature `json:"signatures" yaml:"signatures"`
+	Memo          string         `json:"memo" yaml:"memo"`
+	TimeoutHeight uint64         `json:"timeout_height" yaml:"timeout_height"`
 }
 
 func NewStdTx(msgs []sdk.Msg, fee StdFee, sigs []StdSignature, memo string) StdTx {
@@ -220,6 +221,9 @@ func (tx StdTx) GetSigners() []sdk.AccAddress {
 // GetMemo returns the memo
 func (tx StdTx) GetMemo() string { return tx.Memo }
 
+// GetTimeoutHeight returns the transaction's timeout height (if set).
+func (tx StdTx) GetTimeoutHeight() uint64 { return tx.TimeoutHeight }
+
 // GetSignatures returns the signature of signers who signed the Msg.
 // CONTRACT: Length returned is same as length of
 // pubkeys returned from MsgKeySigners, and the order
@@ -257,7 +261,7 @@ func (tx StdTx) GetSignBytes(ctx sdk.Context, acc AccountI) []byte {
 	}
 
 	return StdSignBytes(
-		chainID, accNum, acc.GetSequence(), tx.Fee, tx.Msgs, tx.Memo,
+		chainID, accNum, acc.GetSequence(), tx.TimeoutHeight, tx.Fee, tx.Msgs, tx.Memo,
 	)
 }
 
@@ -289,10 +293,12 @@ type StdSignDoc struct {
 	Memo          string            `json:"memo" yaml:"memo"`
 	Msgs          []json.RawMessage `json:"msgs" yaml:"msgs"`
 	Sequence      uint64            `json:"sequence" yaml:"sequence"`
+	TimeoutHeight uint64            `json:"timeout_height,omitempty" yaml:"timeout_height"`
 }
 
-// StdSignBytes returns the bytes to sign for a transaction.
-func StdSignBytes(chainID string, accnum uint64, sequence uint64, fee StdFee, msgs []sdk.Msg, memo string) []byte {
+// StdSignBytes returns the bytes to sign for a transaction. A zero timeout
+// height is omitted from the sign bytes.
+func StdSignBytes(chainID string, accnum, sequence, timeout uint64, fee StdFee, msgs []sdk.Msg, memo string) []byte {
 	msgsBytes := make([]json.RawMessage, 0, len(msgs))
 	for _, msg := range msgs {
 		msgsBytes = append(msgsBytes, json.RawMessage(msg.GetSignBytes()))
@@ -305,6 +311,7 @@ func StdSignBytes(chainID string, accnum uint64, sequence uint64, fee StdFee, ms
 		Memo:          memo,
 		Msgs:          msgsBytes,
 		Sequence:      sequence,
+		TimeoutHeight: timeout,
 	})
 
 	if err != nil {
@@ -4,11 +4,16 @@
 package types
 
 import (
+	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/regen-network/cosmos-proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -33,6 +38,9 @@ type BaseAccount struct {
 	PubKey        []byte                                        `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"public_key,omitempty" yaml:"public_key"`
 	AccountNumber uint64                                        `protobuf:"varint,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty" yaml:"account_number"`
 	Sequence      uint64                                        `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// pub_key_rotated is set once the pub_key has been rotated with
+	// MsgChangePubKey, after which it no longer has to match the address
+	PubKeyRotated bool `protobuf:"varint,5,opt,name=pub_key_rotated,json=pubKeyRotated,proto3" json:"pub_key_rotated,omitempty" yaml:"pub_key_rotated"`
 }
 
 func (m *BaseAccount) Reset()      { *m = BaseAccount{} }
@@ -113,6 +121,8 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty" yaml:"tx_size_cost_per_byte"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty" yaml:"sig_verify_cost_ed25519"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty" yaml:"sig_verify_cost_secp256k1"`
+	SigVerifyCostSecp256r1 uint64 `protobuf:"varint,6,opt,name=sig_verify_cost_secp256r1,json=sigVerifyCostSecp256r1,proto3" json:"sig_verify_cost_secp256r1,omitempty" yaml:"sig_verify_cost_secp256r1"`
+	PubKeyChangeCost       uint64 `protobuf:"varint,7,opt,name=pub_key_change_cost,json=pubKeyChangeCost,proto3" json:"pub_key_change_cost,omitempty" yaml:"pub_key_change_cost"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -182,58 +192,160 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetSigVerifyCostSecp256r1() uint64 {
+	if m != nil {
+		return m.SigVerifyCostSecp256r1
+	}
+	return 0
+}
+
+func (m *Params) GetPubKeyChangeCost() uint64 {
+	if m != nil {
+		return m.PubKeyChangeCost
+	}
+	return 0
+}
+
+// MsgChangePubKey replaces the public key of an account, keeping its address.
+type MsgChangePubKey struct {
+	Address github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+	PubKey  []byte                                        `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"public_key" yaml:"public_key"`
+}
+
+func (m *MsgChangePubKey) Reset()         { *m = MsgChangePubKey{} }
+func (m *MsgChangePubKey) String() string { return proto.CompactTextString(m) }
+func (*MsgChangePubKey) ProtoMessage()    {}
+func (*MsgChangePubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d526fa662daab74, []int{3}
+}
+func (m *MsgChangePubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangePubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangePubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgChangePubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangePubKey.Merge(m, src)
+}
+func (m *MsgChangePubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangePubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangePubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChangePubKey proto.InternalMessageInfo
+
+// MsgChangePubKeyResponse defines the Msg/ChangePubKey response type
+type MsgChangePubKeyResponse struct {
+}
+
+func (m *MsgChangePubKeyResponse) Reset()         { *m = MsgChangePubKeyResponse{} }
+func (m *MsgChangePubKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChangePubKeyResponse) ProtoMessage()    {}
+func (*MsgChangePubKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d526fa662daab74, []int{4}
+}
+func (m *MsgChangePubKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangePubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangePubKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgChangePubKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangePubKeyResponse.Merge(m, src)
+}
+func (m *MsgChangePubKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangePubKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangePubKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChangePubKeyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos_sdk.x.auth.v1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos_sdk.x.auth.v1.ModuleAccount")
 	proto.RegisterType((*Params)(nil), "cosmos_sdk.x.auth.v1.Params")
+	proto.RegisterType((*MsgChangePubKey)(nil), "cosmos_sdk.x.auth.v1.MsgChangePubKey")
+	proto.RegisterType((*MsgChangePubKeyResponse)(nil), "cosmos_sdk.x.auth.v1.MsgChangePubKeyResponse")
 }
 
 func init() { proto.RegisterFile("x/auth/types/types.proto", fileDescriptor_2d526fa662daab74) }
 
 var fileDescriptor_2d526fa662daab74 = []byte{
-	// 668 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x4f, 0x4f, 0xdb, 0x48,
-	0x14, 0x8f, 0x49, 0x96, 0x3f, 0x13, 0x40, 0xc2, 0x04, 0x30, 0xd9, 0x95, 0x27, 0xeb, 0xc3, 0x8a,
-	0x55, 0x1b, 0x47, 0xa1, 0xa2, 0x12, 0x39, 0x54, 0xc5, 0xb4, 0x95, 0x10, 0x05, 0x21, 0x23, 0xf5,
-	0xd0, 0x8b, 0x35, 0xb6, 0xa7, 0x89, 0x95, 0x4c, 0xc6, 0x78, 0xc6, 0x28, 0xe6, 0x13, 0xf4, 0xd8,
-	0x53, 0xd5, 0x23, 0x1f, 0xa2, 0x1f, 0xa1, 0x87, 0x1e, 0x51, 0x4f, 0x3d, 0xb9, 0x55, 0xb8, 0x54,
-	0x3d, 0xfa, 0xd8, 0x53, 0x65, 0x4f, 0x08, 0x0e, 0x4d, 0x7b, 0xb1, 0xe6, 0xbd, 0xf7, 0xfb, 0xf3,
-	0xe6, 0x3d, 0x79, 0x80, 0x32, 0x68, 0xa0, 0x90, 0x77, 0x1a, 0x3c, 0xf2, 0x31, 0x13, 0x5f, 0xdd,
-	0x0f, 0x28, 0xa7, 0x72, 0xc5, 0xa1, 0x8c, 0x50, 0x66, 0x31, 0xb7, 0xab, 0x0f, 0xf4, 0x14, 0xa4,
-	0x9f, 0x37, 0xab, 0xf7, 0x78, 0xc7, 0x0b, 0x5c, 0xcb, 0x47, 0x01, 0x8f, 0x1a, 0x19, 0xb0, 0x21,
-	0x70, 0xf5, 0x7c, 0x20, 0x24, 0xaa, 0xff, 0xfd, 0x0a, 0x6e, 0xd3, 0x36, 0xbd, 0x3d, 0x09, 0x9c,
-	0xf6, 0x76, 0x06, 0x94, 0x0d, 0xc4, 0xf0, 0x9e, 0xe3, 0xd0, 0xb0, 0xcf, 0xe5, 0x43, 0x30, 0x87,
-	0x5c, 0x37, 0xc0, 0x8c, 0x29, 0x52, 0x4d, 0xda, 0x5a, 0x34, 0x9a, 0x3f, 0x62, 0x58, 0x6f, 0x7b,
-	0xbc, 0x13, 0xda, 0xba, 0x43, 0xc9, 0xc8, 0xe5, 0xc6, 0x99, 0xb9, 0xdd, 0x51, 0xe7, 0x7b, 0x8e,
-	0xb3, 0x27, 0x88, 0xe6, 0x8d, 0x82, 0xfc, 0x0c, 0xcc, 0xf9, 0xa1, 0x6d, 0x75, 0x71, 0xa4, 0xcc,
-	0x64, 0x62, 0xf5, 0xef, 0x31, 0xac, 0xf8, 0xa1, 0xdd, 0xf3, 0x9c, 0x34, 0x7b, 0x9f, 0x12, 0x8f,
-	0x63, 0xe2, 0xf3, 0x28, 0x89, 0xe1, 0x4a, 0x84, 0x48, 0xaf, 0xa5, 0xdd, 0x56, 0x35, 0x73, 0xd6,
-	0x0f, 0xed, 0x43, 0x1c, 0xc9, 0x8f, 0xc1, 0x32, 0x12, 0xfd, 0x59, 0xfd, 0x90, 0xd8, 0x38, 0x50,
-	0x8a, 0x35, 0x69, 0xab, 0x64, 0x6c, 0x26, 0x31, 0x5c, 0x13, 0xb4, 0xc9, 0xba, 0x66, 0x2e, 0x8d,
-	0x12, 0xc7, 0x59, 0x2c, 0x57, 0xc1, 0x3c, 0xc3, 0x67, 0x21, 0xee, 0x3b, 0x58, 0x29, 0xa5, 0x5c,
-	0x73, 0x1c, 0xb7, 0x2a, 0xaf, 0x2f, 0x61, 0xe1, 0xdd, 0x25, 0x2c, 0x7c, 0x7a, 0x5f, 0x9f, 0x1f,
-	0xcd, 0xe1, 0x40, 0xfb, 0x20, 0x81, 0xa5, 0x23, 0xea, 0x86, 0xbd, 0xf1, 0x68, 0x10, 0x58, 0xb4,
-	0x11, 0xc3, 0xd6, 0x48, 0x39, 0x9b, 0x4f, 0x79, 0xfb, 0x5f, 0x7d, 0xda, 0xb2, 0xf4, 0xdc, 0x4c,
-	0x8d, 0xbf, 0xaf, 0x62, 0x28, 0x25, 0x31, 0x5c, 0x15, 0xad, 0xe6, 0x45, 0x34, 0xb3, 0x6c, 0xe7,
-	0xa6, 0x2f, 0x83, 0x52, 0x1f, 0x11, 0x9c, 0x4d, 0x6b, 0xc1, 0xcc, 0xce, 0x72, 0x0d, 0x94, 0x7d,
-	0x1c, 0x10, 0x8f, 0x31, 0x8f, 0xf6, 0x99, 0x52, 0xac, 0x15, 0xb7, 0x16, 0xcc, 0x7c, 0xaa, 0x55,
-	0xcd, 0x5d, 0x60, 0x79, 0xa2, 0xe7, 0x03, 0xed, 0x4b, 0x11, 0xcc, 0x9e, 0xa0, 0x00, 0x11, 0x26,
-	0x1f, 0x83, 0x55, 0x82, 0x06, 0x16, 0xc1, 0x84, 0x5a, 0x4e, 0x07, 0x05, 0xc8, 0xe1, 0x38, 0x10,
-	0x6b, 0x2e, 0x19, 0x6a, 0x12, 0xc3, 0xaa, 0xe8, 0x6f, 0x0a, 0x48, 0x33, 0x57, 0x08, 0x1a, 0x1c,
-	0x61, 0x42, 0xf7, 0xc7, 0x39, 0x79, 0x17, 0x2c, 0xf2, 0x81, 0xc5, 0xbc, 0xb6, 0xd5, 0xf3, 0x88,
-	0xc7, 0xb3, 0xa6, 0x4b, 0xc6, 0xc6, 0xed, 0x45, 0xf3, 0x55, 0xcd, 0x04, 0x7c, 0x70, 0xea, 0xb5,
-	0x9f, 0xa7, 0x81, 0x6c, 0x82, 0xb5, 0xac, 0x78, 0x81, 0x2d, 0x87, 0x32, 0x6e, 0xf9, 0x38, 0xb0,
-	0xec, 0x88, 0xe3, 0xd1, 0x5e, 0x6b, 0x49, 0x0c, 0xff, 0xc9, 0x69, 0xdc, 0x85, 0x69, 0xe6, 0x4a,
-	0x2a, 0x76, 0x81, 0xf7, 0x29, 0xe3, 0x27, 0x38, 0x30, 0x22, 0x8e, 0xe5, 0x33, 0xb0, 0x91, 0xba,
-	0x9d, 0xe3, 0xc0, 0x7b, 0x15, 0x09, 0x3c, 0x76, 0xb7, 0x77, 0x76, 0x9a, 0xbb, 0x62, 0xe3, 0x46,
-	0x6b, 0x18, 0xc3, 0xca, 0xa9, 0xd7, 0x7e, 0x91, 0x21, 0x52, 0xea, 0xd3, 0x27, 0x59, 0x3d, 0x89,
-	0xa1, 0x2a, 0xdc, 0x7e, 0x23, 0xa0, 0x99, 0x15, 0x36, 0xc1, 0x13, 0x69, 0x39, 0x02, 0x9b, 0x77,
-	0x19, 0x0c, 0x3b, 0xfe, 0xf6, 0xce, 0xc3, 0x6e, 0x53, 0xf9, 0x2b, 0x33, 0x7d, 0x34, 0x8c, 0xe1,
-	0xfa, 0x84, 0xe9, 0xe9, 0x0d, 0x22, 0x89, 0x61, 0x6d, 0xba, 0xed, 0x58, 0x44, 0x33, 0xd7, 0xd9,
-	0x54, 0x6e, 0x6b, 0x3e, 0xdd, 0xf7, 0xb7, 0x4b, 0x28, 0x19, 0xfb, 0x1f, 0x87, 0xaa, 0x74, 0x35,
-	0x54, 0xa5, 0xaf, 0x43, 0x55, 0x7a, 0x73, 0xad, 0x16, 0xae, 0xae, 0xd5, 0xc2, 0xe7, 0x6b, 0xb5,
-	0xf0, 0xf2, 0xff, 0x3f, 0xfe, 0xb6, 0xf9, 0xd7, 0xc7, 0x9e, 0xcd, 0x5e, 0x83, 0x07, 0x3f, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0x11, 0xf8, 0x99, 0x15, 0x94, 0x04, 0x00, 0x00,
+	// 817 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xb7, 0xd9, 0xb6, 0x3b, 0x6d, 0x77, 0xa9, 0x9b, 0x6d, 0xdd, 0x80, 0x3c, 0x61, 0x10,
+	0xa8, 0x08, 0x92, 0x2a, 0x45, 0x45, 0xda, 0x08, 0x21, 0xea, 0x02, 0xd2, 0x6a, 0xc9, 0xaa, 0x9a,
+	0x4a, 0x1c, 0xb8, 0x58, 0x63, 0x7b, 0x70, 0xac, 0xd4, 0x19, 0xef, 0xcc, 0x78, 0x15, 0xef, 0x5f,
+	0xc0, 0x91, 0x23, 0xc7, 0x8a, 0x1b, 0x77, 0xfe, 0x04, 0x0e, 0x1c, 0x2b, 0x4e, 0x88, 0x83, 0x85,
+	0xd2, 0x0b, 0xda, 0xa3, 0x8f, 0x9c, 0x90, 0x3d, 0xf9, 0xe1, 0x84, 0x6c, 0xe0, 0xb0, 0x97, 0x28,
+	0xf3, 0xde, 0xf7, 0xbe, 0xef, 0x9b, 0x99, 0x37, 0xcf, 0xc0, 0x18, 0x1e, 0x93, 0x58, 0xf6, 0x8e,
+	0x65, 0x12, 0x51, 0xa1, 0x7e, 0x5b, 0x11, 0x67, 0x92, 0xe9, 0x35, 0x97, 0x89, 0x90, 0x09, 0x5b,
+	0x78, 0xfd, 0xd6, 0xb0, 0x95, 0x83, 0x5a, 0xcf, 0xdb, 0xf5, 0x0f, 0x64, 0x2f, 0xe0, 0x9e, 0x1d,
+	0x11, 0x2e, 0x93, 0xe3, 0x02, 0x78, 0xac, 0x70, 0xcd, 0xf2, 0x42, 0x51, 0xd4, 0xdf, 0xfb, 0x37,
+	0xd8, 0x67, 0x3e, 0x9b, 0xfd, 0x53, 0x38, 0xf4, 0xc7, 0x1d, 0xb0, 0x65, 0x11, 0x41, 0xcf, 0x5c,
+	0x97, 0xc5, 0x03, 0xa9, 0x3f, 0x01, 0x1b, 0xc4, 0xf3, 0x38, 0x15, 0xc2, 0xd0, 0x1a, 0xda, 0xd1,
+	0xb6, 0xd5, 0xfe, 0x3b, 0x85, 0x4d, 0x3f, 0x90, 0xbd, 0xd8, 0x69, 0xb9, 0x2c, 0x1c, 0xab, 0x4c,
+	0x94, 0x85, 0xd7, 0x1f, 0x3b, 0x3f, 0x73, 0xdd, 0x33, 0x55, 0x88, 0x27, 0x0c, 0xfa, 0x97, 0x60,
+	0x23, 0x8a, 0x1d, 0xbb, 0x4f, 0x13, 0xe3, 0x4e, 0x41, 0xd6, 0x7c, 0x99, 0xc2, 0x5a, 0x14, 0x3b,
+	0x57, 0x81, 0x9b, 0x47, 0x3f, 0x64, 0x61, 0x20, 0x69, 0x18, 0xc9, 0x24, 0x4b, 0xe1, 0x6e, 0x42,
+	0xc2, 0xab, 0x0e, 0x9a, 0x65, 0x11, 0x5e, 0x8f, 0x62, 0xe7, 0x09, 0x4d, 0xf4, 0xcf, 0xc0, 0x7d,
+	0xa2, 0xfc, 0xd9, 0x83, 0x38, 0x74, 0x28, 0x37, 0xd6, 0x1a, 0xda, 0x51, 0xd5, 0x3a, 0xcc, 0x52,
+	0xf8, 0x50, 0x95, 0xcd, 0xe7, 0x11, 0xde, 0x19, 0x07, 0x9e, 0x16, 0x6b, 0xbd, 0x0e, 0x36, 0x05,
+	0x7d, 0x16, 0xd3, 0x81, 0x4b, 0x8d, 0x6a, 0x5e, 0x8b, 0xa7, 0x6b, 0xdd, 0x02, 0x0f, 0xc6, 0x2e,
+	0x6d, 0xce, 0x24, 0x91, 0xd4, 0x33, 0xee, 0x36, 0xb4, 0xa3, 0x4d, 0xab, 0x9e, 0xa5, 0x70, 0x7f,
+	0xea, 0xaa, 0x0c, 0x40, 0x78, 0x47, 0x59, 0xc3, 0x6a, 0xdd, 0xa9, 0x7d, 0x77, 0x0d, 0x2b, 0x3f,
+	0x5c, 0xc3, 0xca, 0x6f, 0x3f, 0x37, 0x37, 0xc7, 0x67, 0xf9, 0x18, 0xfd, 0xa2, 0x81, 0x9d, 0x2e,
+	0xf3, 0xe2, 0xab, 0xe9, 0xf1, 0x12, 0xb0, 0xed, 0x10, 0x41, 0xed, 0xb1, 0xbb, 0xe2, 0x8c, 0xb7,
+	0x4e, 0xde, 0x6e, 0x2d, 0xbb, 0xf0, 0x56, 0xe9, 0x5e, 0xac, 0x37, 0x6f, 0x52, 0xa8, 0x65, 0x29,
+	0xdc, 0x53, 0x7e, 0xca, 0x24, 0x08, 0x6f, 0x39, 0xa5, 0x1b, 0xd4, 0x41, 0x75, 0x40, 0x42, 0x5a,
+	0x9c, 0xf8, 0x3d, 0x5c, 0xfc, 0xd7, 0x1b, 0x60, 0x2b, 0xa2, 0x3c, 0x0c, 0x84, 0x08, 0xd8, 0x40,
+	0x18, 0x6b, 0x8d, 0xb5, 0xa3, 0x7b, 0xb8, 0x1c, 0xea, 0xd4, 0x4b, 0x1b, 0xb8, 0x3f, 0xe7, 0xf9,
+	0x31, 0xfa, 0xe9, 0x2e, 0x58, 0xbf, 0x20, 0x9c, 0x84, 0x42, 0x7f, 0x0a, 0xf6, 0x42, 0x32, 0xb4,
+	0x43, 0x1a, 0x32, 0xdb, 0xed, 0x11, 0x4e, 0x5c, 0x49, 0xb9, 0x6a, 0x95, 0xaa, 0x65, 0x66, 0x29,
+	0xac, 0x2b, 0x7f, 0x4b, 0x40, 0x08, 0xef, 0x86, 0x64, 0xd8, 0xa5, 0x21, 0x3b, 0x9f, 0xc6, 0xf4,
+	0x47, 0x60, 0x5b, 0x0e, 0x6d, 0x11, 0xf8, 0xf6, 0x55, 0x10, 0x06, 0xb2, 0x30, 0x5d, 0xb5, 0x0e,
+	0x66, 0x1b, 0x2d, 0x67, 0x11, 0x06, 0x72, 0x78, 0x19, 0xf8, 0x5f, 0xe5, 0x0b, 0x1d, 0x83, 0x87,
+	0x45, 0xf2, 0x05, 0xb5, 0x5d, 0x26, 0xa4, 0x1d, 0x51, 0x6e, 0x3b, 0x89, 0xa4, 0xe3, 0xde, 0x68,
+	0x64, 0x29, 0x7c, 0xab, 0xc4, 0xb1, 0x08, 0x43, 0x78, 0x37, 0x27, 0x7b, 0x41, 0xcf, 0x99, 0x90,
+	0x17, 0x94, 0x5b, 0x89, 0xa4, 0xfa, 0x33, 0x70, 0x90, 0xab, 0x3d, 0xa7, 0x3c, 0xf8, 0x36, 0x51,
+	0x78, 0xea, 0x9d, 0x9c, 0x9e, 0xb6, 0x1f, 0xa9, 0xae, 0xb1, 0x3a, 0xa3, 0x14, 0xd6, 0x2e, 0x03,
+	0xff, 0xeb, 0x02, 0x91, 0x97, 0x7e, 0xf1, 0x79, 0x91, 0xcf, 0x52, 0x68, 0x2a, 0xb5, 0x57, 0x10,
+	0x20, 0x5c, 0x13, 0x73, 0x75, 0x2a, 0xac, 0x27, 0xe0, 0x70, 0xb1, 0x42, 0x50, 0x37, 0x3a, 0x39,
+	0xfd, 0xb8, 0xdf, 0x2e, 0xfa, 0xb0, 0x6a, 0x7d, 0x3a, 0x4a, 0xe1, 0xfe, 0x9c, 0xe8, 0xe5, 0x04,
+	0x91, 0xa5, 0xb0, 0xb1, 0x5c, 0x76, 0x4a, 0x82, 0xf0, 0xbe, 0x58, 0x5a, 0xbb, 0x42, 0x9a, 0xb7,
+	0x8d, 0xf5, 0xd5, 0xd2, 0xfc, 0xbf, 0xa5, 0xf9, 0xab, 0xa4, 0x79, 0x5b, 0xef, 0x82, 0xbd, 0xc9,
+	0x93, 0x72, 0x7b, 0x64, 0xe0, 0xab, 0xcb, 0x31, 0x36, 0x16, 0xfb, 0x68, 0x09, 0x08, 0xe1, 0x37,
+	0xd4, 0xdb, 0x3b, 0x2f, 0x62, 0x39, 0x6f, 0x67, 0x33, 0xef, 0xdc, 0xbf, 0xae, 0xa1, 0x86, 0x7e,
+	0xd4, 0xc0, 0x83, 0xae, 0xf0, 0x55, 0xee, 0x42, 0x8d, 0x8f, 0xd7, 0x3a, 0xd3, 0x3e, 0x59, 0x9c,
+	0x69, 0xef, 0xbc, 0x4c, 0x21, 0x98, 0x4d, 0xad, 0x95, 0x93, 0xac, 0x53, 0xcd, 0x9f, 0x19, 0x3a,
+	0x04, 0x07, 0x0b, 0x1e, 0x31, 0x15, 0x11, 0x1b, 0x08, 0x7a, 0xd2, 0x07, 0x6b, 0x5d, 0xe1, 0xeb,
+	0x1e, 0xd8, 0x9e, 0xdb, 0xc2, 0xbb, 0xcb, 0x27, 0xc4, 0x02, 0x4b, 0xbd, 0xf9, 0xbf, 0x60, 0x13,
+	0x31, 0xeb, 0xfc, 0xd7, 0x91, 0xa9, 0xdd, 0x8c, 0x4c, 0xed, 0xcf, 0x91, 0xa9, 0x7d, 0x7f, 0x6b,
+	0x56, 0x6e, 0x6e, 0xcd, 0xca, 0xef, 0xb7, 0x66, 0xe5, 0x9b, 0xf7, 0x57, 0x9e, 0x4e, 0xf9, 0xc3,
+	0xe5, 0xac, 0x17, 0x1f, 0x92, 0x8f, 0xfe, 0x19, 0x00, 0x3e, 0xc0, 0xa3, 0x06, 0xcf, 0x06, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -270,8 +382,97 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if this.SigVerifyCostSecp256r1 != that1.SigVerifyCostSecp256r1 {
+		return false
+	}
+	if this.PubKeyChangeCost != that1.PubKeyChangeCost {
+		return false
+	}
 	return true
 }
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// ChangePubKey defines a method for rotating the public key of an account
+	ChangePubKey(ctx context.Context, in *MsgChangePubKey, opts ...grpc.CallOption) (*MsgChangePubKeyResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ChangePubKey(ctx context.Context, in *MsgChangePubKey, opts ...grpc.CallOption) (*MsgChangePubKeyResponse, error) {
+	out := new(MsgChangePubKeyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_sdk.x.auth.v1.Msg/ChangePubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ChangePubKey defines a method for rotating the public key of an account
+	ChangePubKey(context.Context, *MsgChangePubKey) (*MsgChangePubKeyResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) ChangePubKey(ctx context.Context, req *MsgChangePubKey) (*MsgChangePubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePubKey not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_ChangePubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangePubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangePubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_sdk.x.auth.v1.Msg/ChangePubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangePubKey(ctx, req.(*MsgChangePubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos_sdk.x.auth.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ChangePubKey",
+			Handler:    _Msg_ChangePubKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "x/auth/types/types.proto",
+}
+
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -292,6 +493,16 @@ func (m *BaseAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PubKeyRotated {
+		i--
+		if m.PubKeyRotated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Sequence != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.Sequence))
 		i--
@@ -390,6 +601,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PubKeyChangeCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PubKeyChangeCost))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SigVerifyCostSecp256r1 != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SigVerifyCostSecp256r1))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -418,6 +639,66 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgChangePubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangePubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangePubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangePubKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangePubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangePubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -449,6 +730,9 @@ func (m *BaseAccount) Size() (n int) {
 	if m.Sequence != 0 {
 		n += 1 + sovTypes(uint64(m.Sequence))
 	}
+	if m.PubKeyRotated {
+		n += 2
+	}
 	return n
 }
 
@@ -496,6 +780,38 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovTypes(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if m.SigVerifyCostSecp256r1 != 0 {
+		n += 1 + sovTypes(uint64(m.SigVerifyCostSecp256r1))
+	}
+	if m.PubKeyChangeCost != 0 {
+		n += 1 + sovTypes(uint64(m.PubKeyChangeCost))
+	}
+	return n
+}
+
+func (m *MsgChangePubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChangePubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	return n
 }
 
@@ -640,6 +956,26 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyRotated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PubKeyRotated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -941,6 +1277,218 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256r1", wireType)
+			}
+			m.SigVerifyCostSecp256r1 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostSecp256r1 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyChangeCost", wireType)
+			}
+			m.PubKeyChangeCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PubKeyChangeCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangePubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangePubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangePubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangePubKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangePubKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangePubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
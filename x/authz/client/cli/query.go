@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// GetQueryCmd returns the parent querying command for the authz module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the authz module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(flags.GetCommands(
+		GetCmdQueryAuthorization(cdc),
+		GetCmdQueryAuthorizations(cdc),
+	)...)
+
+	return cmd
+}
+
+// GetCmdQueryAuthorization returns a CLI command handler for querying the
+// authorization granted for a single message type.
+func GetCmdQueryAuthorization(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "authorization [granter_address] [grantee_address] [msg_type]",
+		Short: "Query the authorization granted to a grantee for a message type",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAuthorizationParams(granter, grantee, args[2]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAuthorization)
+			res, _, err := clientCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var authorization types.Authorization
+			if err := cdc.UnmarshalJSON(res, &authorization); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(authorization)
+		},
+	}
+}
+
+// GetCmdQueryAuthorizations returns a CLI command handler for querying all the
+// authorizations a granter has granted to a grantee.
+func GetCmdQueryAuthorizations(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "authorizations [granter_address] [grantee_address]",
+		Short: "Query all the authorizations granted to a grantee by a granter",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAuthorizationsParams(granter, grantee))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAuthorizations)
+			res, _, err := clientCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var authorizations []types.Authorization
+			if err := cdc.UnmarshalJSON(res, &authorizations); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(authorizations)
+		},
+	}
+}
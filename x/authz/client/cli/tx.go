@@ -0,0 +1,217 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+const (
+	FlagSpendLimit = "spend-limit"
+	FlagMsgType    = "msg-type"
+	FlagExpiration = "expiration"
+
+	authorizationTypeSend    = "send"
+	authorizationTypeGeneric = "generic"
+
+	defaultExpiration = "8760h"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/authz transaction commands.
+func NewTxCmd(clientCtx client.Context) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Authorization transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewGrantAuthorizationCmd(clientCtx),
+		NewRevokeAuthorizationCmd(clientCtx),
+		NewExecAuthorizedCmd(clientCtx),
+	)
+
+	return txCmd
+}
+
+// NewGrantAuthorizationCmd returns a CLI command handler for creating a
+// MsgGrantAuthorization transaction.
+func NewGrantAuthorizationCmd(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee_address] [authorization_type] --from [granter]",
+		Short: "Grant authorization to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant authorization to an address to execute a transaction on your behalf.
+The authorization type is either "send", limited by --spend-limit, or "generic",
+allowing any message of the --msg-type type URL.
+
+Examples:
+$ %s tx %s grant cosmos1skjw.. send --spend-limit=1000stake --expiration=3600s --from=granter
+$ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos_sdk.x.staking.v1.MsgDelegate --expiration=2021-01-01T00:00:00Z --from=granter
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx = clientCtx.InitWithInput(cmd.InOrStdin())
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var authorization types.Authorization
+			switch args[1] {
+			case authorizationTypeSend:
+				spendLimit, err := sdk.ParseCoins(viper.GetString(FlagSpendLimit))
+				if err != nil {
+					return err
+				}
+				authorization = types.NewSendAuthorization(spendLimit)
+
+			case authorizationTypeGeneric:
+				authorization = types.NewGenericAuthorization(viper.GetString(FlagMsgType))
+
+			default:
+				return fmt.Errorf("invalid authorization type %q, expected %q or %q", args[1], authorizationTypeSend, authorizationTypeGeneric)
+			}
+
+			expiration, err := ParseExpiration(viper.GetString(FlagExpiration), time.Now())
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgGrantAuthorization(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagSpendLimit, "", "Coins the grantee may spend on behalf of the granter (send authorization)")
+	cmd.Flags().String(FlagMsgType, "", "Type URL of the message the grantee may execute (generic authorization)")
+	cmd.Flags().String(FlagExpiration, defaultExpiration, "Time at which the authorization expires, as an RFC3339 timestamp or a duration from now (e.g. 3600s)")
+
+	return flags.PostCommands(cmd)[0]
+}
+
+// ParseExpiration parses an authorization expiration, given either as an
+// RFC3339 timestamp (e.g. "2021-01-01T00:00:00Z") or as a duration relative to
+// now (e.g. "3600s" or "720h"). The result is in UTC so that it is encoded
+// identically regardless of the local time zone.
+func ParseExpiration(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, fmt.Errorf("expiration cannot be empty")
+	}
+
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.UTC(), nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid expiration %q: expected an RFC3339 timestamp or a duration", s)
+	}
+
+	if d <= 0 {
+		return time.Time{}, fmt.Errorf("invalid expiration %q: duration must be positive", s)
+	}
+
+	return now.Add(d).UTC(), nil
+}
+
+// NewRevokeAuthorizationCmd returns a CLI command handler for creating a
+// MsgRevokeAuthorization transaction.
+func NewRevokeAuthorizationCmd(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [grantee_address] [msg_type] --from [granter]",
+		Short: "Revoke an authorization",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the authorization for a message type granted to an address.
+
+Example:
+$ %s tx %s revoke cosmos1skjw.. /cosmos_sdk.x.bank.v1.MsgSend --from=granter
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx = clientCtx.InitWithInput(cmd.InOrStdin())
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeAuthorization(clientCtx.GetFromAddress(), grantee, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
+
+// NewExecAuthorizedCmd returns a CLI command handler for creating a
+// MsgExecAuthorized transaction from the messages of a generated transaction.
+func NewExecAuthorizedCmd(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [msg_tx_json_file] --from [grantee]",
+		Short: "Execute transaction on behalf of granter account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the messages of a transaction generated with --generate-only on
+behalf of their signer, using the authorizations granted to the grantee.
+
+Example:
+$ %s tx %s exec tx.json --from=grantee
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx = clientCtx.InitWithInput(cmd.InOrStdin())
+
+			stdTx, err := authclient.ReadStdTxFromFile(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgExecAuthorized(clientCtx.GetFromAddress(), stdTx.GetMsgs())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
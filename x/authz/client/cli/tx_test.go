@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExpiration(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+
+	testCases := []struct {
+		input   string
+		exp     time.Time
+		expPass bool
+	}{
+		{"2021-01-01T00:00:00Z", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), true},
+		{"2021-01-01T02:00:00+02:00", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), true},
+		{"3600s", time.Date(2020, 6, 1, 11, 0, 0, 0, time.UTC), true},
+		{" 720h ", time.Date(2020, 7, 1, 10, 0, 0, 0, time.UTC), true},
+		{"", time.Time{}, false},
+		{"0s", time.Time{}, false},
+		{"-1h", time.Time{}, false},
+		{"2021-01-01", time.Time{}, false},
+		{"height:100000", time.Time{}, false},
+	}
+
+	for _, tc := range testCases {
+		exp, err := ParseExpiration(tc.input, now)
+		if tc.expPass {
+			require.NoError(t, err, tc.input)
+			require.Equal(t, tc.exp, exp, tc.input)
+		} else {
+			require.Error(t, err, tc.input)
+		}
+	}
+}
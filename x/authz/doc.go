@@ -0,0 +1,14 @@
+/*
+Package authz implements a generic message authorization module. It allows an
+account (the granter) to grant another account (the grantee) the ability to
+execute specific message types on its behalf.
+
+Authorizations implement the Authorization interface. The module provides a
+SendAuthorization, which limits the coins a grantee may send from the granter's
+account, and a GenericAuthorization, which permits any message of a given type
+URL. Grants carry an expiration time after which they are revoked on use.
+
+Messages executed via MsgExecAuthorized are checked against the grantee's
+authorizations and then dispatched through the application's message router.
+*/
+package authz
@@ -0,0 +1,95 @@
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// NewHandler returns a handler for authz messages.
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *types.MsgGrantAuthorization:
+			return handleMsgGrantAuthorization(ctx, k, msg)
+
+		case *types.MsgRevokeAuthorization:
+			return handleMsgRevokeAuthorization(ctx, k, msg)
+
+		case *types.MsgExecAuthorized:
+			return handleMsgExecAuthorized(ctx, k, msg)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
+		}
+	}
+}
+
+func handleMsgGrantAuthorization(ctx sdk.Context, k keeper.Keeper, msg *types.MsgGrantAuthorization) (*sdk.Result, error) {
+	if !msg.Expiration.After(ctx.BlockTime()) {
+		return nil, types.ErrInvalidExpirationTime
+	}
+
+	authorization := msg.GetAuthorization()
+	if err := k.Grant(ctx, msg.Grantee, msg.Granter, authorization, msg.Expiration); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgRevokeAuthorization(ctx sdk.Context, k keeper.Keeper, msg *types.MsgRevokeAuthorization) (*sdk.Result, error) {
+	if err := k.Revoke(ctx, msg.Grantee, msg.Granter, msg.AuthorizationMsgType); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgExecAuthorized(ctx sdk.Context, k keeper.Keeper, msg *types.MsgExecAuthorized) (*sdk.Result, error) {
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := k.DispatchActions(ctx, msg.Grantee, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecAuthorized,
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee.String()),
+		),
+	)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Grantee.String()),
+		),
+	)
+
+	result.Events = append(ctx.EventManager().ABCIEvents(), result.Events...)
+	return result, nil
+}
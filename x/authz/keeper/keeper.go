@@ -0,0 +1,233 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// Keeper defines the authz module's keeper. It persists authorization grants
+// and dispatches messages executed on behalf of a granter through the
+// application's message router.
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.Marshaler
+	router   sdk.Router
+}
+
+// NewKeeper constructs a message authorization Keeper. The router is used to
+// dispatch messages executed via MsgExecAuthorized.
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.Marshaler, router sdk.Router) Keeper {
+	return Keeper{
+		storeKey: storeKey,
+		cdc:      cdc,
+		router:   router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// getAuthorizationGrant returns the grant stored under the provided key.
+func (k Keeper) getAuthorizationGrant(ctx sdk.Context, grantStoreKey []byte) (grant types.AuthorizationGrant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(grantStoreKey)
+	if bz == nil {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+// Grant stores the provided authorization for the grantee on the granter's
+// account with the provided expiration time, overwriting any existing
+// authorization for the same message type.
+func (k Keeper) Grant(
+	ctx sdk.Context, grantee, granter sdk.AccAddress, authorization types.Authorization, expiration time.Time,
+) error {
+	grant, err := types.NewAuthorizationGrant(authorization, expiration)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GrantStoreKey(grantee, granter, authorization.MsgType()), k.cdc.MustMarshalBinaryBare(&grant))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrantAuthorization,
+			sdk.NewAttribute(types.AttributeKeyMsgType, authorization.MsgType()),
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// Revoke removes any authorization for the provided message type granted to
+// the grantee by the granter.
+func (k Keeper) Revoke(ctx sdk.Context, grantee, granter sdk.AccAddress, msgType string) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GrantStoreKey(grantee, granter, msgType)
+	if !store.Has(key) {
+		return sdkerrors.Wrap(types.ErrNoAuthorizationFound, msgType)
+	}
+
+	store.Delete(key)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeAuthorization,
+			sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetAuthorization returns the authorization and its expiration time for the
+// provided message type granted to the grantee by the granter. A nil
+// authorization is returned if none exists.
+func (k Keeper) GetAuthorization(
+	ctx sdk.Context, grantee, granter sdk.AccAddress, msgType string,
+) (authorization types.Authorization, expiration time.Time) {
+	grant, found := k.getAuthorizationGrant(ctx, types.GrantStoreKey(grantee, granter, msgType))
+	if !found {
+		return nil, time.Time{}
+	}
+
+	return grant.GetAuthorization(), grant.Expiration
+}
+
+// GetOrRevokeAuthorization returns the authorization for the provided message
+// type granted to the grantee by the granter if it has not expired. An expired
+// authorization is revoked and nil is returned.
+func (k Keeper) GetOrRevokeAuthorization(
+	ctx sdk.Context, grantee, granter sdk.AccAddress, msgType string,
+) types.Authorization {
+	authorization, expiration := k.GetAuthorization(ctx, grantee, granter, msgType)
+	if authorization == nil {
+		return nil
+	}
+
+	if !expiration.After(ctx.BlockTime()) {
+		// an existing grant is guaranteed to be found, so the error can be ignored
+		_ = k.Revoke(ctx, grantee, granter, msgType)
+		return nil
+	}
+
+	return authorization
+}
+
+// IterateGrants iterates over all stored authorization grants. If cb returns
+// true, the iteration stops.
+func (k Keeper) IterateGrants(
+	ctx sdk.Context, cb func(granter, grantee sdk.AccAddress, grant types.AuthorizationGrant) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GrantKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.AuthorizationGrant
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &grant)
+
+		granter, grantee, _ := types.SplitGrantStoreKey(iterator.Key())
+		if cb(granter, grantee, grant) {
+			break
+		}
+	}
+}
+
+// GetAuthorizations returns all the authorizations the granter has granted to
+// the grantee.
+func (k Keeper) GetAuthorizations(ctx sdk.Context, grantee, granter sdk.AccAddress) (authorizations []types.Authorization) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := append(append(types.GrantKeyPrefix, granter.Bytes()...), grantee.Bytes()...)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.AuthorizationGrant
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &grant)
+		authorizations = append(authorizations, grant.GetAuthorization())
+	}
+
+	return authorizations
+}
+
+// DispatchActions attempts to execute the provided messages on behalf of their
+// signers using the authorizations granted to the grantee. Messages signed by
+// the grantee itself are executed without an authorization. Each accepted
+// authorization is updated or, once exhausted, deleted before the message is
+// routed to its handler.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) (*sdk.Result, error) {
+	var events []abci.Event
+
+	for _, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "authorization can be given to msg with only one signer")
+		}
+
+		granter := signers[0]
+		if !granter.Equals(grantee) {
+			msgType := types.MsgTypeURL(msg)
+
+			authorization := k.GetOrRevokeAuthorization(ctx, grantee, granter, msgType)
+			if authorization == nil {
+				return nil, sdkerrors.Wrapf(types.ErrNoAuthorizationFound, "%s granted by %s", msgType, granter)
+			}
+
+			updated, del, err := authorization.Accept(msg, ctx.BlockHeader())
+			if err != nil {
+				return nil, err
+			}
+
+			telemetry.IncrCounterWithLabels(
+				[]string{types.ModuleName, "authorization", "uses"},
+				1,
+				[]telemetry.Label{telemetry.NewLabel("msg_type", msgType)},
+			)
+
+			if del {
+				if err := k.Revoke(ctx, grantee, granter, msgType); err != nil {
+					return nil, err
+				}
+			} else if updated != nil {
+				_, expiration := k.GetAuthorization(ctx, grantee, granter, msgType)
+				if err := k.Grant(ctx, grantee, granter, updated, expiration); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		handler := k.router.Route(ctx, msg.Route())
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", msg.Route())
+		}
+
+		msgResult, err := handler(ctx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute message %s", types.MsgTypeURL(msg))
+		}
+
+		events = append(events, msgResult.Events...)
+	}
+
+	return &sdk.Result{Events: events}, nil
+}
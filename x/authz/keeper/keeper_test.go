@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app   *simapp.SimApp
+	ctx   sdk.Context
+	addrs []sdk.AccAddress
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+}
+
+func (suite *KeeperTestSuite) TestKeeper() {
+	app, ctx, addrs := suite.app, suite.ctx, suite.addrs
+	granter, grantee := addrs[0], addrs[1]
+	msgType := types.MsgTypeURL(&banktypes.MsgSend{})
+
+	authorization, _ := app.AuthzKeeper.GetAuthorization(ctx, grantee, granter, msgType)
+	suite.Require().Nil(authorization)
+
+	now := ctx.BlockTime()
+	newCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	// an expired authorization is revoked on access
+	err := app.AuthzKeeper.Grant(ctx, grantee, granter, types.NewSendAuthorization(newCoins), now.Add(-time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().Nil(app.AuthzKeeper.GetOrRevokeAuthorization(ctx, grantee, granter, msgType))
+	authorization, _ = app.AuthzKeeper.GetAuthorization(ctx, grantee, granter, msgType)
+	suite.Require().Nil(authorization)
+
+	err = app.AuthzKeeper.Grant(ctx, grantee, granter, types.NewSendAuthorization(newCoins), now.Add(time.Hour))
+	suite.Require().NoError(err)
+	authorization = app.AuthzKeeper.GetOrRevokeAuthorization(ctx, grantee, granter, msgType)
+	suite.Require().NotNil(authorization)
+	suite.Require().Equal(msgType, authorization.MsgType())
+
+	// an authorization is scoped to a granter and grantee pair
+	suite.Require().Nil(app.AuthzKeeper.GetOrRevokeAuthorization(ctx, granter, grantee, msgType))
+	suite.Require().Nil(app.AuthzKeeper.GetOrRevokeAuthorization(ctx, addrs[2], granter, msgType))
+	suite.Require().Len(app.AuthzKeeper.GetAuthorizations(ctx, grantee, granter), 1)
+
+	suite.Require().NoError(app.AuthzKeeper.Revoke(ctx, grantee, granter, msgType))
+	suite.Require().Nil(app.AuthzKeeper.GetOrRevokeAuthorization(ctx, grantee, granter, msgType))
+	suite.Require().Error(app.AuthzKeeper.Revoke(ctx, grantee, granter, msgType))
+}
+
+func (suite *KeeperTestSuite) TestDispatchSendAuthorization() {
+	app, ctx, addrs := suite.app, suite.ctx, suite.addrs
+	granter, grantee, recipient := addrs[0], addrs[1], addrs[2]
+	msgType := types.MsgTypeURL(&banktypes.MsgSend{})
+
+	send := func(amount int64) []sdk.Msg {
+		return []sdk.Msg{banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))}
+	}
+
+	// no authorization granted yet
+	_, err := app.AuthzKeeper.DispatchActions(ctx, grantee, send(20))
+	suite.Require().Error(err)
+
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	err = app.AuthzKeeper.Grant(ctx, grantee, granter, types.NewSendAuthorization(spendLimit), ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+
+	recipientBalance := app.BankKeeper.GetBalance(ctx, recipient, "stake")
+
+	_, err = app.AuthzKeeper.DispatchActions(ctx, grantee, send(20))
+	suite.Require().NoError(err)
+	suite.Require().Equal(recipientBalance.Add(sdk.NewInt64Coin("stake", 20)), app.BankKeeper.GetBalance(ctx, recipient, "stake"))
+
+	authorization := app.AuthzKeeper.GetOrRevokeAuthorization(ctx, grantee, granter, msgType)
+	suite.Require().NotNil(authorization)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), authorization.(*types.SendAuthorization).SpendLimit)
+
+	// exceeding the remaining spend limit is rejected
+	_, err = app.AuthzKeeper.DispatchActions(ctx, grantee, send(31))
+	suite.Require().Error(err)
+
+	// spending the remaining limit exhausts and deletes the authorization
+	_, err = app.AuthzKeeper.DispatchActions(ctx, grantee, send(30))
+	suite.Require().NoError(err)
+	suite.Require().Nil(app.AuthzKeeper.GetOrRevokeAuthorization(ctx, grantee, granter, msgType))
+}
+
+func (suite *KeeperTestSuite) TestDispatchGenericAuthorization() {
+	app, ctx, addrs := suite.app, suite.ctx, suite.addrs
+	granter, grantee, recipient := addrs[0], addrs[1], addrs[2]
+	msgType := types.MsgTypeURL(&banktypes.MsgSend{})
+
+	err := app.AuthzKeeper.Grant(ctx, grantee, granter, types.NewGenericAuthorization(msgType), ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+
+	msgs := []sdk.Msg{banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)))}
+	for i := 0; i < 2; i++ {
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		_, err = app.AuthzKeeper.DispatchActions(ctx, grantee, msgs)
+		suite.Require().NoError(err)
+
+		// the authorization is left unchanged, so it is not granted again
+		for _, event := range ctx.EventManager().Events() {
+			suite.Require().NotEqual(types.EventTypeGrantAuthorization, event.Type)
+		}
+	}
+	suite.Require().NotNil(app.AuthzKeeper.GetOrRevokeAuthorization(ctx, grantee, granter, msgType))
+
+	// a message of another type is not covered by the authorization
+	multiSend := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(granter, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))},
+		[]banktypes.Output{banktypes.NewOutput(recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))},
+	)
+	_, err = app.AuthzKeeper.DispatchActions(ctx, grantee, []sdk.Msg{multiSend})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQuerier() {
+	app, ctx, addrs := suite.app, suite.ctx, suite.addrs
+	granter, grantee := addrs[0], addrs[1]
+	msgType := types.MsgTypeURL(&banktypes.MsgSend{})
+	querier := keeper.NewQuerier(app.AuthzKeeper)
+	cdc := app.AppCodec()
+
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	err := app.AuthzKeeper.Grant(ctx, grantee, granter, types.NewSendAuthorization(spendLimit), ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+
+	req := abci.RequestQuery{Data: cdc.MustMarshalJSON(types.NewQueryAuthorizationParams(granter, grantee, msgType))}
+	bz, err := querier(ctx, []string{types.QueryAuthorization}, req)
+	suite.Require().NoError(err)
+
+	var authorization types.Authorization
+	suite.Require().NoError(cdc.UnmarshalJSON(bz, &authorization))
+	suite.Require().Equal(types.NewSendAuthorization(spendLimit), authorization)
+
+	req = abci.RequestQuery{Data: cdc.MustMarshalJSON(types.NewQueryAuthorizationsParams(granter, grantee))}
+	bz, err = querier(ctx, []string{types.QueryAuthorizations}, req)
+	suite.Require().NoError(err)
+
+	var authorizations []types.Authorization
+	suite.Require().NoError(cdc.UnmarshalJSON(bz, &authorizations))
+	suite.Require().Len(authorizations, 1)
+
+	req = abci.RequestQuery{Data: cdc.MustMarshalJSON(types.NewQueryAuthorizationParams(grantee, granter, msgType))}
+	_, err = querier(ctx, []string{types.QueryAuthorization}, req)
+	suite.Require().Error(err)
+
+	// an expired authorization is reported but not revoked by the query
+	expiredCtx := ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	req = abci.RequestQuery{Data: cdc.MustMarshalJSON(types.NewQueryAuthorizationParams(granter, grantee, msgType))}
+	_, err = querier(expiredCtx, []string{types.QueryAuthorization}, req)
+	suite.Require().True(types.ErrAuthorizationNotActive.Is(err))
+
+	authorization, _ = app.AuthzKeeper.GetAuthorization(expiredCtx, grantee, granter, msgType)
+	suite.Require().NotNil(authorization)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
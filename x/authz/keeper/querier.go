@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// NewQuerier returns a new sdk.Querier for the authz module.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		var (
+			res []byte
+			err error
+		)
+
+		switch path[0] {
+		case types.QueryAuthorization:
+			res, err = queryAuthorization(ctx, req, k)
+
+		case types.QueryAuthorizations:
+			res, err = queryAuthorizations(ctx, req, k)
+
+		default:
+			err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
+		}
+
+		return res, err
+	}
+}
+
+func queryAuthorization(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryAuthorizationParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	// queries must not write state, so expired authorizations are reported
+	// rather than revoked
+	authorization, expiration := k.GetAuthorization(ctx, params.Grantee, params.Granter, params.MsgType)
+	if authorization == nil {
+		return nil, sdkerrors.Wrap(types.ErrNoAuthorizationFound, params.MsgType)
+	}
+
+	if !expiration.After(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(types.ErrAuthorizationNotActive, "%s expired at %s", params.MsgType, expiration)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, authorization)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryAuthorizations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryAuthorizationsParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	authorizations := k.GetAuthorizations(ctx, params.Grantee, params.Granter)
+	if authorizations == nil {
+		authorizations = []types.Authorization{}
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, authorizations)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
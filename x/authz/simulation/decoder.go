@@ -0,0 +1,28 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding authz type.
+func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB tmkv.Pair) string {
+	return func(kvA, kvB tmkv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.GrantKeyPrefix):
+			var grantA, grantB types.AuthorizationGrant
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &grantA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &grantB)
+			return fmt.Sprintf("%v\n%v", grantA, grantB)
+
+		default:
+			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
+		}
+	}
+}
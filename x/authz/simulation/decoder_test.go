@@ -0,0 +1,61 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/simulation"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+var (
+	granterAddr = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	granteeAddr = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+func TestDecodeStore(t *testing.T) {
+	appCodec, _ := simapp.MakeCodecs()
+	dec := simulation.NewDecodeStore(appCodec)
+
+	authorization := types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	grant, err := types.NewAuthorizationGrant(authorization, time.Now().UTC())
+	require.NoError(t, err)
+
+	grantBz, err := appCodec.MarshalBinaryBare(&grant)
+	require.NoError(t, err)
+
+	var decoded types.AuthorizationGrant
+	appCodec.MustUnmarshalBinaryBare(grantBz, &decoded)
+
+	kvPairs := tmkv.Pairs{
+		tmkv.Pair{Key: types.GrantStoreKey(granteeAddr, granterAddr, authorization.MsgType()), Value: grantBz},
+		tmkv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Grant", fmt.Sprintf("%v\n%v", decoded, decoded)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs[i], kvPairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs[i], kvPairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Simulation parameter constants
+const authorizations = "authorizations"
+
+// GenAuthorizations returns a random set of authorization grants between the
+// simulation accounts, expiring up to a year after the genesis time. Each
+// account grants at most one authorization.
+func GenAuthorizations(r *rand.Rand, accs []simtypes.Account, genTime time.Time) []types.GrantAuthorization {
+	var grants []types.GrantAuthorization
+	if len(accs) < 2 {
+		return grants
+	}
+
+	for i, granter := range accs {
+		if r.Intn(2) == 0 {
+			continue
+		}
+
+		// pick any account but the granter
+		j := r.Intn(len(accs) - 1)
+		if j >= i {
+			j++
+		}
+
+		var authorization types.Authorization
+		if r.Intn(2) == 0 {
+			spendLimit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simtypes.RandIntBetween(r, 1, 1000))))
+			authorization = types.NewSendAuthorization(spendLimit)
+		} else {
+			authorization = types.NewGenericAuthorization(types.MsgTypeURL(&banktypes.MsgMultiSend{}))
+		}
+
+		expiration := genTime.Add(time.Duration(simtypes.RandIntBetween(r, 1, 365*24)) * time.Hour).UTC()
+		grants = append(grants, types.GrantAuthorization{
+			Granter:       granter.Address,
+			Grantee:       accs[j].Address,
+			Authorization: authorization,
+			Expiration:    expiration,
+		})
+	}
+
+	return grants
+}
+
+// RandomizedGenState generates a random GenesisState for authz
+func RandomizedGenState(simState *module.SimulationState) {
+	var grants []types.GrantAuthorization
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, authorizations, &grants, simState.Rand,
+		func(r *rand.Rand) { grants = GenAuthorizations(r, simState.Accounts, simState.GenTimestamp) },
+	)
+
+	authzGenesis := types.NewGenesisState(grants)
+
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, codec.MustMarshalJSONIndent(simState.Cdc, authzGenesis))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(authzGenesis)
+}
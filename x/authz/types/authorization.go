@@ -0,0 +1,33 @@
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Authorization represents the interface of various Authorization types
+// that a granter can grant to a grantee.
+type Authorization interface {
+	proto.Message
+
+	// MsgType returns the type URL of the message this authorization applies
+	// to, as computed by MsgTypeURL.
+	MsgType() string
+
+	// Accept determines whether this grant permits the provided sdk.Msg to be
+	// performed, and if so provides an updated authorization instance, or nil
+	// if the authorization is left unchanged. If delete is true, the
+	// authorization is exhausted and should be removed from the store.
+	Accept(msg sdk.Msg, block abci.Header) (updated Authorization, delete bool, err error)
+
+	// ValidateBasic performs stateless validation of the authorization.
+	ValidateBasic() error
+}
+
+// MsgTypeURL returns the type URL of the provided sdk.Msg, which is the fully
+// qualified name of its Protobuf message prefixed with "/".
+func MsgTypeURL(msg sdk.Msg) string {
+	return "/" + proto.MessageName(msg)
+}
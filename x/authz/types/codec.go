@@ -0,0 +1,62 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterCodec registers all the necessary types and interfaces for the
+// authz module.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterInterface((*Authorization)(nil), nil)
+	cdc.RegisterConcrete(&MsgGrantAuthorization{}, "cosmos-sdk/MsgGrantAuthorization", nil)
+	cdc.RegisterConcrete(&MsgRevokeAuthorization{}, "cosmos-sdk/MsgRevokeAuthorization", nil)
+	cdc.RegisterConcrete(&MsgExecAuthorized{}, "cosmos-sdk/MsgExecAuthorized", nil)
+	cdc.RegisterConcrete(&SendAuthorization{}, "cosmos-sdk/SendAuthorization", nil)
+	cdc.RegisterConcrete(&GenericAuthorization{}, "cosmos-sdk/GenericAuthorization", nil)
+}
+
+// RegisterInterfaces registers the authz module's interface types and
+// implementations with the provided registry.
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgGrantAuthorization{},
+		&MsgRevokeAuthorization{},
+		&MsgExecAuthorized{},
+	)
+	registry.RegisterInterface(
+		"cosmos_sdk.authz.v1.Authorization",
+		(*Authorization)(nil),
+		&SendAuthorization{},
+		&GenericAuthorization{},
+	)
+}
+
+// RegisterAuthorizationTypeCodec registers an external authorization type
+// defined in another module for the internal ModuleCdc. This allows the
+// MsgGrantAuthorization to be correctly Amino encoded and decoded.
+//
+// NOTE: This should only be used for applications that are still using a concrete
+// Amino codec for serialization.
+func RegisterAuthorizationTypeCodec(o interface{}, name string) {
+	amino.RegisterConcrete(o, name, nil)
+}
+
+var (
+	amino = codec.New()
+
+	// ModuleCdc references the global x/authz module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding as Amino is
+	// still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/authz and
+	// defined at the application level.
+	ModuleCdc = codec.NewHybridCodec(amino, types.NewInterfaceRegistry())
+)
+
+func init() {
+	RegisterCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/authz module sentinel errors
+var (
+	ErrInvalidAuthorization   = sdkerrors.Register(ModuleName, 2, "invalid authorization")
+	ErrNoAuthorizationFound   = sdkerrors.Register(ModuleName, 3, "authorization not found")
+	ErrInvalidExpirationTime  = sdkerrors.Register(ModuleName, 4, "expiration time of authorization should be more than current time")
+	ErrUnauthorized           = sdkerrors.Register(ModuleName, 5, "authorization does not permit the message")
+	ErrAuthorizationNotActive = sdkerrors.Register(ModuleName, 6, "authorization has expired")
+)
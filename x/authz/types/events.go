@@ -0,0 +1,13 @@
+package types
+
+// authz module events
+const (
+	EventTypeGrantAuthorization  = "grant_authorization"
+	EventTypeRevokeAuthorization = "revoke_authorization"
+	EventTypeExecAuthorized      = "exec_authorized"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyGranter    = "granter"
+	AttributeKeyGrantee    = "grantee"
+	AttributeKeyMsgType    = "msg_type"
+)
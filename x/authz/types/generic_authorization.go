@@ -0,0 +1,44 @@
+package types
+
+import (
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ Authorization = &GenericAuthorization{}
+
+// NewGenericAuthorization creates a new GenericAuthorization object for the
+// provided message type URL.
+func NewGenericAuthorization(msgType string) *GenericAuthorization {
+	return &GenericAuthorization{
+		Msg: msgType,
+	}
+}
+
+// MsgType implements Authorization.
+func (authorization GenericAuthorization) MsgType() string {
+	return authorization.Msg
+}
+
+// Accept implements Authorization. A generic authorization accepts any message
+// of its type and is never exhausted, so it is never updated either.
+func (authorization GenericAuthorization) Accept(msg sdk.Msg, block abci.Header) (Authorization, bool, error) {
+	if MsgTypeURL(msg) != authorization.MsgType() {
+		return nil, false, sdkerrors.Wrapf(ErrUnauthorized, "type mismatch: expected %s, got %s", authorization.MsgType(), MsgTypeURL(msg))
+	}
+
+	return nil, false, nil
+}
+
+// ValidateBasic implements Authorization.
+func (authorization GenericAuthorization) ValidateBasic() error {
+	if !strings.HasPrefix(authorization.MsgType(), "/") || len(authorization.MsgType()) == 1 {
+		return sdkerrors.Wrapf(ErrInvalidAuthorization, "invalid message type URL %q", authorization.MsgType())
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GrantAuthorization defines a single authorization grant as represented in
+// the authz module's genesis state.
+type GrantAuthorization struct {
+	Granter       sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee       sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Authorization Authorization  `json:"authorization" yaml:"authorization"`
+	Expiration    time.Time      `json:"expiration" yaml:"expiration"`
+}
+
+// GenesisState defines the authz module's genesis state.
+type GenesisState struct {
+	Authorizations []GrantAuthorization `json:"authorizations" yaml:"authorizations"`
+}
+
+// NewGenesisState creates a new GenesisState object.
+func NewGenesisState(authorizations []GrantAuthorization) GenesisState {
+	return GenesisState{
+		Authorizations: authorizations,
+	}
+}
+
+// DefaultGenesisState returns the authz module's default genesis state.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Authorizations: []GrantAuthorization{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.Authorizations))
+	for i, a := range gs.Authorizations {
+		if a.Granter.Empty() || a.Grantee.Empty() {
+			return fmt.Errorf("authorization %d: granter and grantee cannot be empty", i)
+		}
+		if a.Authorization == nil {
+			return fmt.Errorf("authorization %d cannot be nil", i)
+		}
+		if err := a.Authorization.ValidateBasic(); err != nil {
+			return fmt.Errorf("authorization %d: %w", i, err)
+		}
+		if a.Expiration.IsZero() {
+			return fmt.Errorf("authorization %d: %w", i, ErrInvalidExpirationTime)
+		}
+
+		// grants are stored by granter, grantee and message type, so a
+		// duplicate would silently overwrite the previous grant
+		key := string(GrantStoreKey(a.Grantee, a.Granter, a.Authorization.MsgType()))
+		if seen[key] {
+			return fmt.Errorf(
+				"authorization %d: duplicate grant from %s to %s for %s",
+				i, a.Granter, a.Grantee, a.Authorization.MsgType(),
+			)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
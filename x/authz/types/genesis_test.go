@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	expiration := time.Now().UTC().Add(time.Hour)
+	send := GrantAuthorization{granter, grantee, NewSendAuthorization(coins), expiration}
+	generic := GrantAuthorization{granter, grantee, NewGenericAuthorization(MsgTypeURL(&banktypes.MsgMultiSend{})), expiration}
+
+	cases := []struct {
+		name           string
+		authorizations []GrantAuthorization
+		valid          bool
+	}{
+		{"default", DefaultGenesisState().Authorizations, true},
+		{"distinct message types", []GrantAuthorization{send, generic}, true},
+		{"same message type for another grantee", []GrantAuthorization{
+			send, {granter, sdk.AccAddress([]byte("other_grantee_______")), NewSendAuthorization(coins), expiration},
+		}, true},
+		{"empty granter", []GrantAuthorization{{nil, grantee, NewSendAuthorization(coins), expiration}}, false},
+		{"nil authorization", []GrantAuthorization{{granter, grantee, nil, expiration}}, false},
+		{"invalid authorization", []GrantAuthorization{{granter, grantee, NewSendAuthorization(nil), expiration}}, false},
+		{"missing expiration", []GrantAuthorization{{granter, grantee, NewSendAuthorization(coins), time.Time{}}}, false},
+		{"duplicate grant", []GrantAuthorization{send, generic, send}, false},
+	}
+
+	for _, tc := range cases {
+		err := NewGenesisState(tc.authorizations).Validate()
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ types.UnpackInterfacesMessage = AuthorizationGrant{}
+
+// NewAuthorizationGrant returns a new AuthorizationGrant wrapping the provided
+// Authorization.
+func NewAuthorizationGrant(authorization Authorization, expiration time.Time) (AuthorizationGrant, error) {
+	any, err := types.NewAnyWithValue(authorization)
+	if err != nil {
+		return AuthorizationGrant{}, err
+	}
+
+	return AuthorizationGrant{
+		Authorization: any,
+		Expiration:    expiration,
+	}, nil
+}
+
+// GetAuthorization returns the cached Authorization of the grant or nil if it
+// has not been unpacked.
+func (g AuthorizationGrant) GetAuthorization() Authorization {
+	authorization, ok := g.Authorization.GetCachedValue().(Authorization)
+	if !ok {
+		return nil
+	}
+	return authorization
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g AuthorizationGrant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(g.Authorization, &authorization)
+}
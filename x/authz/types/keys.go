@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "authz"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	GrantKeyPrefix = []byte{0x01}
+)
+
+// GrantStoreKey returns the store key of an authorization grant for the given
+// granter, grantee and message type:
+// 0x01<granter_address_bytes><grantee_address_bytes><msg_type_bytes>
+func GrantStoreKey(grantee, granter sdk.AccAddress, msgType string) []byte {
+	return append(append(append(GrantKeyPrefix, granter.Bytes()...), grantee.Bytes()...), []byte(msgType)...)
+}
+
+// SplitGrantStoreKey splits an authorization grant store key into the granter
+// and grantee addresses and the message type.
+func SplitGrantStoreKey(key []byte) (granter, grantee sdk.AccAddress, msgType string) {
+	key = key[len(GrantKeyPrefix):]
+	granter = sdk.AccAddress(key[:sdk.AddrLen])
+	grantee = sdk.AccAddress(key[sdk.AddrLen : 2*sdk.AddrLen])
+	msgType = string(key[2*sdk.AddrLen:])
+
+	return granter, grantee, msgType
+}
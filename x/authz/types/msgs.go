@@ -0,0 +1,254 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// authz message types
+const (
+	TypeMsgGrantAuthorization  = "grant_authorization"
+	TypeMsgRevokeAuthorization = "revoke_authorization"
+	TypeMsgExecAuthorized      = "exec_authorized"
+)
+
+var (
+	_ sdk.Msg                       = &MsgGrantAuthorization{}
+	_ sdk.Msg                       = &MsgRevokeAuthorization{}
+	_ sdk.Msg                       = &MsgExecAuthorized{}
+	_ types.UnpackInterfacesMessage = MsgGrantAuthorization{}
+	_ types.UnpackInterfacesMessage = MsgExecAuthorized{}
+)
+
+// NewMsgGrantAuthorization creates a new MsgGrantAuthorization.
+func NewMsgGrantAuthorization(
+	granter, grantee sdk.AccAddress, authorization Authorization, expiration time.Time,
+) (*MsgGrantAuthorization, error) {
+	any, err := types.NewAnyWithValue(authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgGrantAuthorization{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: any,
+		Expiration:    expiration,
+	}, nil
+}
+
+// Route returns the MsgGrantAuthorization's route.
+func (msg MsgGrantAuthorization) Route() string { return RouterKey }
+
+// Type returns the MsgGrantAuthorization's type.
+func (msg MsgGrantAuthorization) Type() string { return TypeMsgGrantAuthorization }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a
+// MsgGrantAuthorization.
+func (msg MsgGrantAuthorization) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if msg.Granter.Equals(msg.Grantee) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "granter and grantee cannot be the same")
+	}
+	if msg.Expiration.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidExpirationTime, "missing expiration time")
+	}
+
+	authorization := msg.GetAuthorization()
+	if authorization == nil {
+		return sdkerrors.Wrap(ErrInvalidAuthorization, "missing authorization")
+	}
+
+	return authorization.ValidateBasic()
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when
+// submitting a MsgGrantAuthorization message.
+func (msg MsgGrantAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the single expected signer for a MsgGrantAuthorization.
+func (msg MsgGrantAuthorization) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// GetAuthorization returns the cached Authorization of the message or nil if
+// it has not been unpacked.
+func (msg MsgGrantAuthorization) GetAuthorization() Authorization {
+	authorization, ok := msg.Authorization.GetCachedValue().(Authorization)
+	if !ok {
+		return nil
+	}
+	return authorization
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrantAuthorization) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(msg.Authorization, &authorization)
+}
+
+// NewMsgRevokeAuthorization creates a new MsgRevokeAuthorization.
+func NewMsgRevokeAuthorization(granter, grantee sdk.AccAddress, authorizationMsgType string) *MsgRevokeAuthorization {
+	return &MsgRevokeAuthorization{
+		Granter:              granter,
+		Grantee:              grantee,
+		AuthorizationMsgType: authorizationMsgType,
+	}
+}
+
+// Route returns the MsgRevokeAuthorization's route.
+func (msg MsgRevokeAuthorization) Route() string { return RouterKey }
+
+// Type returns the MsgRevokeAuthorization's type.
+func (msg MsgRevokeAuthorization) Type() string { return TypeMsgRevokeAuthorization }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a
+// MsgRevokeAuthorization.
+func (msg MsgRevokeAuthorization) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if msg.AuthorizationMsgType == "" {
+		return sdkerrors.Wrap(ErrInvalidAuthorization, "missing authorization message type")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when
+// submitting a MsgRevokeAuthorization message.
+func (msg MsgRevokeAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the single expected signer for a MsgRevokeAuthorization.
+func (msg MsgRevokeAuthorization) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// NewMsgExecAuthorized creates a new MsgExecAuthorized wrapping the provided
+// messages.
+func NewMsgExecAuthorized(grantee sdk.AccAddress, msgs []sdk.Msg) (*MsgExecAuthorized, error) {
+	anys := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := types.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+
+	return &MsgExecAuthorized{
+		Grantee: grantee,
+		Msgs:    anys,
+	}, nil
+}
+
+// Route returns the MsgExecAuthorized's route.
+func (msg MsgExecAuthorized) Route() string { return RouterKey }
+
+// Type returns the MsgExecAuthorized's type.
+func (msg MsgExecAuthorized) Type() string { return TypeMsgExecAuthorized }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a
+// MsgExecAuthorized and each of its wrapped messages.
+func (msg MsgExecAuthorized) ValidateBasic() error {
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if len(msg.Msgs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "messages cannot be empty")
+	}
+
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	for _, m := range msgs {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when
+// submitting a MsgExecAuthorized message. The wrapped messages contribute
+// their own sign bytes so that their types need not be registered with the
+// authz module codec.
+func (msg MsgExecAuthorized) GetSignBytes() []byte {
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		panic(err)
+	}
+
+	msgsBytes := make([]json.RawMessage, 0, len(msgs))
+	for _, m := range msgs {
+		msgsBytes = append(msgsBytes, json.RawMessage(m.GetSignBytes()))
+	}
+
+	bz, err := ModuleCdc.MarshalJSON(execSignDoc{
+		Grantee: msg.Grantee,
+		Msgs:    msgsBytes,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the single expected signer for a MsgExecAuthorized.
+func (msg MsgExecAuthorized) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Grantee}
+}
+
+// GetMsgs returns the cached sdk.Msgs wrapped by the MsgExecAuthorized. An
+// error is returned if any of them has not been unpacked.
+func (msg MsgExecAuthorized) GetMsgs() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, any := range msg.Msgs {
+		m, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "message %d cannot be unpacked into %T", i, (*sdk.Msg)(nil))
+		}
+		msgs[i] = m
+	}
+
+	return msgs, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgExecAuthorized) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, any := range msg.Msgs {
+		var m sdk.Msg
+		if err := unpacker.UnpackAny(any, &m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// execSignDoc defines the structure signed by the grantee of a
+// MsgExecAuthorized.
+type execSignDoc struct {
+	Grantee sdk.AccAddress    `json:"grantee" yaml:"grantee"`
+	Msgs    []json.RawMessage `json:"msgs" yaml:"msgs"`
+}
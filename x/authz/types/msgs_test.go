@@ -0,0 +1,103 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	granter = sdk.AccAddress([]byte("granter_____________"))
+	grantee = sdk.AccAddress([]byte("grantee_____________"))
+	coins   = sdk.NewCoins(sdk.NewInt64Coin("steak", 10))
+)
+
+func TestMsgGrantAuthorization(t *testing.T) {
+	var emptyAddr sdk.AccAddress
+	expiration := time.Now().UTC().Add(time.Hour)
+
+	cases := []struct {
+		granter, grantee sdk.AccAddress
+		authorization    Authorization
+		expiration       time.Time
+		valid            bool
+	}{
+		{granter, grantee, NewSendAuthorization(coins), expiration, true},
+		{granter, grantee, NewGenericAuthorization(MsgTypeURL(&banktypes.MsgSend{})), expiration, true},
+		{emptyAddr, grantee, NewSendAuthorization(coins), expiration, false},
+		{granter, emptyAddr, NewSendAuthorization(coins), expiration, false},
+		{granter, granter, NewSendAuthorization(coins), expiration, false},
+		{granter, grantee, NewSendAuthorization(coins), time.Time{}, false},
+		{granter, grantee, NewSendAuthorization(nil), expiration, false},
+		{granter, grantee, NewGenericAuthorization("send"), expiration, false},
+	}
+
+	for i, tc := range cases {
+		msg, err := NewMsgGrantAuthorization(tc.granter, tc.grantee, tc.authorization, tc.expiration)
+		require.NoError(t, err)
+		require.Equal(t, RouterKey, msg.Route())
+		require.Equal(t, TypeMsgGrantAuthorization, msg.Type())
+
+		if tc.valid {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+			require.Equal(t, []sdk.AccAddress{tc.granter}, msg.GetSigners())
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgRevokeAuthorization(t *testing.T) {
+	var emptyAddr sdk.AccAddress
+	msgType := MsgTypeURL(&banktypes.MsgSend{})
+
+	cases := []struct {
+		granter, grantee sdk.AccAddress
+		msgType          string
+		valid            bool
+	}{
+		{granter, grantee, msgType, true},
+		{emptyAddr, grantee, msgType, false},
+		{granter, emptyAddr, msgType, false},
+		{granter, grantee, "", false},
+	}
+
+	for i, tc := range cases {
+		msg := NewMsgRevokeAuthorization(tc.granter, tc.grantee, tc.msgType)
+		require.Equal(t, RouterKey, msg.Route())
+		require.Equal(t, TypeMsgRevokeAuthorization, msg.Type())
+
+		if tc.valid {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+			require.Equal(t, []sdk.AccAddress{tc.granter}, msg.GetSigners())
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgExecAuthorized(t *testing.T) {
+	send := banktypes.NewMsgSend(granter, grantee, coins)
+
+	msg, err := NewMsgExecAuthorized(grantee, []sdk.Msg{send})
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{grantee}, msg.GetSigners())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+
+	msgs, err := msg.GetMsgs()
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{send}, msgs)
+
+	msg, err = NewMsgExecAuthorized(grantee, nil)
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+
+	msg, err = NewMsgExecAuthorized(nil, []sdk.Msg{send})
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Querier routes for the authz module
+const (
+	QueryAuthorization  = "authorization"
+	QueryAuthorizations = "authorizations"
+)
+
+// QueryAuthorizationParams defines the parameters necessary for querying a
+// single authorization granted for a message type.
+type QueryAuthorizationParams struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	MsgType string         `json:"msg_type" yaml:"msg_type"`
+}
+
+func NewQueryAuthorizationParams(granter, grantee sdk.AccAddress, msgType string) QueryAuthorizationParams {
+	return QueryAuthorizationParams{Granter: granter, Grantee: grantee, MsgType: msgType}
+}
+
+// QueryAuthorizationsParams defines the parameters necessary for querying all
+// authorizations a granter has granted to a grantee.
+type QueryAuthorizationsParams struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+func NewQueryAuthorizationsParams(granter, grantee sdk.AccAddress) QueryAuthorizationsParams {
+	return QueryAuthorizationsParams{Granter: granter, Grantee: grantee}
+}
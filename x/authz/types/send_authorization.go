@@ -0,0 +1,53 @@
+package types
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var _ Authorization = &SendAuthorization{}
+
+// NewSendAuthorization creates a new SendAuthorization object.
+func NewSendAuthorization(spendLimit sdk.Coins) *SendAuthorization {
+	return &SendAuthorization{
+		SpendLimit: spendLimit,
+	}
+}
+
+// MsgType implements Authorization.
+func (authorization SendAuthorization) MsgType() string {
+	return MsgTypeURL(&banktypes.MsgSend{})
+}
+
+// Accept implements Authorization. It deducts the sent amount from the spend
+// limit and deletes the authorization once the limit is fully spent.
+func (authorization SendAuthorization) Accept(msg sdk.Msg, block abci.Header) (Authorization, bool, error) {
+	msgSend, ok := msg.(*banktypes.MsgSend)
+	if !ok {
+		return nil, false, sdkerrors.Wrapf(ErrUnauthorized, "type mismatch: expected %s, got %T", authorization.MsgType(), msg)
+	}
+
+	limitLeft, isNegative := authorization.SpendLimit.SafeSub(msgSend.Amount)
+	if isNegative {
+		return nil, false, sdkerrors.Wrapf(
+			ErrUnauthorized, "requested amount %s is more than spend limit %s", msgSend.Amount, authorization.SpendLimit,
+		)
+	}
+	if limitLeft.IsZero() {
+		return nil, true, nil
+	}
+
+	return NewSendAuthorization(limitLeft), false, nil
+}
+
+// ValidateBasic implements Authorization.
+func (authorization SendAuthorization) ValidateBasic() error {
+	if !authorization.SpendLimit.IsValid() || authorization.SpendLimit.Empty() {
+		return sdkerrors.Wrapf(ErrInvalidAuthorization, "invalid spend limit %s", authorization.SpendLimit)
+	}
+
+	return nil
+}
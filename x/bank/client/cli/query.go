@@ -40,7 +40,9 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 	cmd.AddCommand(
 		GetBalancesCmd(cdc),
+		GetSpendableBalancesCmd(cdc),
 		GetCmdQueryTotalSupply(cdc),
+		GetCmdQueryDenomMetadata(cdc),
 	)
 
 	return cmd
@@ -72,7 +74,7 @@ func GetBalancesCmd(cdc *codec.Codec) *cobra.Command {
 
 			denom := viper.GetString(flagDenom)
 			if denom == "" {
-				params = types.NewQueryAllBalancesRequest(addr)
+				params = types.NewQueryAllBalancesRequest(addr, nil)
 				route = fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllBalances)
 			} else {
 				params = types.NewQueryBalanceRequest(addr, denom)
@@ -114,6 +116,49 @@ func GetBalancesCmd(cdc *codec.Codec) *cobra.Command {
 	return flags.GetCommands(cmd)[0]
 }
 
+// GetSpendableBalancesCmd returns a CLI command handler that facilitates
+// querying for the spendable balances of an account, i.e. its balances minus
+// the coins locked by vesting.
+//
+// TODO: Remove once client-side Protobuf migration has been completed.
+// ref: https://github.com/cosmos/cosmos-sdk/issues/5864
+func GetSpendableBalancesCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spendable-balances [address]",
+		Short: "Query for the spendable balances of an account by address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQuerySpendableBalancesRequest(addr, nil)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return fmt.Errorf("failed to marshal params: %w", err)
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySpendableBalances)
+			res, _, err := clientCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var balances sdk.Coins
+			if err := cdc.UnmarshalJSON(res, &balances); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(balances)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
 // TODO: Remove once client-side Protobuf migration has been completed.
 // ref: https://github.com/cosmos/cosmos-sdk/issues/5864
 func GetCmdQueryTotalSupply(cdc *codec.Codec) *cobra.Command {
@@ -147,3 +192,36 @@ $ %s query %s total stake
 
 	return flags.GetCommands(cmd)[0]
 }
+
+// TODO: Remove once client-side Protobuf migration has been completed.
+// ref: https://github.com/cosmos/cosmos-sdk/issues/5864
+func GetCmdQueryDenomMetadata(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-metadata [denom]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the client metadata of coin denominations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the client metadata for all the registered coin denominations.
+
+Example:
+$ %s query %s denom-metadata
+
+To query for the client metadata of a specific coin denomination use:
+$ %s query %s denom-metadata uatom
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc)
+
+			if len(args) == 0 {
+				return queryDenomsMetadata(clientCtx, cdc)
+			}
+
+			return queryDenomMetadata(clientCtx, cdc, args[0])
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
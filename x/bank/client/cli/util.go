@@ -50,3 +50,36 @@ func querySupplyOf(clientCtx client.Context, cdc *codec.Codec, denom string) err
 
 	return clientCtx.PrintOutput(supply)
 }
+
+func queryDenomsMetadata(clientCtx client.Context, cdc *codec.Codec) error {
+	res, _, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDenomsMetadata), nil)
+	if err != nil {
+		return err
+	}
+
+	var metadatas []types.Metadata
+	if err := cdc.UnmarshalJSON(res, &metadatas); err != nil {
+		return err
+	}
+
+	return clientCtx.PrintOutput(metadatas)
+}
+
+func queryDenomMetadata(clientCtx client.Context, cdc *codec.Codec, denom string) error {
+	bz, err := cdc.MarshalJSON(types.NewQueryDenomMetadataRequest(denom))
+	if err != nil {
+		return err
+	}
+
+	res, _, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDenomMetadata), bz)
+	if err != nil {
+		return err
+	}
+
+	var metadata types.Metadata
+	if err := cdc.UnmarshalJSON(res, &metadata); err != nil {
+		return err
+	}
+
+	return clientCtx.PrintOutput(metadata)
+}
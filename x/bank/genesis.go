@@ -11,6 +11,7 @@ import (
 // InitGenesis initializes the bank module's state from a given genesis state.
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, genState types.GenesisState) {
 	keeper.SetSendEnabled(ctx, genState.SendEnabled)
+	keeper.SetSendEnabledDenoms(ctx, genState.SendEnabledDenoms)
 
 	var totalSupply sdk.Coins
 
@@ -32,6 +33,10 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, genState types.GenesisSt
 	}
 
 	keeper.SetSupply(ctx, types.NewSupply(genState.Supply))
+
+	for _, metadata := range genState.DenomMetadata {
+		keeper.SetDenomMetadata(ctx, metadata)
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -57,11 +62,19 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
 		})
 	}
 
-	return types.NewGenesisState(keeper.GetSendEnabled(ctx), balances, keeper.GetSupply(ctx).GetTotal())
+	denomMetadata := []types.Metadata{}
+	keeper.IterateAllDenomMetadata(ctx, func(metadata types.Metadata) bool {
+		denomMetadata = append(denomMetadata, metadata)
+		return false
+	})
+
+	return types.NewGenesisState(
+		keeper.GetSendEnabled(ctx), keeper.GetSendEnabledDenoms(ctx), balances, keeper.GetSupply(ctx).GetTotal(), denomMetadata,
+	)
 }
 
-// ValidateGenesis performs basic validation of supply genesis data returning an
+// ValidateGenesis performs basic validation of bank genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data types.GenesisState) error {
-	return types.NewSupply(data.Supply).ValidateBasic()
+	return data.Validate()
 }
@@ -6,7 +6,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -43,9 +45,46 @@ func (q BaseKeeper) AllBalances(c context.Context, req *types.QueryAllBalancesRe
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	balances := q.GetAllBalances(ctx, req.Address)
+	balances, pageRes, err := q.paginateBalances(ctx, req.Address, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryAllBalancesResponse{Balances: balances, Pagination: pageRes}, nil
+}
+
+// SpendableBalances implements the Query/SpendableBalances gRPC method. The
+// balances are paginated by denomination and each of them is reduced by the
+// amount of the denomination locked by vesting. Coins delegated from a vesting
+// account are not part of its balance and are thus never reported as spendable.
+func (q BaseKeeper) SpendableBalances(c context.Context, req *types.QuerySpendableBalancesRequest) (*types.QuerySpendableBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Address) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address")
+	}
 
-	return &types.QueryAllBalancesResponse{Balances: balances}, nil
+	ctx := sdk.UnwrapSDKContext(c)
+	balances, pageRes, err := q.paginateBalances(ctx, req.Address, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	locked := q.LockedCoins(ctx, req.Address)
+	spendable := make(sdk.Coins, len(balances))
+
+	for i, balance := range balances {
+		amount := balance.Amount.Sub(locked.AmountOf(balance.Denom))
+		if amount.IsNegative() {
+			amount = sdk.ZeroInt()
+		}
+
+		spendable[i] = sdk.NewCoin(balance.Denom, amount)
+	}
+
+	return &types.QuerySpendableBalancesResponse{Balances: spendable, Pagination: pageRes}, nil
 }
 
 // TotalSupply implements the Query/TotalSupply gRPC method
@@ -71,3 +110,74 @@ func (q BaseKeeper) SupplyOf(c context.Context, req *types.QuerySupplyOfRequest)
 
 	return &types.QuerySupplyOfResponse{Amount: supply}, nil
 }
+
+// DenomMetadata implements the Query/DenomMetadata gRPC method
+func (q BaseKeeper) DenomMetadata(c context.Context, req *types.QueryDenomMetadataRequest) (*types.QueryDenomMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	metadata, found := q.GetDenomMetadata(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no metadata registered for denom %s", req.Denom)
+	}
+
+	return &types.QueryDenomMetadataResponse{Metadata: metadata}, nil
+}
+
+// DenomsMetadata implements the Query/DenomsMetadata gRPC method
+func (q BaseKeeper) DenomsMetadata(c context.Context, req *types.QueryDenomsMetadataRequest) (*types.QueryDenomsMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.DenomMetadataPrefix)
+
+	metadatas := []types.Metadata{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var metadata types.Metadata
+		if err := q.cdc.UnmarshalBinaryBare(value, &metadata); err != nil {
+			return err
+		}
+
+		metadatas = append(metadatas, metadata)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryDenomsMetadataResponse{Metadatas: metadatas, Pagination: pageRes}, nil
+}
+
+// paginateBalances returns the page of an account's balances selected by
+// pageReq, in denomination order.
+func (q BaseKeeper) paginateBalances(
+	ctx sdk.Context, addr sdk.AccAddress, pageReq *query.PageRequest,
+) (sdk.Coins, *query.PageResponse, error) {
+	store := ctx.KVStore(q.storeKey)
+	balancesStore := prefix.NewStore(store, types.BalancesPrefix)
+	accountStore := prefix.NewStore(balancesStore, addr.Bytes())
+
+	balances := sdk.NewCoins()
+	pageRes, err := query.Paginate(accountStore, pageReq, func(_ []byte, value []byte) error {
+		var balance sdk.Coin
+		if err := q.cdc.UnmarshalBinaryBare(value, &balance); err != nil {
+			return err
+		}
+
+		balances = balances.Add(balance)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return balances, pageRes, nil
+}
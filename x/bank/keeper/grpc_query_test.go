@@ -2,10 +2,15 @@ package keeper_test
 
 import (
 	gocontext "context"
+	"time"
+
+	tmtime "github.com/tendermint/tendermint/types/time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -52,7 +57,7 @@ func (suite *IntegrationTestSuite) TestQueryAllBalances() {
 	_, err := queryClient.AllBalances(gocontext.Background(), &types.QueryAllBalancesRequest{})
 	suite.Require().Error(err)
 
-	req := types.NewQueryAllBalancesRequest(addr)
+	req := types.NewQueryAllBalancesRequest(addr, nil)
 	res, err := queryClient.AllBalances(gocontext.Background(), req)
 	suite.Require().NoError(err)
 	suite.Require().NotNil(res)
@@ -68,6 +73,79 @@ func (suite *IntegrationTestSuite) TestQueryAllBalances() {
 	suite.Require().NoError(err)
 	suite.Require().NotNil(res)
 	suite.True(res.Balances.IsEqual(origCoins))
+
+	pageReq := &query.PageRequest{Limit: 1, CountTotal: true}
+	req = types.NewQueryAllBalancesRequest(addr, pageReq)
+	res, err = queryClient.AllBalances(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(30)), res.Balances)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}
+	req = types.NewQueryAllBalancesRequest(addr, pageReq)
+	res, err = queryClient.AllBalances(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(50)), res.Balances)
+	suite.Require().Nil(res.Pagination.NextKey)
+}
+
+func (suite *IntegrationTestSuite) TestQuerySpendableBalances() {
+	app := suite.app
+	now := tmtime.Now()
+	ctx := suite.ctx.WithBlockTime(now.Add(12 * time.Hour))
+	_, _, addr := authtypes.KeyTestPubAddr()
+	addrModule := sdk.AccAddress([]byte("moduleAcc"))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx)
+	types.RegisterQueryServer(queryHelper, app.BankKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	_, err := queryClient.SpendableBalances(gocontext.Background(), &types.QuerySpendableBalancesRequest{})
+	suite.Require().Error(err)
+
+	// half of the vesting coins are still locked at the current block time
+	origCoins := sdk.NewCoins(newFooCoin(100), newBarCoin(30))
+	vestingCoins := sdk.NewCoins(newFooCoin(100))
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	vacc := vesting.NewContinuousVestingAccount(bacc, vestingCoins, now.Unix(), now.Add(24*time.Hour).Unix())
+
+	app.AccountKeeper.SetAccount(ctx, vacc)
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addrModule))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr, origCoins))
+
+	req := types.NewQuerySpendableBalancesRequest(addr, nil)
+	res, err := queryClient.SpendableBalances(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(30), newFooCoin(50)), res.Balances)
+
+	pageReq := &query.PageRequest{Limit: 1, CountTotal: true}
+	req = types.NewQuerySpendableBalancesRequest(addr, pageReq)
+	res, err = queryClient.SpendableBalances(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(30)), res.Balances)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}
+	req = types.NewQuerySpendableBalancesRequest(addr, pageReq)
+	res, err = queryClient.SpendableBalances(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(50)), res.Balances)
+
+	// delegating the locked coins leaves the spendable balance untouched
+	suite.Require().NoError(app.BankKeeper.DelegateCoins(ctx, addr, addrModule, sdk.NewCoins(newFooCoin(50))))
+
+	req = types.NewQuerySpendableBalancesRequest(addr, nil)
+	res, err = queryClient.SpendableBalances(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(30), newFooCoin(50)), res.Balances)
+
+	// delegating unlocked coins makes them unspendable
+	suite.Require().NoError(app.BankKeeper.DelegateCoins(ctx, addr, addrModule, sdk.NewCoins(newFooCoin(20))))
+
+	res, err = queryClient.SpendableBalances(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(30), newFooCoin(30)), res.Balances)
 }
 
 func (suite *IntegrationTestSuite) TestQueryTotalSupply() {
@@ -107,3 +185,37 @@ func (suite *IntegrationTestSuite) TestQueryTotalSupplyOf() {
 
 	suite.Require().Equal(test1Supply.Amount, res.Amount)
 }
+
+func (suite *IntegrationTestSuite) TestQueryDenomMetadata() {
+	app, ctx := suite.app, suite.ctx
+
+	metadata := types.Metadata{
+		Description: "The native staking token of the Cosmos Hub.",
+		DenomUnits: []*types.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+		Base:    "uatom",
+		Display: "atom",
+		Symbol:  "ATOM",
+	}
+	app.BankKeeper.SetDenomMetadata(ctx, metadata)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx)
+	types.RegisterQueryServer(queryHelper, app.BankKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	_, err := queryClient.DenomMetadata(gocontext.Background(), &types.QueryDenomMetadataRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.DenomMetadata(gocontext.Background(), &types.QueryDenomMetadataRequest{Denom: "unknown"})
+	suite.Require().Error(err)
+
+	res, err := queryClient.DenomMetadata(gocontext.Background(), &types.QueryDenomMetadataRequest{Denom: "uatom"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(metadata, res.Metadata)
+
+	allRes, err := queryClient.DenomsMetadata(gocontext.Background(), &types.QueryDenomsMetadataRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Metadata{metadata}, allRes.Metadatas)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -42,6 +43,10 @@ type Keeper interface {
 	MarshalSupplyJSON(supply exported.SupplyI) ([]byte, error)
 	UnmarshalSupplyJSON(bz []byte) (exported.SupplyI, error)
 
+	GetDenomMetadata(ctx sdk.Context, denom string) (types.Metadata, bool)
+	SetDenomMetadata(ctx sdk.Context, metadata types.Metadata)
+	IterateAllDenomMetadata(ctx sdk.Context, cb func(types.Metadata) bool)
+
 	types.QueryServer
 }
 
@@ -177,6 +182,46 @@ func (k BaseKeeper) SetSupply(ctx sdk.Context, supply exported.SupplyI) {
 	store.Set(types.SupplyKey, bz)
 }
 
+// GetDenomMetadata returns the metadata registered for the given base denom
+func (k BaseKeeper) GetDenomMetadata(ctx sdk.Context, denom string) (types.Metadata, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DenomMetadataKey(denom))
+	if bz == nil {
+		return types.Metadata{}, false
+	}
+
+	var metadata types.Metadata
+	k.cdc.MustUnmarshalBinaryBare(bz, &metadata)
+
+	return metadata, true
+}
+
+// SetDenomMetadata registers the metadata of its base denom, replacing any
+// existing metadata
+func (k BaseKeeper) SetDenomMetadata(ctx sdk.Context, metadata types.Metadata) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DenomMetadataKey(metadata.Base), k.cdc.MustMarshalBinaryBare(&metadata))
+}
+
+// IterateAllDenomMetadata iterates over the metadata of all denoms, ordered
+// by base denom, and calls cb on each of them. Iteration stops when cb returns
+// true.
+func (k BaseKeeper) IterateAllDenomMetadata(ctx sdk.Context, cb func(types.Metadata) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomMetadataPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.Metadata
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &metadata)
+
+		if cb(metadata) {
+			break
+		}
+	}
+}
+
 // SendCoinsFromModuleToAccount transfers coins from a ModuleAccount to an AccAddress.
 // It will panic if the module account does not exist.
 func (k BaseKeeper) SendCoinsFromModuleToAccount(
@@ -329,6 +374,7 @@ func (k BaseKeeper) trackDelegation(ctx sdk.Context, addr sdk.AccAddress, blockT
 	if ok {
 		// TODO: return error on account.TrackDelegation
 		vacc.TrackDelegation(blockTime, balance, amt)
+		k.ak.SetAccount(ctx, acc)
 	}
 
 	return nil
@@ -344,6 +390,7 @@ func (k BaseKeeper) trackUndelegation(ctx sdk.Context, addr sdk.AccAddress, amt
 	if ok {
 		// TODO: return error on account.TrackUndelegation
 		vacc.TrackUndelegation(amt)
+		k.ak.SetAccount(ctx, acc)
 	}
 
 	return nil
@@ -457,6 +457,69 @@ func (suite *IntegrationTestSuite) TestSendEnabled() {
 	suite.Require().Equal(enabled, app.BankKeeper.GetSendEnabled(ctx))
 }
 
+func (suite *IntegrationTestSuite) TestSendEnabledDenoms() {
+	app, ctx := suite.app, suite.ctx
+	app.BankKeeper.SetSendEnabled(ctx, true)
+
+	// denoms without an entry fall back to the default
+	suite.Require().True(app.BankKeeper.IsSendEnabledCoin(ctx, newFooCoin(1)))
+	suite.Require().NoError(app.BankKeeper.IsSendEnabledCoins(ctx, newFooCoin(1), newBarCoin(1)))
+
+	sendEnabled := []types.SendEnabled{types.NewSendEnabled(fooDenom, false)}
+	app.BankKeeper.SetSendEnabledDenoms(ctx, sendEnabled)
+	suite.Require().Equal(sendEnabled, app.BankKeeper.GetSendEnabledDenoms(ctx))
+
+	suite.Require().False(app.BankKeeper.IsSendEnabledCoin(ctx, newFooCoin(1)))
+	suite.Require().True(app.BankKeeper.IsSendEnabledCoin(ctx, newBarCoin(1)))
+	suite.Require().True(types.ErrSendDisabled.Is(app.BankKeeper.IsSendEnabledCoins(ctx, newBarCoin(1), newFooCoin(1))))
+
+	// an explicit entry overrides a disabled default
+	app.BankKeeper.SetSendEnabled(ctx, false)
+	app.BankKeeper.SetSendEnabledDenoms(ctx, []types.SendEnabled{types.NewSendEnabled(barDenom, true)})
+	suite.Require().False(app.BankKeeper.IsSendEnabledCoin(ctx, newFooCoin(1)))
+	suite.Require().True(app.BankKeeper.IsSendEnabledCoin(ctx, newBarCoin(1)))
+}
+
+func (suite *IntegrationTestSuite) TestDenomMetadata() {
+	app, ctx := suite.app, suite.ctx
+
+	_, found := app.BankKeeper.GetDenomMetadata(ctx, "uatom")
+	suite.Require().False(found)
+
+	metadata := []types.Metadata{
+		{
+			Description: "The native staking token of the Cosmos Hub.",
+			DenomUnits: []*types.DenomUnit{
+				{Denom: "uatom", Exponent: 0, Aliases: []string{"microatom"}},
+				{Denom: "atom", Exponent: 6},
+			},
+			Base:    "uatom",
+			Display: "atom",
+			Symbol:  "ATOM",
+		},
+		{
+			Description: "A token used for testing.",
+			DenomUnits:  []*types.DenomUnit{{Denom: "utest", Exponent: 0}},
+			Base:        "utest",
+			Display:     "utest",
+		},
+	}
+	for _, m := range metadata {
+		app.BankKeeper.SetDenomMetadata(ctx, m)
+	}
+
+	got, found := app.BankKeeper.GetDenomMetadata(ctx, "uatom")
+	suite.Require().True(found)
+	suite.Require().Equal(metadata[0], got)
+
+	var all []types.Metadata
+	app.BankKeeper.IterateAllDenomMetadata(ctx, func(m types.Metadata) bool {
+		all = append(all, m)
+		return false
+	})
+	suite.Require().Equal(metadata, all)
+}
+
 func (suite *IntegrationTestSuite) TestHasBalance() {
 	app, ctx := suite.app, suite.ctx
 	addr := sdk.AccAddress([]byte("addr1"))
@@ -517,9 +580,17 @@ func (suite *IntegrationTestSuite) TestMsgSendEvents() {
 	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr, addr2, newCoins))
 
 	events = ctx.EventManager().ABCIEvents()
-	suite.Require().Equal(4, len(events))
+	suite.Require().Equal(5, len(events))
 	suite.Require().Equal(abci.Event(event1), events[2])
 	suite.Require().Equal(abci.Event(event2), events[3])
+
+	typedEvent, err := sdk.ParseTypedEvent(events[4])
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.EventTransfer{
+		Sender:    addr.String(),
+		Recipient: addr2.String(),
+		Amount:    newCoins,
+	}, typedEvent)
 }
 
 func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
@@ -581,7 +652,7 @@ func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
 	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
 
 	events = ctx.EventManager().ABCIEvents()
-	suite.Require().Equal(5, len(events))
+	suite.Require().Equal(6, len(events))
 
 	event2 := sdk.Event{
 		Type:       sdk.EventTypeMessage,
@@ -619,6 +690,19 @@ func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
 	suite.Require().Equal(abci.Event(event2), events[2])
 	suite.Require().Equal(abci.Event(event3), events[3])
 	suite.Require().Equal(abci.Event(event4), events[4])
+
+	typedEvent, err := sdk.ParseTypedEvent(events[5])
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.EventMultiSend{
+		Inputs: []types.AddressCoins{
+			{Address: addr.String(), Coins: newCoins},
+			{Address: addr2.String(), Coins: newCoins2},
+		},
+		Outputs: []types.AddressCoins{
+			{Address: addr3.String(), Coins: newCoins},
+			{Address: addr4.String(), Coins: newCoins2},
+		},
+	}, typedEvent)
 }
 
 func (suite *IntegrationTestSuite) TestSpendableCoins() {
@@ -822,6 +906,11 @@ func (suite *IntegrationTestSuite) TestDelegateCoins() {
 	// require the ability for a vesting account to delegate
 	suite.Require().NoError(app.BankKeeper.DelegateCoins(ctx, addr1, addrModule, delCoins))
 	suite.Require().Equal(delCoins, app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	// require the delegation tracking of the vesting account to be persisted
+	vacc = app.AccountKeeper.GetAccount(ctx, addr1).(*vesting.ContinuousVestingAccount)
+	suite.Require().Equal(delCoins, vacc.GetDelegatedVesting())
+	suite.Require().True(vacc.GetDelegatedFree().Empty())
 }
 
 func (suite *IntegrationTestSuite) TestDelegateCoins_Invalid() {
@@ -894,11 +983,19 @@ func (suite *IntegrationTestSuite) TestUndelegateCoins() {
 	suite.Require().Equal(origCoins.Sub(delCoins), app.BankKeeper.GetAllBalances(ctx, addr1))
 	suite.Require().Equal(delCoins, app.BankKeeper.GetAllBalances(ctx, addrModule))
 
+	vacc = app.AccountKeeper.GetAccount(ctx, addr1).(*vesting.ContinuousVestingAccount)
+	suite.Require().Equal(delCoins, vacc.GetDelegatedVesting())
+
 	// require the ability for a vesting account to undelegate
 	suite.Require().NoError(app.BankKeeper.UndelegateCoins(ctx, addrModule, addr1, delCoins))
 
 	suite.Require().Equal(origCoins, app.BankKeeper.GetAllBalances(ctx, addr1))
 	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addrModule).Empty())
+
+	// require the undelegation tracking of the vesting account to be persisted
+	vacc = app.AccountKeeper.GetAccount(ctx, addr1).(*vesting.ContinuousVestingAccount)
+	suite.Require().True(vacc.GetDelegatedVesting().Empty())
+	suite.Require().True(vacc.GetDelegatedFree().Empty())
 }
 
 func (suite *IntegrationTestSuite) TestUndelegateCoins_Invalid() {
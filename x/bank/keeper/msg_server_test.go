@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *IntegrationTestSuite) TestMsgServiceRouterSend() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr1))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, balances))
+
+	router := baseapp.NewMsgServiceRouter()
+	types.RegisterMsgServer(router, keeper.NewMsgServerImpl(app.BankKeeper))
+
+	// registering the same service twice must fail
+	suite.Require().Panics(func() {
+		types.RegisterMsgServer(router, keeper.NewMsgServerImpl(app.BankKeeper))
+	})
+
+	msg := types.NewMsgSend(addr1, addr2, sdk.NewCoins(newFooCoin(50)))
+	handler := router.Handler(msg)
+	suite.Require().NotNil(handler)
+
+	res, err := handler(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(res.Events)
+
+	var sendRes types.MsgSendResponse
+	suite.Require().NoError(sendRes.Unmarshal(res.Data))
+
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(50), newBarCoin(50)), app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(50)), app.BankKeeper.GetAllBalances(ctx, addr2))
+
+	// insufficient funds surface as handler errors
+	_, err = handler(ctx, types.NewMsgSend(addr1, addr2, sdk.NewCoins(newFooCoin(100))))
+	suite.Require().Error(err)
+
+	// sends of a disabled denom are rejected
+	app.BankKeeper.SetSendEnabledDenoms(ctx, []types.SendEnabled{types.NewSendEnabled(fooDenom, false)})
+	_, err = handler(ctx, types.NewMsgSend(addr1, addr2, sdk.NewCoins(newFooCoin(1))))
+	suite.Require().True(types.ErrSendDisabled.Is(err))
+
+	_, err = handler(ctx, types.NewMsgSend(addr1, addr2, sdk.NewCoins(newBarCoin(1))))
+	suite.Require().NoError(err)
+
+	// Msgs without a registered service have no handler
+	suite.Require().Nil(baseapp.NewMsgServiceRouter().Handler(msg))
+}
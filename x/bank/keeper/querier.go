@@ -20,12 +20,21 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryAllBalances:
 			return queryAllBalance(ctx, req, k)
 
+		case types.QuerySpendableBalances:
+			return querySpendableBalances(ctx, req, k)
+
 		case types.QueryTotalSupply:
 			return queryTotalSupply(ctx, req, k)
 
 		case types.QuerySupplyOf:
 			return querySupplyOf(ctx, req, k)
 
+		case types.QueryDenomMetadata:
+			return queryDenomMetadata(ctx, req, k)
+
+		case types.QueryDenomsMetadata:
+			return queryDenomsMetadata(ctx, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
@@ -56,7 +65,17 @@ func queryAllBalance(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	balances := k.GetAllBalances(ctx, params.Address)
+	var balances sdk.Coins
+	if params.Pagination == nil {
+		balances = k.GetAllBalances(ctx, params.Address)
+	} else {
+		res, err := k.AllBalances(sdk.WrapSDKContext(ctx), &params)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		balances = res.Balances
+	}
 
 	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, balances)
 	if err != nil {
@@ -66,6 +85,26 @@ func queryAllBalance(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 	return bz, nil
 }
 
+func querySpendableBalances(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QuerySpendableBalancesRequest
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	res, err := k.SpendableBalances(sdk.WrapSDKContext(ctx), &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, res.Balances)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 func queryTotalSupply(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
 	var params types.QueryTotalSupplyParams
 
@@ -108,3 +147,38 @@ func querySupplyOf(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, er
 
 	return res, nil
 }
+
+func queryDenomMetadata(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDenomMetadataRequest
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	metadata, found := k.GetDenomMetadata(ctx, params.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "no metadata registered for denom %s", params.Denom)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, metadata)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryDenomsMetadata(ctx sdk.Context, k Keeper) ([]byte, error) {
+	metadatas := []types.Metadata{}
+	k.IterateAllDenomMetadata(ctx, func(metadata types.Metadata) bool {
+		metadatas = append(metadatas, metadata)
+		return false
+	})
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, metadatas)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
@@ -25,6 +25,10 @@ type SendKeeper interface {
 
 	GetSendEnabled(ctx sdk.Context) bool
 	SetSendEnabled(ctx sdk.Context, enabled bool)
+	GetSendEnabledDenoms(ctx sdk.Context) []types.SendEnabled
+	SetSendEnabledDenoms(ctx sdk.Context, sendEnabled []types.SendEnabled)
+	IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
+	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 
 	BlacklistedAddr(addr sdk.AccAddress) bool
 }
@@ -107,7 +111,7 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		}
 	}
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(newEventMultiSend(inputs, outputs))
 }
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
@@ -144,7 +148,11 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 		k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, toAddr))
 	}
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(&types.EventTransfer{
+		Sender:    fromAddr.String(),
+		Recipient: toAddr.String(),
+		Amount:    amt,
+	})
 }
 
 // SubtractCoins removes amt coins the account by the given address. An error is
@@ -252,7 +260,8 @@ func (k BaseSendKeeper) SetBalance(ctx sdk.Context, addr sdk.AccAddress, balance
 	return nil
 }
 
-// GetSendEnabled returns the current SendEnabled
+// GetSendEnabled returns the current SendEnabled, which is the default for
+// denoms without a per-denom SendEnabled entry
 func (k BaseSendKeeper) GetSendEnabled(ctx sdk.Context) bool {
 	var enabled bool
 	k.paramSpace.Get(ctx, types.ParamStoreKeySendEnabled, &enabled)
@@ -264,8 +273,61 @@ func (k BaseSendKeeper) SetSendEnabled(ctx sdk.Context, enabled bool) {
 	k.paramSpace.Set(ctx, types.ParamStoreKeySendEnabled, &enabled)
 }
 
+// GetSendEnabledDenoms returns the per-denom SendEnabled entries
+func (k BaseSendKeeper) GetSendEnabledDenoms(ctx sdk.Context) []types.SendEnabled {
+	sendEnabled := []types.SendEnabled{}
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeySendEnabledDenoms, &sendEnabled)
+	return sendEnabled
+}
+
+// SetSendEnabledDenoms sets the per-denom SendEnabled entries
+func (k BaseSendKeeper) SetSendEnabledDenoms(ctx sdk.Context, sendEnabled []types.SendEnabled) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeySendEnabledDenoms, &sendEnabled)
+}
+
+// IsSendEnabledCoin returns the send enabled status of the coin's denom. It
+// falls back to the default SendEnabled parameter if the denom has no entry.
+func (k BaseSendKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
+	for _, se := range k.GetSendEnabledDenoms(ctx) {
+		if se.Denom == coin.Denom {
+			return se.Enabled
+		}
+	}
+
+	return k.GetSendEnabled(ctx)
+}
+
+// IsSendEnabledCoins returns an error if any of the coins is not enabled for
+// transfers.
+func (k BaseSendKeeper) IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error {
+	for _, coin := range coins {
+		if !k.IsSendEnabledCoin(ctx, coin) {
+			return sdkerrors.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", coin.Denom)
+		}
+	}
+
+	return nil
+}
+
 // BlacklistedAddr checks if a given address is blacklisted (i.e restricted from
 // receiving funds)
 func (k BaseSendKeeper) BlacklistedAddr(addr sdk.AccAddress) bool {
 	return k.blacklistedAddrs[addr.String()]
 }
+
+func newEventMultiSend(inputs []types.Input, outputs []types.Output) *types.EventMultiSend {
+	event := &types.EventMultiSend{
+		Inputs:  make([]types.AddressCoins, len(inputs)),
+		Outputs: make([]types.AddressCoins, len(outputs)),
+	}
+
+	for i, in := range inputs {
+		event.Inputs[i] = types.AddressCoins{Address: in.Address.String(), Coins: in.Coins}
+	}
+
+	for i, out := range outputs {
+		event.Outputs[i] = types.AddressCoins{Address: out.Address.String(), Coins: out.Coins}
+	}
+
+	return event
+}
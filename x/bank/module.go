@@ -26,6 +26,7 @@ import (
 
 var (
 	_ module.AppModule           = AppModule{}
+	_ module.MsgServiceAppModule = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
 	_ module.InterfaceModule     = AppModuleBasic{}
@@ -88,7 +89,15 @@ type AppModule struct {
 	accountKeeper types.AccountKeeper
 }
 
-func (am AppModule) RegisterQueryService(grpc.Server) {}
+// RegisterQueryService registers the bank Query service.
+func (am AppModule) RegisterQueryService(server grpc.Server) {
+	types.RegisterQueryServer(server, am.keeper)
+}
+
+// RegisterMsgService registers the bank Msg service.
+func (am AppModule) RegisterMsgService(server grpc.Server) {
+	types.RegisterMsgServer(server, keeper.NewMsgServerImpl(am.keeper))
+}
 
 // NewAppModule creates a new AppModule object
 func NewAppModule(cdc codec.Marshaler, keeper keeper.Keeper, accountKeeper types.AccountKeeper) AppModule {
@@ -47,6 +47,6 @@ func RandomizedGenState(simState *module.SimulationState) {
 	totalSupply := sdk.NewInt(simState.InitialStake * (numAccs + simState.NumBonded))
 	supply := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, totalSupply))
 
-	bankGenesis := types.NewGenesisState(sendEnabled, RandomGenesisBalances(simState), supply)
+	bankGenesis := types.NewGenesisState(sendEnabled, []types.SendEnabled{}, RandomGenesisBalances(simState), supply, []types.Metadata{})
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(bankGenesis)
 }
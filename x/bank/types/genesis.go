@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"sort"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -14,9 +15,11 @@ var _ exported.GenesisBalance = (*Balance)(nil)
 
 // GenesisState defines the bank module's genesis state.
 type GenesisState struct {
-	SendEnabled bool      `json:"send_enabled" yaml:"send_enabled"`
-	Balances    []Balance `json:"balances" yaml:"balances"`
-	Supply      sdk.Coins `json:"supply" yaml:"supply"`
+	SendEnabled       bool          `json:"send_enabled" yaml:"send_enabled"`
+	SendEnabledDenoms []SendEnabled `json:"send_enabled_denoms" yaml:"send_enabled_denoms"`
+	Balances          []Balance     `json:"balances" yaml:"balances"`
+	Supply            sdk.Coins     `json:"supply" yaml:"supply"`
+	DenomMetadata     []Metadata    `json:"denom_metadata" yaml:"denom_metadata"`
 }
 
 // Balance defines an account address and balance pair used in the bank module's
@@ -50,17 +53,43 @@ func SanitizeGenesisBalances(balances []Balance) []Balance {
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(sendEnabled bool, balances []Balance, supply sdk.Coins) GenesisState {
+func NewGenesisState(
+	sendEnabled bool, sendEnabledDenoms []SendEnabled, balances []Balance, supply sdk.Coins, denomMetadata []Metadata,
+) GenesisState {
 	return GenesisState{
-		SendEnabled: sendEnabled,
-		Balances:    balances,
-		Supply:      supply,
+		SendEnabled:       sendEnabled,
+		SendEnabledDenoms: sendEnabledDenoms,
+		Balances:          balances,
+		Supply:            supply,
+		DenomMetadata:     denomMetadata,
 	}
 }
 
 // DefaultGenesisState returns a default bank module genesis state.
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(true, []Balance{}, DefaultSupply().GetTotal())
+	return NewGenesisState(DefaultSendEnabled, []SendEnabled{}, []Balance{}, DefaultSupply().GetTotal(), []Metadata{})
+}
+
+// Validate performs basic validation of the bank genesis data, returning an
+// error for any failed validation criteria.
+func (gs GenesisState) Validate() error {
+	if err := ValidateSendEnabledDenoms(gs.SendEnabledDenoms); err != nil {
+		return err
+	}
+
+	seenMetadata := make(map[string]bool)
+	for _, metadata := range gs.DenomMetadata {
+		if seenMetadata[metadata.Base] {
+			return fmt.Errorf("duplicate denom metadata found for %s", metadata.Base)
+		}
+		seenMetadata[metadata.Base] = true
+
+		if err := metadata.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return NewSupply(gs.Supply).ValidateBasic()
 }
 
 // GetGenesisStateFromAppState returns x/bank GenesisState given raw application
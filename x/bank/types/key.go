@@ -22,10 +22,16 @@ const (
 
 // KVStore keys
 var (
-	BalancesPrefix = []byte("balances")
-	SupplyKey      = []byte{0x00}
+	BalancesPrefix      = []byte("balances")
+	SupplyKey           = []byte{0x00}
+	DenomMetadataPrefix = []byte{0x1}
 )
 
+// DenomMetadataKey returns the store key of the metadata of a base denom
+func DenomMetadataKey(denom string) []byte {
+	return append(DenomMetadataPrefix, []byte(denom)...)
+}
+
 // AddressFromBalancesStore returns an account address from a balances prefix
 // store. The key must not contain the perfix BalancesPrefix as the prefix store
 // iterator discards the actual prefix.
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a basic validation of the denomination metadata. It checks
+// that:
+//  - the base and display denoms are valid
+//  - the first denom unit is the base denom, with exponent 0
+//  - the denom units are sorted by strictly increasing exponent and their
+//    denoms are unique
+//  - the display denom is one of the denom units
+func (m Metadata) Validate() error {
+	if err := sdk.ValidateDenom(m.Base); err != nil {
+		return fmt.Errorf("invalid metadata base denom: %w", err)
+	}
+
+	if err := sdk.ValidateDenom(m.Display); err != nil {
+		return fmt.Errorf("invalid metadata display denom: %w", err)
+	}
+
+	if len(m.DenomUnits) == 0 {
+		return errors.New("metadata must contain at least one denom unit")
+	}
+
+	if m.DenomUnits[0].Denom != m.Base || m.DenomUnits[0].Exponent != 0 {
+		return fmt.Errorf("the first denom unit must be the base denom %s with exponent 0", m.Base)
+	}
+
+	var hasDisplay bool
+	seen := make(map[string]bool)
+
+	for i, unit := range m.DenomUnits {
+		if err := sdk.ValidateDenom(unit.Denom); err != nil {
+			return fmt.Errorf("invalid denom unit: %w", err)
+		}
+
+		if seen[unit.Denom] {
+			return fmt.Errorf("duplicate denom unit %s", unit.Denom)
+		}
+		seen[unit.Denom] = true
+
+		if i > 0 && unit.Exponent <= m.DenomUnits[i-1].Exponent {
+			return fmt.Errorf("denom units must be sorted by increasing exponent; %s has exponent %d", unit.Denom, unit.Exponent)
+		}
+
+		if unit.Denom == m.Display {
+			hasDisplay = true
+		}
+	}
+
+	if !hasDisplay {
+		return fmt.Errorf("display denom %s is not one of the denom units", m.Display)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMetadataValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		metadata types.Metadata
+		expErr   bool
+	}{
+		{
+			"valid metadata",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{
+					{Denom: "uatom", Exponent: 0, Aliases: []string{"microatom"}},
+					{Denom: "matom", Exponent: 3},
+					{Denom: "atom", Exponent: 6},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			false,
+		},
+		{
+			"invalid base denom",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{{Denom: "uatom", Exponent: 0}},
+				Base:       "",
+				Display:    "uatom",
+			},
+			true,
+		},
+		{
+			"no denom units",
+			types.Metadata{Base: "uatom", Display: "uatom"},
+			true,
+		},
+		{
+			"first unit is not the base denom",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{
+					{Denom: "atom", Exponent: 6},
+					{Denom: "uatom", Exponent: 0},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"base denom with non-zero exponent",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{{Denom: "uatom", Exponent: 1}},
+				Base:       "uatom",
+				Display:    "uatom",
+			},
+			true,
+		},
+		{
+			"exponents not increasing",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{
+					{Denom: "uatom", Exponent: 0},
+					{Denom: "atom", Exponent: 6},
+					{Denom: "matom", Exponent: 3},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"duplicate denom unit",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{
+					{Denom: "uatom", Exponent: 0},
+					{Denom: "uatom", Exponent: 6},
+				},
+				Base:    "uatom",
+				Display: "uatom",
+			},
+			true,
+		},
+		{
+			"display is not a denom unit",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{{Denom: "uatom", Exponent: 0}},
+				Base:       "uatom",
+				Display:    "atom",
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.metadata.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSendEnabledDenoms(t *testing.T) {
+	require.NoError(t, types.ValidateSendEnabledDenoms(nil))
+	require.NoError(t, types.ValidateSendEnabledDenoms([]types.SendEnabled{
+		types.NewSendEnabled("foo", true), types.NewSendEnabled("bar", false),
+	}))
+	require.Error(t, types.ValidateSendEnabledDenoms([]types.SendEnabled{types.NewSendEnabled("", true)}))
+	require.Error(t, types.ValidateSendEnabledDenoms([]types.SendEnabled{
+		types.NewSendEnabled("foo", true), types.NewSendEnabled("foo", false),
+	}))
+}
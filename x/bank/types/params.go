@@ -3,6 +3,9 @@ package types
 import (
 	"fmt"
 
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -13,16 +16,44 @@ const (
 	DefaultSendEnabled = true
 )
 
-// ParamStoreKeySendEnabled is store's key for SendEnabled
-var ParamStoreKeySendEnabled = []byte("sendenabled")
+// Parameter store keys
+var (
+	// ParamStoreKeySendEnabled is store's key for SendEnabled, the default
+	// send enabled status of denoms without a SendEnabled entry
+	ParamStoreKeySendEnabled = []byte("sendenabled")
+
+	// ParamStoreKeySendEnabledDenoms is store's key for the per-denom
+	// SendEnabled entries
+	ParamStoreKeySendEnabledDenoms = []byte("sendenableddenoms")
+)
 
 // ParamKeyTable type declaration for parameters
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable(
 		paramtypes.NewParamSetPair(ParamStoreKeySendEnabled, false, validateSendEnabled),
+		paramtypes.NewParamSetPair(ParamStoreKeySendEnabledDenoms, []SendEnabled{}, validateSendEnabledDenoms),
 	)
 }
 
+// NewSendEnabled creates a new SendEnabled object
+func NewSendEnabled(denom string, enabled bool) SendEnabled {
+	return SendEnabled{
+		Denom:   denom,
+		Enabled: enabled,
+	}
+}
+
+// String implements stringer interface
+func (se SendEnabled) String() string {
+	out, _ := yaml.Marshal(se)
+	return string(out)
+}
+
+// Validate performs a basic validation of a SendEnabled entry
+func (se SendEnabled) Validate() error {
+	return sdk.ValidateDenom(se.Denom)
+}
+
 func validateSendEnabled(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -31,3 +62,30 @@ func validateSendEnabled(i interface{}) error {
 
 	return nil
 }
+
+// ValidateSendEnabledDenoms checks that all entries have a valid denom and
+// that no denom appears twice.
+func ValidateSendEnabledDenoms(sendEnabled []SendEnabled) error {
+	seen := make(map[string]bool)
+	for _, se := range sendEnabled {
+		if err := se.Validate(); err != nil {
+			return err
+		}
+
+		if seen[se.Denom] {
+			return fmt.Errorf("duplicate send enabled parameter found: %s", se.Denom)
+		}
+		seen[se.Denom] = true
+	}
+
+	return nil
+}
+
+func validateSendEnabledDenoms(i interface{}) error {
+	sendEnabled, ok := i.([]SendEnabled)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return ValidateSendEnabledDenoms(sendEnabled)
+}
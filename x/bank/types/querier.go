@@ -2,14 +2,18 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // Querier path constants
 const (
-	QueryBalance     = "balance"
-	QueryAllBalances = "all_balances"
-	QueryTotalSupply = "total_supply"
-	QuerySupplyOf    = "supply_of"
+	QueryBalance           = "balance"
+	QueryAllBalances       = "all_balances"
+	QuerySpendableBalances = "spendable_balances"
+	QueryTotalSupply       = "total_supply"
+	QuerySupplyOf          = "supply_of"
+	QueryDenomMetadata     = "denom_metadata"
+	QueryDenomsMetadata    = "denoms_metadata"
 )
 
 // NewQueryBalanceRequest creates a new instance of QueryBalanceRequest.
@@ -18,8 +22,18 @@ func NewQueryBalanceRequest(addr sdk.AccAddress, denom string) *QueryBalanceRequ
 }
 
 // NewQueryAllBalancesRequest creates a new instance of QueryAllBalancesRequest.
-func NewQueryAllBalancesRequest(addr sdk.AccAddress) *QueryAllBalancesRequest {
-	return &QueryAllBalancesRequest{Address: addr}
+func NewQueryAllBalancesRequest(addr sdk.AccAddress, req *query.PageRequest) *QueryAllBalancesRequest {
+	return &QueryAllBalancesRequest{Address: addr, Pagination: req}
+}
+
+// NewQuerySpendableBalancesRequest creates a new instance of QuerySpendableBalancesRequest.
+func NewQuerySpendableBalancesRequest(addr sdk.AccAddress, req *query.PageRequest) *QuerySpendableBalancesRequest {
+	return &QuerySpendableBalancesRequest{Address: addr, Pagination: req}
+}
+
+// NewQueryDenomMetadataRequest creates a new instance of QueryDenomMetadataRequest.
+func NewQueryDenomMetadataRequest(denom string) *QueryDenomMetadataRequest {
+	return &QueryDenomMetadataRequest{Denom: denom}
 }
 
 // QueryTotalSupply defines the params for the following queries:
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// ValidatorOutstandingRewards implements the Query/ValidatorOutstandingRewards gRPC method
+func (k Keeper) ValidatorOutstandingRewards(c context.Context, req *types.QueryValidatorOutstandingRewardsRequest) (*types.QueryValidatorOutstandingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddress.Empty() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rewards := k.GetValidatorOutstandingRewards(ctx, req.ValidatorAddress)
+
+	return &types.QueryValidatorOutstandingRewardsResponse{Rewards: rewards}, nil
+}
+
+// ValidatorCommission implements the Query/ValidatorCommission gRPC method
+func (k Keeper) ValidatorCommission(c context.Context, req *types.QueryValidatorCommissionRequest) (*types.QueryValidatorCommissionResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddress.Empty() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	commission := k.GetValidatorAccumulatedCommission(ctx, req.ValidatorAddress)
+
+	return &types.QueryValidatorCommissionResponse{Commission: commission}, nil
+}
+
+// DelegationRewards implements the Query/DelegationRewards gRPC method
+func (k Keeper) DelegationRewards(c context.Context, req *types.QueryDelegationRewardsRequest) (*types.QueryDelegationRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddress.Empty() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delegator address")
+	}
+
+	if req.ValidatorAddress.Empty() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// cache-wrap context as to not persist state changes during querying
+	ctx, _ = ctx.CacheContext()
+
+	val := k.stakingKeeper.Validator(ctx, req.ValidatorAddress)
+	if val == nil {
+		return nil, status.Errorf(codes.NotFound, "validator %s does not exist", req.ValidatorAddress)
+	}
+
+	del := k.stakingKeeper.Delegation(ctx, req.DelegatorAddress, req.ValidatorAddress)
+	if del == nil {
+		return nil, status.Errorf(codes.NotFound, "delegation does not exist")
+	}
+
+	endingPeriod := k.IncrementValidatorPeriod(ctx, val)
+	rewards := k.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+
+	return &types.QueryDelegationRewardsResponse{Rewards: rewards}, nil
+}
+
+// DelegatorWithdrawAddress implements the Query/DelegatorWithdrawAddress gRPC method
+func (k Keeper) DelegatorWithdrawAddress(c context.Context, req *types.QueryDelegatorWithdrawAddressRequest) (*types.QueryDelegatorWithdrawAddressResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddress.Empty() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delegator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, req.DelegatorAddress)
+
+	return &types.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: withdrawAddr}, nil
+}
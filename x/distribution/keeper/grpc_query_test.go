@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestGRPCQueries(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	valOpAddr1 := valAddrs[0]
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx)
+	types.RegisterQueryServer(queryHelper, app.DistrKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	// test outstanding rewards query
+	_, err := queryClient.ValidatorOutstandingRewards(gocontext.Background(), &types.QueryValidatorOutstandingRewardsRequest{})
+	require.Error(t, err)
+
+	outstandingRewards := sdk.DecCoins{{Denom: "mytoken", Amount: sdk.NewDec(3)}, {Denom: "myothertoken", Amount: sdk.NewDecWithPrec(3, 7)}}
+	app.DistrKeeper.SetValidatorOutstandingRewards(ctx, valOpAddr1, types.ValidatorOutstandingRewards{Rewards: outstandingRewards})
+	rewardsRes, err := queryClient.ValidatorOutstandingRewards(gocontext.Background(), &types.QueryValidatorOutstandingRewardsRequest{ValidatorAddress: valOpAddr1})
+	require.NoError(t, err)
+	require.Equal(t, outstandingRewards, rewardsRes.Rewards.Rewards)
+
+	// test validator commission query
+	_, err = queryClient.ValidatorCommission(gocontext.Background(), &types.QueryValidatorCommissionRequest{})
+	require.Error(t, err)
+
+	commission := sdk.DecCoins{{Denom: "token1", Amount: sdk.NewDec(4)}, {Denom: "token2", Amount: sdk.NewDec(2)}}
+	app.DistrKeeper.SetValidatorAccumulatedCommission(ctx, valOpAddr1, types.ValidatorAccumulatedCommission{Commission: commission})
+	commissionRes, err := queryClient.ValidatorCommission(gocontext.Background(), &types.QueryValidatorCommissionRequest{ValidatorAddress: valOpAddr1})
+	require.NoError(t, err)
+	require.Equal(t, commission, commissionRes.Commission.Commission)
+
+	// test delegator withdraw address query
+	_, err = queryClient.DelegatorWithdrawAddress(gocontext.Background(), &types.QueryDelegatorWithdrawAddressRequest{})
+	require.Error(t, err)
+
+	withdrawRes, err := queryClient.DelegatorWithdrawAddress(gocontext.Background(), &types.QueryDelegatorWithdrawAddressRequest{DelegatorAddress: addr[0]})
+	require.NoError(t, err)
+	require.Equal(t, addr[0], withdrawRes.WithdrawAddress)
+
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, addr[0], addr[1]))
+	withdrawRes, err = queryClient.DelegatorWithdrawAddress(gocontext.Background(), &types.QueryDelegatorWithdrawAddressRequest{DelegatorAddress: addr[0]})
+	require.NoError(t, err)
+	require.Equal(t, addr[1], withdrawRes.WithdrawAddress)
+
+	// test delegation rewards query
+	req := &types.QueryDelegationRewardsRequest{DelegatorAddress: sdk.AccAddress(valOpAddr1), ValidatorAddress: valOpAddr1}
+	_, err = queryClient.DelegationRewards(gocontext.Background(), req)
+	require.Error(t, err, "validator does not exist yet")
+
+	sh := staking.NewHandler(app.StakingKeeper)
+	comm := stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := stakingtypes.NewMsgCreateValidator(
+		valOpAddr1, valConsPk1, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), stakingtypes.Description{}, comm, sdk.OneInt(),
+	)
+
+	_, err = sh(ctx, msg)
+	require.NoError(t, err)
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	delRewardsRes, err := queryClient.DelegationRewards(gocontext.Background(), req)
+	require.NoError(t, err)
+	require.True(t, delRewardsRes.Rewards.IsZero())
+
+	val := app.StakingKeeper.Validator(ctx, valOpAddr1)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(10)}})
+
+	// rewards are only calculated after the block the delegation started in
+	queryHelper = baseapp.NewQueryServerTestHelper(ctx)
+	types.RegisterQueryServer(queryHelper, app.DistrKeeper)
+	queryClient = types.NewQueryClient(queryHelper)
+
+	delRewardsRes, err = queryClient.DelegationRewards(gocontext.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(5)}}, delRewardsRes.Rewards)
+
+	_, err = queryClient.DelegationRewards(gocontext.Background(), &types.QueryDelegationRewardsRequest{DelegatorAddress: addr[1], ValidatorAddress: valOpAddr1})
+	require.Error(t, err, "delegation does not exist")
+}
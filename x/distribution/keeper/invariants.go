@@ -18,6 +18,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		ReferenceCountInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "module-account",
 		ModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "nonnegative-community-pool",
+		NonNegativeCommunityPoolInvariant(k))
 }
 
 // AllInvariants runs all invariants of the distribution module
@@ -35,7 +37,11 @@ func AllInvariants(k Keeper) sdk.Invariant {
 		if stop {
 			return res, stop
 		}
-		return ModuleAccountInvariant(k)(ctx)
+		res, stop = ModuleAccountInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return NonNegativeCommunityPoolInvariant(k)(ctx)
 	}
 }
 
@@ -61,6 +67,18 @@ func NonNegativeOutstandingInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
+// NonNegativeCommunityPoolInvariant checks that the community pool is never
+// negative, e.g. after a community pool spend proposal was executed
+func NonNegativeCommunityPoolInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		communityPool := k.GetFeePoolCommunityCoins(ctx)
+		broken := communityPool.IsAnyNegative()
+
+		return sdk.FormatInvariant(types.ModuleName, "nonnegative community pool",
+			fmt.Sprintf("\tcommunity pool coins: %s\n", communityPool)), broken
+	}
+}
+
 // CanWithdrawInvariant checks that current rewards can be completely withdrawn
 func CanWithdrawInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
@@ -52,6 +53,13 @@ func TestProposalHandlerPassed(t *testing.T) {
 
 	balances = app.BankKeeper.GetAllBalances(ctx, recipient)
 	require.Equal(t, balances, amount)
+
+	// the community pool is drained and spending from it again must fail
+	require.True(t, app.DistrKeeper.GetFeePoolCommunityCoins(ctx).IsZero())
+	require.Error(t, hdlr(ctx, tp))
+
+	_, broken := keeper.NonNegativeCommunityPoolInvariant(app.DistrKeeper)(ctx)
+	require.False(t, broken)
 }
 
 func TestProposalHandlerFailed(t *testing.T) {
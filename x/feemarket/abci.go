@@ -0,0 +1,36 @@
+package feemarket
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// EndBlocker adjusts the base fee for the next block based on the gas used by
+// the current block relative to the block gas target.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	params := k.GetParams(ctx)
+	if !params.Enabled {
+		return
+	}
+
+	// without a block gas limit there is no target to adjust against
+	gasTarget := k.GetBlockGasTarget(ctx, params)
+	if gasTarget == 0 || ctx.BlockGasMeter() == nil {
+		return
+	}
+
+	gasUsed := ctx.BlockGasMeter().GasConsumedToLimit()
+	baseFee := params.NextBaseFee(gasUsed, gasTarget)
+	k.SetBaseFee(ctx, baseFee)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeMarket,
+			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
+			sdk.NewAttribute(types.AttributeKeyBlockGasUsed, strconv.FormatUint(gasUsed, 10)),
+		),
+	)
+}
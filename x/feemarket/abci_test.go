@@ -0,0 +1,53 @@
+package feemarket_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestEndBlockerAdjustsBaseFee(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1}).
+		WithConsensusParams(&abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: 200}})
+
+	params := types.NewParams(true, sdk.DefaultBondDenom, sdk.NewDec(80), sdk.NewDec(10), 8, 2)
+	app.FeeMarketKeeper.SetParams(ctx, params)
+
+	// a full block raises the base fee by 1/8
+	meter := sdk.NewGasMeter(200)
+	meter.ConsumeGas(200, "test")
+	feemarket.EndBlocker(ctx.WithBlockGasMeter(meter), app.FeeMarketKeeper)
+	require.Equal(t, sdk.NewDec(90), app.FeeMarketKeeper.GetBaseFee(ctx))
+
+	// an empty block lowers it again
+	feemarket.EndBlocker(ctx.WithBlockGasMeter(sdk.NewGasMeter(200)), app.FeeMarketKeeper)
+	require.Equal(t, sdk.NewDecWithPrec(7875, 2), app.FeeMarketKeeper.GetBaseFee(ctx))
+
+	// the base fee is left untouched when the fee market is disabled
+	params = app.FeeMarketKeeper.GetParams(ctx)
+	params.Enabled = false
+	app.FeeMarketKeeper.SetParams(ctx, params)
+	feemarket.EndBlocker(ctx.WithBlockGasMeter(sdk.NewGasMeter(200)), app.FeeMarketKeeper)
+	require.Equal(t, sdk.NewDecWithPrec(7875, 2), app.FeeMarketKeeper.GetBaseFee(ctx))
+}
+
+func TestEndBlockerNoBlockGasLimit(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1}).
+		WithConsensusParams(&abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: -1}}).
+		WithBlockGasMeter(sdk.NewInfiniteGasMeter())
+
+	params := types.DefaultParams()
+	params.Enabled = true
+	app.FeeMarketKeeper.SetParams(ctx, params)
+
+	feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+	require.Equal(t, params.BaseFee, app.FeeMarketKeeper.GetBaseFee(ctx))
+}
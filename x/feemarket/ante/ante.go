@@ -0,0 +1,72 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+)
+
+// FeeTx defines the interface to be implemented by Tx to use the
+// BaseFeeDecorator
+type FeeTx interface {
+	sdk.Tx
+	GetGas() uint64
+	GetFee() sdk.Coins
+}
+
+// NewAnteHandler returns an AnteHandler that checks the tx pays at least the
+// base fee before calling next, which is usually the application's regular
+// AnteHandler.
+func NewAnteHandler(k keeper.Keeper, next sdk.AnteHandler) sdk.AnteHandler {
+	bfd := NewBaseFeeDecorator(k)
+
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return bfd.AnteHandle(ctx, tx, simulate, next)
+	}
+}
+
+// BaseFeeDecorator rejects txs whose gas price is below the chain-wide base
+// fee of the fee market. Unlike the MempoolFeeDecorator, the check is part of
+// consensus and applies to both CheckTx and DeliverTx. It is a no-op when the
+// fee market is disabled, during genesis and when simulating.
+// CONTRACT: Tx must implement FeeTx to use BaseFeeDecorator
+type BaseFeeDecorator struct {
+	keeper keeper.Keeper
+}
+
+// NewBaseFeeDecorator creates a new BaseFeeDecorator
+func NewBaseFeeDecorator(k keeper.Keeper) BaseFeeDecorator {
+	return BaseFeeDecorator{
+		keeper: k,
+	}
+}
+
+func (bfd BaseFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if simulate || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	params := bfd.keeper.GetParams(ctx)
+	if !params.Enabled {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// fee = ceil(baseFee * gasLimit)
+	requiredFee := sdk.NewCoin(
+		params.FeeDenom,
+		params.BaseFee.MulInt64(int64(feeTx.GetGas())).Ceil().RoundInt(),
+	)
+
+	if fee := feeTx.GetFee().AmountOf(params.FeeDenom); fee.LT(requiredFee.Amount) {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrInsufficientFee, "fee below the base fee; got: %s required: %s", feeTx.GetFee(), requiredFee,
+		)
+	}
+
+	return next(ctx, tx, simulate)
+}
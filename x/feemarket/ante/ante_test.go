@@ -0,0 +1,70 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/ante"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestBaseFeeDecorator(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+
+	params := types.DefaultParams()
+	params.Enabled = true
+	params.BaseFee = sdk.NewDecWithPrec(25, 3)
+	app.FeeMarketKeeper.SetParams(ctx, params)
+
+	var called bool
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		called = true
+		return ctx, nil
+	}
+	anteHandler := ante.NewAnteHandler(app.FeeMarketKeeper, next)
+
+	newTx := func(amount int64) sdk.Tx {
+		fee := authtypes.NewStdFee(100000, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)))
+		return authtypes.NewStdTx(nil, fee, nil, "")
+	}
+
+	testCases := []struct {
+		name      string
+		ctx       sdk.Context
+		tx        sdk.Tx
+		simulate  bool
+		expectErr bool
+	}{
+		{"fee equal to the base fee", ctx, newTx(2500), false, false},
+		{"fee below the base fee", ctx, newTx(2499), false, true},
+		{"fee below the base fee in check tx", ctx.WithIsCheckTx(true), newTx(2499), false, true},
+		{"simulation skips the check", ctx, newTx(0), true, false},
+		{"genesis skips the check", ctx.WithBlockHeight(0), newTx(0), false, false},
+	}
+
+	for _, tc := range testCases {
+		called = false
+		_, err := anteHandler(tc.ctx, tc.tx, tc.simulate)
+
+		if tc.expectErr {
+			require.True(t, sdkerrors.ErrInsufficientFee.Is(err), tc.name)
+			require.False(t, called, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+			require.True(t, called, tc.name)
+		}
+	}
+
+	// a disabled fee market accepts any fee
+	params.Enabled = false
+	app.FeeMarketKeeper.SetParams(ctx, params)
+	_, err := anteHandler(ctx, newTx(0), false)
+	require.NoError(t, err)
+}
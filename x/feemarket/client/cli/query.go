@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// GetQueryCmd returns the cli query commands for the fee market module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	feeMarketQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the fee market module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feeMarketQueryCmd.AddCommand(
+		flags.GetCommands(
+			GetCmdQueryParams(cdc),
+			GetCmdQueryBaseFee(cdc),
+		)...,
+	)
+
+	return feeMarketQueryCmd
+}
+
+// GetCmdQueryParams implements a command to return the current fee market
+// parameters.
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query the current fee market parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParameters)
+			res, _, err := clientCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := cdc.UnmarshalJSON(res, &params); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetCmdQueryBaseFee implements a command to return the current base fee, the
+// minimum gas price every tx must pay.
+func GetCmdQueryBaseFee(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "base-fee",
+		Short: "Query the current base fee (minimum gas price) of the fee market",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBaseFee)
+			res, _, err := clientCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var baseFee sdk.DecCoin
+			if err := cdc.UnmarshalJSON(res, &baseFee); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(baseFee)
+		},
+	}
+}
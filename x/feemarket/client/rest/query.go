@@ -0,0 +1,43 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func registerQueryRoutes(clientCtx client.Context, r *mux.Router) {
+	r.HandleFunc(
+		"/feemarket/parameters",
+		queryHandlerFn(clientCtx, types.QueryParameters),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/feemarket/base_fee",
+		queryHandlerFn(clientCtx, types.QueryBaseFee),
+	).Methods("GET")
+}
+
+func queryHandlerFn(clientCtx client.Context, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, endpoint)
+
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := clientCtx.QueryWithData(route, nil)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
@@ -0,0 +1,15 @@
+/*
+Package feemarket implements an optional EIP-1559 style fee market. The module
+keeps a chain-wide base fee, the minimum gas price every tx must pay, in its
+params.
+
+At the end of every block the base fee moves towards the direction of the
+block's deviation from the gas target, which is the block gas limit divided by
+the elasticity multiplier, by at most 1/BaseFeeChangeDenominator and never
+below the minimum base fee. Txs paying less than the base fee are rejected by
+the BaseFeeDecorator in both CheckTx and DeliverTx.
+
+The fee market is disabled by default and only adjusts the base fee when the
+consensus params define a block gas limit.
+*/
+package feemarket
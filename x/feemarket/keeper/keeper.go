@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper of the fee market. The base fee is kept in the module's params so
+// that it can be both adjusted every block and queried or changed through
+// governance like any other parameter.
+type Keeper struct {
+	cdc        codec.Marshaler
+	paramSpace paramtypes.Subspace
+}
+
+// NewKeeper creates a new fee market Keeper instance
+func NewKeeper(cdc codec.Marshaler, paramSpace paramtypes.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:        cdc,
+		paramSpace: paramSpace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of fee market parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of fee market parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetBaseFee returns the current base fee, i.e. the minimum gas price every
+// tx must pay in the fee denom.
+func (k Keeper) GetBaseFee(ctx sdk.Context) (baseFee sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyBaseFee, &baseFee)
+	return baseFee
+}
+
+// SetBaseFee sets the base fee.
+func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee sdk.Dec) {
+	k.paramSpace.Set(ctx, types.KeyBaseFee, baseFee)
+}
+
+// GetBlockGasTarget returns the amount of gas the base fee targets per block,
+// which is the block gas limit divided by the elasticity multiplier. It returns
+// zero if the consensus params do not define a block gas limit.
+func (k Keeper) GetBlockGasTarget(ctx sdk.Context, params types.Params) uint64 {
+	cp := ctx.ConsensusParams()
+	if cp == nil || cp.Block == nil || cp.Block.MaxGas <= 0 {
+		return 0
+	}
+
+	return uint64(cp.Block.MaxGas) / uint64(params.ElasticityMultiplier)
+}
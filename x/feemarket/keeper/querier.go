@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// NewQuerier returns a fee market Querier handler.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, _ abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case types.QueryParameters:
+			return queryParams(ctx, k)
+
+		case types.QueryBaseFee:
+			return queryBaseFee(ctx, k)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
+		}
+	}
+}
+
+func queryParams(ctx sdk.Context, k Keeper) ([]byte, error) {
+	params := k.GetParams(ctx)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryBaseFee(ctx sdk.Context, k Keeper) ([]byte, error) {
+	params := k.GetParams(ctx)
+	baseFee := sdk.NewDecCoinFromDec(params.FeeDenom, params.BaseFee)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, baseFee)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
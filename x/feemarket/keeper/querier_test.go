@@ -0,0 +1,38 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestQuerier(t *testing.T) {
+	app := simapp.Setup(true)
+	ctx := app.BaseApp.NewContext(true, abci.Header{})
+	app.FeeMarketKeeper.SetParams(ctx, types.DefaultParams())
+	querier := keeper.NewQuerier(app.FeeMarketKeeper)
+
+	res, err := querier(ctx, []string{types.QueryParameters}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var params types.Params
+	require.NoError(t, app.Codec().UnmarshalJSON(res, &params))
+	require.Equal(t, app.FeeMarketKeeper.GetParams(ctx), params)
+
+	app.FeeMarketKeeper.SetBaseFee(ctx, sdk.NewDecWithPrec(5, 2))
+	res, err = querier(ctx, []string{types.QueryBaseFee}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var baseFee sdk.DecCoin
+	require.NoError(t, app.Codec().UnmarshalJSON(res, &baseFee))
+	require.Equal(t, sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(5, 2)), baseFee)
+
+	_, err = querier(ctx, []string{"foo"}, abci.RequestQuery{})
+	require.Error(t, err)
+}
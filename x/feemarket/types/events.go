@@ -0,0 +1,9 @@
+package types
+
+// fee market module event types
+const (
+	EventTypeFeeMarket = ModuleName
+
+	AttributeKeyBaseFee      = "base_fee"
+	AttributeKeyBlockGasUsed = "block_gas_used"
+)
@@ -0,0 +1,16 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feemarket"
+
+	// DefaultParamspace defines the default paramspace for params keeper
+	DefaultParamspace = ModuleName
+
+	// QuerierRoute defines the querier route for the fee market module
+	QuerierRoute = ModuleName
+
+	// Query endpoints supported by the fee market querier
+	QueryParameters = "parameters"
+	QueryBaseFee    = "base_fee"
+)
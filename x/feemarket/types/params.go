@@ -0,0 +1,214 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyEnabled                  = []byte("Enabled")
+	KeyFeeDenom                 = []byte("FeeDenom")
+	KeyBaseFee                  = []byte("BaseFee")
+	KeyMinBaseFee               = []byte("MinBaseFee")
+	KeyBaseFeeChangeDenominator = []byte("BaseFeeChangeDenominator")
+	KeyElasticityMultiplier     = []byte("ElasticityMultiplier")
+)
+
+// Default parameter values
+const (
+	DefaultBaseFeeChangeDenominator = 8
+	DefaultElasticityMultiplier     = 2
+)
+
+// ParamKeyTable for the fee market module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(
+	enabled bool, feeDenom string, baseFee, minBaseFee sdk.Dec,
+	baseFeeChangeDenominator, elasticityMultiplier uint32,
+) Params {
+
+	return Params{
+		Enabled:                  enabled,
+		FeeDenom:                 feeDenom,
+		BaseFee:                  baseFee,
+		MinBaseFee:               minBaseFee,
+		BaseFeeChangeDenominator: baseFeeChangeDenominator,
+		ElasticityMultiplier:     elasticityMultiplier,
+	}
+}
+
+// DefaultParams returns the default fee market parameters. The fee market is
+// disabled by default.
+func DefaultParams() Params {
+	return Params{
+		Enabled:                  false,
+		FeeDenom:                 sdk.DefaultBondDenom,
+		BaseFee:                  sdk.NewDecWithPrec(25, 3),
+		MinBaseFee:               sdk.NewDecWithPrec(1, 3),
+		BaseFeeChangeDenominator: DefaultBaseFeeChangeDenominator,
+		ElasticityMultiplier:     DefaultElasticityMultiplier,
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateEnabled(p.Enabled); err != nil {
+		return err
+	}
+	if err := validateFeeDenom(p.FeeDenom); err != nil {
+		return err
+	}
+	if err := validateBaseFee(p.BaseFee); err != nil {
+		return err
+	}
+	if err := validateMinBaseFee(p.MinBaseFee); err != nil {
+		return err
+	}
+	if err := validateBaseFeeChangeDenominator(p.BaseFeeChangeDenominator); err != nil {
+		return err
+	}
+	if err := validateElasticityMultiplier(p.ElasticityMultiplier); err != nil {
+		return err
+	}
+	if p.BaseFee.LT(p.MinBaseFee) {
+		return fmt.Errorf(
+			"base fee (%s) must be greater than or equal to min base fee (%s)",
+			p.BaseFee, p.MinBaseFee,
+		)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyEnabled, &p.Enabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyFeeDenom, &p.FeeDenom, validateFeeDenom),
+		paramtypes.NewParamSetPair(KeyBaseFee, &p.BaseFee, validateBaseFee),
+		paramtypes.NewParamSetPair(KeyMinBaseFee, &p.MinBaseFee, validateMinBaseFee),
+		paramtypes.NewParamSetPair(KeyBaseFeeChangeDenominator, &p.BaseFeeChangeDenominator, validateBaseFeeChangeDenominator),
+		paramtypes.NewParamSetPair(KeyElasticityMultiplier, &p.ElasticityMultiplier, validateElasticityMultiplier),
+	}
+}
+
+// NextBaseFee returns the base fee of the next block given the gas used by the
+// current block and its gas target. The base fee moves towards the direction of
+// the deviation from the target by at most 1/BaseFeeChangeDenominator and never
+// falls below MinBaseFee.
+func (p Params) NextBaseFee(gasUsed, gasTarget uint64) sdk.Dec {
+	if gasTarget == 0 || gasUsed == gasTarget {
+		return p.BaseFee
+	}
+
+	target := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasTarget))
+	used := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasUsed))
+	denominator := sdk.NewDec(int64(p.BaseFeeChangeDenominator))
+
+	// delta = baseFee * |gasUsed - gasTarget| / gasTarget / denominator
+	delta := p.BaseFee.Mul(used.Sub(target).Abs()).Quo(target).Quo(denominator)
+
+	if gasUsed > gasTarget {
+		return p.BaseFee.Add(delta)
+	}
+
+	next := p.BaseFee.Sub(delta)
+	if next.LT(p.MinBaseFee) {
+		return p.MinBaseFee
+	}
+
+	return next
+}
+
+func validateEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateFeeDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if strings.TrimSpace(v) == "" {
+		return errors.New("fee denom cannot be blank")
+	}
+	if err := sdk.ValidateDenom(v); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateBaseFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("base fee cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinBaseFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("min base fee cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateBaseFeeChangeDenominator(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("base fee change denominator must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateElasticityMultiplier(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("elasticity multiplier must be positive: %d", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	params := types.DefaultParams()
+	params.FeeDenom = ""
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.BaseFee = sdk.NewDec(-1)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MinBaseFee = params.BaseFee.MulInt64(2)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.BaseFeeChangeDenominator = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.ElasticityMultiplier = 0
+	require.Error(t, params.Validate())
+}
+
+func TestNextBaseFee(t *testing.T) {
+	params := types.NewParams(true, sdk.DefaultBondDenom, sdk.NewDec(80), sdk.NewDec(10), 8, 2)
+
+	testCases := []struct {
+		name      string
+		gasUsed   uint64
+		gasTarget uint64
+		expected  sdk.Dec
+	}{
+		{"no target", 100, 0, sdk.NewDec(80)},
+		{"at target", 100, 100, sdk.NewDec(80)},
+		{"full block", 200, 100, sdk.NewDec(90)},
+		{"half over target", 150, 100, sdk.NewDec(85)},
+		{"empty block", 0, 100, sdk.NewDec(70)},
+		{"half under target", 50, 100, sdk.NewDec(75)},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, params.NextBaseFee(tc.gasUsed, tc.gasTarget), tc.name)
+	}
+
+	// the base fee never falls below the minimum
+	params.BaseFee = sdk.NewDec(11)
+	require.Equal(t, sdk.NewDec(10), params.NextBaseFee(0, 100))
+}
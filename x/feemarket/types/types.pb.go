@@ -0,0 +1,545 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: x/feemarket/types/types.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// fee market parameters
+type Params struct {
+	// enables the base fee check and its adjustment at the end of every block
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// denomination in which the base fee must be paid
+	FeeDenom string `protobuf:"bytes,2,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty" yaml:"fee_denom"`
+	// current minimum gas price every tx must pay
+	BaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee" yaml:"base_fee"`
+	// lower bound of the base fee
+	MinBaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_base_fee" yaml:"min_base_fee"`
+	// bounds the amount the base fee can change between blocks
+	BaseFeeChangeDenominator uint32 `protobuf:"varint,5,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty" yaml:"base_fee_change_denominator"`
+	// bounds the block gas target as a fraction of the block gas limit
+	ElasticityMultiplier uint32 `protobuf:"varint,6,opt,name=elasticity_multiplier,json=elasticityMultiplier,proto3" json:"elasticity_multiplier,omitempty" yaml:"elasticity_multiplier"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b9a07145442bc09, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *Params) GetFeeDenom() string {
+	if m != nil {
+		return m.FeeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetBaseFeeChangeDenominator() uint32 {
+	if m != nil {
+		return m.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (m *Params) GetElasticityMultiplier() uint32 {
+	if m != nil {
+		return m.ElasticityMultiplier
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos_sdk.x.feemarket.v1.Params")
+}
+
+func init() { proto.RegisterFile("x/feemarket/types/types.proto", fileDescriptor_9b9a07145442bc09) }
+
+var fileDescriptor_9b9a07145442bc09 = []byte{
+	// 393 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4f, 0x8f, 0x93, 0x40,
+	0x18, 0xc6, 0xc1, 0xdd, 0xed, 0x76, 0x27, 0x1a, 0x0d, 0xae, 0x09, 0xfe, 0x63, 0xc8, 0x1c, 0x9a,
+	0x5e, 0x84, 0x6c, 0xbc, 0xed, 0x4d, 0xac, 0xde, 0x4c, 0x0c, 0x89, 0x17, 0x63, 0x42, 0x06, 0x78,
+	0xa1, 0x93, 0x32, 0x0c, 0x61, 0x66, 0x4d, 0xf9, 0x16, 0x1e, 0x3d, 0xfa, 0x71, 0x7a, 0xec, 0xd1,
+	0x78, 0x20, 0x4d, 0xfb, 0x0d, 0xf8, 0x04, 0xa6, 0x40, 0xa9, 0x26, 0xbd, 0xec, 0x05, 0x5e, 0xde,
+	0xe7, 0xe1, 0xf9, 0xbd, 0xef, 0x64, 0xd0, 0xeb, 0xa5, 0x9b, 0x00, 0x70, 0x5a, 0x2e, 0x40, 0xb9,
+	0xaa, 0x2a, 0x40, 0x76, 0x4f, 0xa7, 0x28, 0x85, 0x12, 0xc6, 0xf3, 0x48, 0x48, 0x2e, 0x64, 0x20,
+	0xe3, 0x85, 0xb3, 0x74, 0x06, 0xa7, 0xf3, 0xfd, 0xe6, 0xc5, 0x44, 0xcd, 0x59, 0x19, 0x07, 0x05,
+	0x2d, 0x55, 0xe5, 0xb6, 0x6e, 0x37, 0x15, 0xa9, 0x38, 0x56, 0x5d, 0x04, 0xd9, 0x9c, 0xa1, 0xd1,
+	0x67, 0x5a, 0x52, 0x2e, 0x0d, 0x13, 0x5d, 0x42, 0x4e, 0xc3, 0x0c, 0x62, 0x53, 0xb7, 0xf5, 0xe9,
+	0xd8, 0x3f, 0x7c, 0x1a, 0x37, 0xe8, 0x2a, 0x01, 0x08, 0x62, 0xc8, 0x05, 0x37, 0x1f, 0xd8, 0xfa,
+	0xf4, 0xca, 0xbb, 0x6e, 0x6a, 0xfc, 0xa4, 0xa2, 0x3c, 0xbb, 0x25, 0x83, 0x44, 0xfc, 0x71, 0x02,
+	0x30, 0xdb, 0x97, 0xc6, 0x37, 0x34, 0x0e, 0xa9, 0x84, 0x20, 0x01, 0x30, 0xcf, 0xda, 0x3f, 0xde,
+	0xad, 0x6a, 0xac, 0xfd, 0xa9, 0xf1, 0x24, 0x65, 0x6a, 0x7e, 0x17, 0x3a, 0x91, 0xe0, 0x6e, 0x37,
+	0x7f, 0xff, 0x7a, 0x23, 0xe3, 0x45, 0xbf, 0xde, 0x0c, 0xa2, 0xa6, 0xc6, 0x8f, 0xbb, 0xfc, 0x43,
+	0x0e, 0xf1, 0x2f, 0xf7, 0xe5, 0x47, 0x00, 0x23, 0x45, 0x0f, 0x39, 0xcb, 0x83, 0x81, 0x70, 0xde,
+	0x12, 0x3e, 0xdc, 0x9b, 0xf0, 0xb4, 0x23, 0xfc, 0x9b, 0x45, 0x7c, 0xc4, 0x59, 0xee, 0xf5, 0x20,
+	0x40, 0x2f, 0x0f, 0x42, 0x10, 0xcd, 0x69, 0x9e, 0xf6, 0xab, 0xb2, 0x9c, 0x2a, 0x51, 0x9a, 0x17,
+	0xb6, 0x3e, 0x7d, 0xe4, 0x4d, 0x9a, 0x1a, 0x93, 0xff, 0x67, 0x3d, 0x61, 0x26, 0xbe, 0xd9, 0x8f,
+	0xff, 0xbe, 0xd5, 0x66, 0x47, 0xc9, 0xf8, 0x82, 0x9e, 0x41, 0x46, 0xa5, 0x62, 0x11, 0x53, 0x55,
+	0xc0, 0xef, 0x32, 0xc5, 0x8a, 0x8c, 0x41, 0x69, 0x8e, 0x5a, 0x80, 0xdd, 0xd4, 0xf8, 0x55, 0x07,
+	0x38, 0x69, 0x23, 0xfe, 0xf5, 0xb1, 0xff, 0x69, 0x68, 0xdf, 0x9e, 0xff, 0xfc, 0x85, 0x35, 0x0f,
+	0xaf, 0xb6, 0x96, 0xbe, 0xde, 0x5a, 0xfa, 0x66, 0x6b, 0xe9, 0x3f, 0x76, 0x96, 0xb6, 0xde, 0x59,
+	0xda, 0xef, 0x9d, 0xa5, 0x7d, 0xbd, 0x68, 0xcf, 0x22, 0x1c, 0xb5, 0x57, 0xe1, 0xed, 0xdf, 0x01,
+	0x00, 0x59, 0x29, 0xb9, 0xde, 0x6e, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ElasticityMultiplier != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ElasticityMultiplier))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.FeeDenom) > 0 {
+		i -= len(m.FeeDenom)
+		copy(dAtA[i:], m.FeeDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.FeeDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.FeeDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovTypes(uint64(m.BaseFeeChangeDenominator))
+	}
+	if m.ElasticityMultiplier != 0 {
+		n += 1 + sovTypes(uint64(m.ElasticityMultiplier))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElasticityMultiplier", wireType)
+			}
+			m.ElasticityMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElasticityMultiplier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypes(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypes
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypes
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypes
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypes        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypes          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypes = fmt.Errorf("proto: unexpected end of group")
+)
@@ -55,6 +55,11 @@ func GenAppStateFromConfig(cdc codec.JSONMarshaler, config *cfg.Config,
 		return appState, err
 	}
 
+	// validate the resulting validator set before writing the genesis file
+	if err = types.ValidateGenesis(types.GetGenesisStateFromAppState(cdc, appGenesisState)); err != nil {
+		return appState, err
+	}
+
 	appState, err = codec.MarshalJSONIndent(cdc, appGenesisState)
 	if err != nil {
 		return appState, err
@@ -133,7 +138,10 @@ func CollectStdTxs(cdc codec.JSONMarshaler, moniker, genTxsDir string,
 		}
 
 		// TODO abstract out staking message validation back to staking
-		msg := msgs[0].(*stakingtypes.MsgCreateValidator)
+		msg, ok := msgs[0].(*stakingtypes.MsgCreateValidator)
+		if !ok {
+			return appGenTxs, persistentPeers, fmt.Errorf("genesis transaction in %s does not contain a MsgCreateValidator", fo.Name())
+		}
 
 		// validate delegator and validator addresses and funds against the accounts in the state
 		delAddr := msg.DelegatorAddress.String()
@@ -97,8 +97,12 @@ func GenesisStateFromGenFile(cdc codec.JSONMarshaler, genFile string) (genesisSt
 	return genesisState, genDoc, err
 }
 
-// ValidateGenesis validates GenTx transactions
+// ValidateGenesis validates GenTx transactions. Each of them must create a
+// distinct validator, both by operator address and by consensus public key.
 func ValidateGenesis(genesisState GenesisState) error {
+	validators := make(map[string]bool, len(genesisState.GenTxs))
+	pubKeys := make(map[string]bool, len(genesisState.GenTxs))
+
 	for i, genTx := range genesisState.GenTxs {
 		var tx authtypes.StdTx
 		if err := ModuleCdc.UnmarshalJSON(genTx, &tx); err != nil {
@@ -112,10 +116,26 @@ func ValidateGenesis(genesisState GenesisState) error {
 		}
 
 		// TODO: abstract back to staking
-		if _, ok := msgs[0].(*stakingtypes.MsgCreateValidator); !ok {
+		msg, ok := msgs[0].(*stakingtypes.MsgCreateValidator)
+		if !ok {
 			return fmt.Errorf(
 				"genesis transaction %v does not contain a MsgCreateValidator", i)
 		}
+
+		if err := msg.ValidateBasic(); err != nil {
+			return fmt.Errorf("genesis transaction %v: %w", i, err)
+		}
+
+		valAddr := msg.ValidatorAddress.String()
+		if validators[valAddr] {
+			return fmt.Errorf("genesis transaction %v: duplicate validator %s", i, valAddr)
+		}
+		validators[valAddr] = true
+
+		if pubKeys[msg.Pubkey] {
+			return fmt.Errorf("genesis transaction %v: duplicate validator public key %s", i, msg.Pubkey)
+		}
+		pubKeys[msg.Pubkey] = true
 	}
 	return nil
 }
@@ -59,3 +59,26 @@ func TestValidateGenesisBadMessage(t *testing.T) {
 	err := ValidateGenesis(genesisState)
 	require.Error(t, err)
 }
+
+func TestValidateGenesisDuplicateValidators(t *testing.T) {
+	desc := stakingtypes.NewDescription("testname", "", "", "", "")
+	comm := stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	amount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)
+
+	msg1 := stakingtypes.NewMsgCreateValidator(sdk.ValAddress(pk1.Address()), pk1, amount, desc, comm, sdk.OneInt())
+	msg2 := stakingtypes.NewMsgCreateValidator(sdk.ValAddress(pk2.Address()), pk2, amount, desc, comm, sdk.OneInt())
+	sameAddr := stakingtypes.NewMsgCreateValidator(sdk.ValAddress(pk1.Address()), pk2, amount, desc, comm, sdk.OneInt())
+	samePubKey := stakingtypes.NewMsgCreateValidator(sdk.ValAddress(pk2.Address()), pk1, amount, desc, comm, sdk.OneInt())
+
+	genTxs := func(msgs ...sdk.Msg) GenesisState {
+		txs := make([]authtypes.StdTx, len(msgs))
+		for i, msg := range msgs {
+			txs[i] = authtypes.NewStdTx([]sdk.Msg{msg}, authtypes.StdFee{}, nil, "")
+		}
+		return NewGenesisStateFromStdTx(txs)
+	}
+
+	require.NoError(t, ValidateGenesis(genTxs(msg1, msg2)))
+	require.Error(t, ValidateGenesis(genTxs(msg1, sameAddr)))
+	require.Error(t, ValidateGenesis(genTxs(msg1, samePubKey)))
+}
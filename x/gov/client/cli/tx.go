@@ -70,6 +70,7 @@ func NewTxCmd(
 	govTxCmd.AddCommand(flags.PostCommands(
 		NewCmdDeposit(ctx),
 		NewCmdVote(ctx),
+		NewCmdWeightedVote(ctx),
 		cmdSubmitProp,
 	)...)
 
@@ -233,4 +234,52 @@ $ %s tx gov vote 1 yes --from mykey
 	}
 }
 
+// NewCmdWeightedVote implements creating a new weighted vote command.
+func NewCmdWeightedVote(clientCtx client.Context) *cobra.Command {
+	return &cobra.Command{
+		Use:   "weighted-vote [proposal-id] [weighted-options]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an active proposal, splitting the voting power across options: yes/no/no_with_veto/abstain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a vote for an active proposal whose voting power is split
+across several options. The weights must sum up to 1. You can find the
+proposal-id by running "%s query gov proposals".
+
+
+Example:
+$ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.1 --from mykey
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := clientCtx.InitWithInput(cmd.InOrStdin())
+
+			// Get voting address
+			from := clientCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// Figure out which vote options user chose
+			options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+
+			// Build vote message and run basic validation
+			msg := types.NewMsgVoteWeighted(from, proposalID, options)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+}
+
 // DONTCOVER
@@ -77,36 +77,41 @@ func QueryDepositsByTxQuery(clientCtx client.Context, params types.QueryProposal
 // marshalled result or any error that occurred.
 func QueryVotesByTxQuery(clientCtx client.Context, params types.QueryProposalVotesParams) ([]byte, error) {
 	var (
-		events = []string{
-			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
-			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
-		}
 		votes      []types.Vote
-		nextTxPage = defaultPage
 		totalLimit = params.Limit * params.Page
 	)
-	// query interrupted either if we collected enough votes or tx indexer run out of relevant txs
-	for len(votes) < totalLimit {
-		searchResult, err := authclient.QueryTxsByEvents(clientCtx, events, nextTxPage, defaultLimit, "")
-		if err != nil {
-			return nil, err
+
+	// events are matched with AND semantics, so plain and weighted votes are
+	// searched for separately
+	for _, msgType := range []string{types.TypeMsgVote, types.TypeMsgVoteWeighted} {
+		events := []string{
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, msgType),
+			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
 		}
-		nextTxPage++
-		for _, info := range searchResult.Txs {
-			for _, msg := range info.Tx.GetMsgs() {
-				if msg.Type() == types.TypeMsgVote {
-					voteMsg := msg.(*types.MsgVote)
-
-					votes = append(votes, types.Vote{
-						Voter:      voteMsg.Voter,
-						ProposalID: params.ProposalID,
-						Option:     voteMsg.Option,
-					})
+		nextTxPage := defaultPage
+
+		// query interrupted either if we collected enough votes or tx indexer run out of relevant txs
+		for len(votes) < totalLimit {
+			searchResult, err := authclient.QueryTxsByEvents(clientCtx, events, nextTxPage, defaultLimit, "")
+			if err != nil {
+				return nil, err
+			}
+			nextTxPage++
+			for _, info := range searchResult.Txs {
+				for _, msg := range info.Tx.GetMsgs() {
+					// txs with both kinds of votes are returned by both searches
+					if msg.Type() != msgType {
+						continue
+					}
+
+					if vote, ok := voteFromMsg(msg, params.ProposalID); ok {
+						votes = append(votes, vote)
+					}
 				}
 			}
-		}
-		if len(searchResult.Txs) != defaultLimit {
-			break
+			if len(searchResult.Txs) != defaultLimit {
+				break
+			}
 		}
 	}
 	start, end := client.Paginate(len(votes), params.Page, params.Limit, 100)
@@ -123,35 +128,29 @@ func QueryVotesByTxQuery(clientCtx client.Context, params types.QueryProposalVot
 
 // QueryVoteByTxQuery will query for a single vote via a direct txs tags query.
 func QueryVoteByTxQuery(clientCtx client.Context, params types.QueryVoteParams) ([]byte, error) {
-	events := []string{
-		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
-		fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
-		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Voter.String())),
-	}
-
-	// NOTE: SearchTxs is used to facilitate the txs query which does not currently
-	// support configurable pagination.
-	searchResult, err := authclient.QueryTxsByEvents(clientCtx, events, defaultPage, defaultLimit, "")
-	if err != nil {
-		return nil, err
-	}
-	for _, info := range searchResult.Txs {
-		for _, msg := range info.Tx.GetMsgs() {
-			// there should only be a single vote under the given conditions
-			if msg.Type() == types.TypeMsgVote {
-				voteMsg := msg.(*types.MsgVote)
+	for _, msgType := range []string{types.TypeMsgVote, types.TypeMsgVoteWeighted} {
+		events := []string{
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, msgType),
+			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Voter.String())),
+		}
 
-				vote := types.Vote{
-					Voter:      voteMsg.Voter,
-					ProposalID: params.ProposalID,
-					Option:     voteMsg.Option,
-				}
+		// NOTE: SearchTxs is used to facilitate the txs query which does not currently
+		// support configurable pagination.
+		searchResult, err := authclient.QueryTxsByEvents(clientCtx, events, defaultPage, defaultLimit, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range searchResult.Txs {
+			for _, msg := range info.Tx.GetMsgs() {
+				// there should only be a single vote under the given conditions
+				if vote, ok := voteFromMsg(msg, params.ProposalID); ok {
+					if clientCtx.Indent {
+						return clientCtx.Codec.MarshalJSONIndent(vote, "", "  ")
+					}
 
-				if clientCtx.Indent {
-					return clientCtx.Codec.MarshalJSONIndent(vote, "", "  ")
+					return clientCtx.Codec.MarshalJSON(vote)
 				}
-
-				return clientCtx.Codec.MarshalJSON(vote)
 			}
 		}
 	}
@@ -159,6 +158,20 @@ func QueryVoteByTxQuery(clientCtx client.Context, params types.QueryVoteParams)
 	return nil, fmt.Errorf("address '%s' did not vote on proposalID %d", params.Voter, params.ProposalID)
 }
 
+// voteFromMsg builds the vote cast by a MsgVote or MsgVoteWeighted.
+func voteFromMsg(msg sdk.Msg, proposalID uint64) (types.Vote, bool) {
+	switch msg := msg.(type) {
+	case *types.MsgVote:
+		return types.NewVote(proposalID, msg.Voter, types.NewNonSplitVoteOption(msg.Option)), true
+
+	case *types.MsgVoteWeighted:
+		return types.NewVote(proposalID, msg.Voter, msg.Options), true
+
+	default:
+		return types.Vote{}, false
+	}
+}
+
 // QueryDepositByTxQuery will query for a single deposit via a direct txs tags
 // query.
 func QueryDepositByTxQuery(clientCtx client.Context, params types.QueryDepositParams) ([]byte, error) {
@@ -76,8 +76,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				{Msgs: acc2Msgs[:1]},
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc1, types.OptionYes),
-				types.NewVote(0, acc2, types.OptionYes)},
+				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes)),
+				types.NewVote(0, acc2, types.NewNonSplitVoteOption(types.OptionYes))},
 		},
 
 		{
@@ -89,8 +89,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				{Msgs: acc2Msgs},
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc1, types.OptionYes),
-				types.NewVote(0, acc1, types.OptionYes)},
+				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes)),
+				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes))},
 		},
 		{
 			description: "2MsgPerTx2Chunk",
@@ -101,8 +101,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				{Msgs: acc2Msgs},
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc2, types.OptionYes),
-				types.NewVote(0, acc2, types.OptionYes)},
+				types.NewVote(0, acc2, types.NewNonSplitVoteOption(types.OptionYes)),
+				types.NewVote(0, acc2, types.NewNonSplitVoteOption(types.OptionYes))},
 		},
 		{
 			description: "IncompleteSearchTx",
@@ -111,7 +111,7 @@ func TestGetPaginatedVotes(t *testing.T) {
 			txs: []authtypes.StdTx{
 				{Msgs: acc1Msgs[:1]},
 			},
-			votes: []types.Vote{types.NewVote(0, acc1, types.OptionYes)},
+			votes: []types.Vote{types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes))},
 		},
 		{
 			description: "InvalidPage",
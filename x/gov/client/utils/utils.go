@@ -1,6 +1,10 @@
 package utils
 
-import "github.com/cosmos/cosmos-sdk/x/gov/types"
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
 
 // NormalizeVoteOption - normalize user specified vote option
 func NormalizeVoteOption(option string) string {
@@ -22,7 +26,21 @@ func NormalizeVoteOption(option string) string {
 	}
 }
 
-//NormalizeProposalType - normalize user specified proposal type
+// NormalizeWeightedVoteOptions normalizes the option names of user specified
+// weighted vote options, e.g. "yes=0.6,no_with_veto=0.4" becomes
+// "Yes=0.6,NoWithVeto=0.4"
+func NormalizeWeightedVoteOptions(options string) string {
+	newOptions := []string{}
+	for _, option := range strings.Split(options, ",") {
+		fields := strings.Split(option, "=")
+		fields[0] = NormalizeVoteOption(strings.TrimSpace(fields[0]))
+		newOptions = append(newOptions, strings.Join(fields, "="))
+	}
+
+	return strings.Join(newOptions, ",")
+}
+
+// NormalizeProposalType - normalize user specified proposal type
 func NormalizeProposalType(proposalType string) string {
 	switch proposalType {
 	case "Text", "text":
@@ -33,7 +51,7 @@ func NormalizeProposalType(proposalType string) string {
 	}
 }
 
-//NormalizeProposalStatus - normalize user specified proposal status
+// NormalizeProposalStatus - normalize user specified proposal status
 func NormalizeProposalStatus(status string) string {
 	switch status {
 	case "DepositPeriod", "deposit_period":
@@ -24,6 +24,9 @@ func NewHandler(keeper keeper.Keeper) sdk.Handler {
 		case *types.MsgVote:
 			return handleMsgVote(ctx, keeper, msg)
 
+		case *types.MsgVoteWeighted:
+			return handleMsgVoteWeighted(ctx, keeper, msg)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -91,7 +94,24 @@ func handleMsgDeposit(ctx sdk.Context, keeper keeper.Keeper, msg *types.MsgDepos
 }
 
 func handleMsgVote(ctx sdk.Context, keeper keeper.Keeper, msg *types.MsgVote) (*sdk.Result, error) {
-	err := keeper.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Option)
+	err := keeper.AddVote(ctx, msg.ProposalID, msg.Voter, types.NewNonSplitVoteOption(msg.Option))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgVoteWeighted(ctx sdk.Context, keeper keeper.Keeper, msg *types.MsgVoteWeighted) (*sdk.Result, error) {
+	err := keeper.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Options)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Vote implements the Query/Vote gRPC method
+func (keeper Keeper) Vote(c context.Context, req *types.QueryVoteRequest) (*types.QueryVoteResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ProposalID == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	if req.Voter.Empty() {
+		return nil, status.Errorf(codes.InvalidArgument, "empty voter address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	vote, found := keeper.GetVote(ctx, req.ProposalID, req.Voter)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "voter: %s not found for proposal: %d", req.Voter, req.ProposalID)
+	}
+
+	return &types.QueryVoteResponse{Vote: vote}, nil
+}
+
+// Votes implements the Query/Votes gRPC method
+func (keeper Keeper) Votes(c context.Context, req *types.QueryVotesRequest) (*types.QueryVotesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ProposalID == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	votes, pageRes, err := keeper.GetVotesPaginated(ctx, req.ProposalID, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryVotesResponse{Votes: votes, Pagination: pageRes}, nil
+}
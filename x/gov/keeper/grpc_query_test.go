@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestGRPCQueryVotes(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx)
+	types.RegisterQueryServer(queryHelper, app.GovKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	_, err = queryClient.Vote(gocontext.Background(), &types.QueryVoteRequest{})
+	require.Error(t, err)
+
+	_, err = queryClient.Vote(gocontext.Background(), &types.QueryVoteRequest{ProposalID: proposalID, Voter: addrs[0]})
+	require.Error(t, err, "no vote yet")
+
+	weightedOptions := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+		types.NewWeightedVoteOption(types.OptionAbstain, sdk.NewDecWithPrec(4, 1)),
+	}
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], weightedOptions))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionNo)))
+
+	voteRes, err := queryClient.Vote(gocontext.Background(), &types.QueryVoteRequest{ProposalID: proposalID, Voter: addrs[0]})
+	require.NoError(t, err)
+	require.Equal(t, types.NewVote(proposalID, addrs[0], weightedOptions), voteRes.Vote)
+
+	_, err = queryClient.Votes(gocontext.Background(), &types.QueryVotesRequest{})
+	require.Error(t, err)
+
+	votesRes, err := queryClient.Votes(gocontext.Background(), &types.QueryVotesRequest{
+		ProposalID: proposalID,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, votesRes.Votes, 1)
+	require.Equal(t, uint64(2), votesRes.Pagination.Total)
+}
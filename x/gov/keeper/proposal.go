@@ -3,9 +3,10 @@ package keeper
 import (
 	"fmt"
 
-	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -126,10 +127,21 @@ func (keeper Keeper) GetProposals(ctx sdk.Context) (proposals types.Proposals) {
 // NOTE: If no filters are provided, all proposals will be returned in paginated
 // form.
 func (keeper Keeper) GetProposalsFiltered(ctx sdk.Context, params types.QueryProposalsParams) types.Proposals {
-	proposals := keeper.GetProposals(ctx)
-	filteredProposals := make([]types.Proposal, 0, len(proposals))
+	filteredProposals := types.Proposals{}
+
+	pageReq, ok := query.NewPageRequestFromPage(params.Page, params.Limit, query.DefaultLimit)
+	if !ok {
+		return filteredProposals
+	}
+
+	store := prefix.NewStore(ctx.KVStore(keeper.storeKey), types.ProposalsKeyPrefix)
+
+	_, err := query.FilteredPaginate(store, pageReq, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var p types.Proposal
+		if err := keeper.UnmarshalProposal(value, &p); err != nil {
+			return false, err
+		}
 
-	for _, p := range proposals {
 		matchVoter, matchDepositor, matchStatus := true, true, true
 
 		// match status (if supplied/valid)
@@ -147,16 +159,18 @@ func (keeper Keeper) GetProposalsFiltered(ctx sdk.Context, params types.QueryPro
 			_, matchDepositor = keeper.GetDeposit(ctx, p.ProposalID, params.Depositor)
 		}
 
-		if matchVoter && matchDepositor && matchStatus {
+		if !(matchVoter && matchDepositor && matchStatus) {
+			return false, nil
+		}
+
+		if accumulate {
 			filteredProposals = append(filteredProposals, p)
 		}
-	}
 
-	start, end := client.Paginate(len(filteredProposals), params.Page, params.Limit, 100)
-	if start < 0 || end < 0 {
-		filteredProposals = []types.Proposal{}
-	} else {
-		filteredProposals = filteredProposals[start:end]
+		return true, nil
+	})
+	if err != nil {
+		panic(err)
 	}
 
 	return filteredProposals
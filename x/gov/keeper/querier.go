@@ -3,10 +3,10 @@ package keeper
 import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
-	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -193,15 +193,11 @@ func queryVotes(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	votes := keeper.GetVotes(ctx, params.ProposalID)
-	if votes == nil {
-		votes = types.Votes{}
-	} else {
-		start, end := client.Paginate(len(votes), params.Page, params.Limit, 100)
-		if start < 0 || end < 0 {
-			votes = types.Votes{}
-		} else {
-			votes = votes[start:end]
+	votes := types.Votes{}
+	if pageReq, ok := query.NewPageRequestFromPage(params.Page, params.Limit, query.DefaultLimit); ok {
+		votes, _, err = keeper.GetVotesPaginated(ctx, params.ProposalID, pageReq)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 		}
 	}
 
@@ -27,7 +27,7 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 			validator.GetBondedTokens(),
 			validator.GetDelegatorShares(),
 			sdk.ZeroDec(),
-			types.OptionEmpty,
+			nil,
 		)
 
 		return false
@@ -37,7 +37,7 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 		// if validator, just record it in the map
 		valAddrStr := sdk.ValAddress(vote.Voter).String()
 		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Option
+			val.Vote = vote.VoteOptions()
 			currValidators[valAddrStr] = val
 		}
 
@@ -54,7 +54,10 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 				delegatorShare := delegation.GetShares().Quo(val.DelegatorShares)
 				votingPower := delegatorShare.MulInt(val.BondedTokens)
 
-				results[vote.Option] = results[vote.Option].Add(votingPower)
+				for _, option := range vote.VoteOptions() {
+					subPower := votingPower.Mul(option.Weight)
+					results[option.Option] = results[option.Option].Add(subPower)
+				}
 				totalVotingPower = totalVotingPower.Add(votingPower)
 			}
 
@@ -67,7 +70,7 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 
 	// iterate over the validators again to tally their voting power
 	for _, val := range currValidators {
-		if val.Vote == types.OptionEmpty {
+		if len(val.Vote) == 0 {
 			continue
 		}
 
@@ -75,7 +78,10 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 		fractionAfterDeductions := sharesAfterDeductions.Quo(val.DelegatorShares)
 		votingPower := fractionAfterDeductions.MulInt(val.BondedTokens)
 
-		results[val.Vote] = results[val.Vote].Add(votingPower)
+		for _, option := range val.Vote {
+			subPower := votingPower.Mul(option.Weight)
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
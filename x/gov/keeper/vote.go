@@ -3,13 +3,16 @@ package keeper
 import (
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
-// AddVote adds a vote on a specific proposal
-func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, option types.VoteOption) error {
+// AddVote adds a vote on a specific proposal. The voting power of the voter is
+// split across the given weighted options.
+func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions) error {
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
 		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
@@ -18,17 +21,17 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
 
-	if !types.ValidVoteOption(option) {
-		return sdkerrors.Wrap(types.ErrInvalidVote, option.String())
+	if err := types.ValidWeightedVoteOptions(options); err != nil {
+		return err
 	}
 
-	vote := types.NewVote(proposalID, voterAddr, option)
+	vote := types.NewVote(proposalID, voterAddr, options)
 	keeper.SetVote(ctx, vote)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeProposalVote,
-			sdk.NewAttribute(types.AttributeKeyOption, option.String()),
+			sdk.NewAttribute(types.AttributeKeyOption, options.String()),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
 		),
 	)
@@ -54,6 +57,30 @@ func (keeper Keeper) GetVotes(ctx sdk.Context, proposalID uint64) (votes types.V
 	return
 }
 
+// GetVotesPaginated returns the page of votes on the given proposal selected by
+// pageReq.
+func (keeper Keeper) GetVotesPaginated(
+	ctx sdk.Context, proposalID uint64, pageReq *query.PageRequest,
+) (types.Votes, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(keeper.storeKey), types.VotesKey(proposalID))
+
+	votes := types.Votes{}
+	pageRes, err := query.Paginate(store, pageReq, func(_ []byte, value []byte) error {
+		var vote types.Vote
+		if err := keeper.cdc.UnmarshalBinaryBare(value, &vote); err != nil {
+			return err
+		}
+
+		votes = append(votes, vote)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return votes, pageRes, nil
+}
+
 // GetVote gets the vote from an address on a specific proposal
 func (keeper Keeper) GetVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) (vote types.Vote, found bool) {
 	store := ctx.KVStore(keeper.storeKey)
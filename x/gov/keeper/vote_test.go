@@ -24,16 +24,20 @@ func TestVotes(t *testing.T) {
 
 	var invalidOption types.VoteOption = 0x10
 
-	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.OptionYes), "proposal not on voting period")
-	require.Error(t, app.GovKeeper.AddVote(ctx, 10, addrs[0], types.OptionYes), "invalid proposal ID")
+	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)), "proposal not on voting period")
+	require.Error(t, app.GovKeeper.AddVote(ctx, 10, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)), "invalid proposal ID")
 
 	proposal.Status = types.StatusVotingPeriod
 	app.GovKeeper.SetProposal(ctx, proposal)
 
-	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], invalidOption), "invalid option")
+	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(invalidOption)), "invalid option")
+	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(5, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(4, 1)),
+	}), "weights don't sum up to 1")
 
 	// Test first vote
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.OptionAbstain))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionAbstain)))
 	vote, found := app.GovKeeper.GetVote(ctx, proposalID, addrs[0])
 	require.True(t, found)
 	require.Equal(t, addrs[0], vote.Voter)
@@ -41,7 +45,7 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, types.OptionAbstain, vote.Option)
 
 	// Test change of vote
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.OptionYes))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
 	vote, found = app.GovKeeper.GetVote(ctx, proposalID, addrs[0])
 	require.True(t, found)
 	require.Equal(t, addrs[0], vote.Voter)
@@ -49,17 +53,35 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, types.OptionYes, vote.Option)
 
 	// Test second vote
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.OptionNoWithVeto))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionNoWithVeto)))
 	vote, found = app.GovKeeper.GetVote(ctx, proposalID, addrs[1])
 	require.True(t, found)
 	require.Equal(t, addrs[1], vote.Voter)
 	require.Equal(t, proposalID, vote.ProposalID)
 	require.Equal(t, types.OptionNoWithVeto, vote.Option)
 
+	// Test weighted vote
+	weightedOptions := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+		types.NewWeightedVoteOption(types.OptionAbstain, sdk.NewDecWithPrec(4, 1)),
+	}
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], weightedOptions))
+	vote, found = app.GovKeeper.GetVote(ctx, proposalID, addrs[2])
+	require.True(t, found)
+	require.Equal(t, weightedOptions, vote.Options)
+	require.Equal(t, types.OptionEmpty, vote.Option)
+
+	// votes without weighted options are read as non-split votes
+	legacyVote := types.Vote{ProposalID: proposalID, Voter: addrs[3], Option: types.OptionNo}
+	app.GovKeeper.SetVote(ctx, legacyVote)
+	vote, found = app.GovKeeper.GetVote(ctx, proposalID, addrs[3])
+	require.True(t, found)
+	require.Equal(t, types.NewNonSplitVoteOption(types.OptionNo), vote.VoteOptions())
+
 	// Test vote iterator
 	// NOTE order of deposits is determined by the addresses
 	votes := app.GovKeeper.GetAllVotes(ctx)
-	require.Len(t, votes, 2)
+	require.Len(t, votes, 4)
 	require.Equal(t, votes, app.GovKeeper.GetVotes(ctx, proposalID))
 	require.Equal(t, addrs[0], votes[0].Voter)
 	require.Equal(t, proposalID, votes[0].ProposalID)
@@ -20,8 +20,9 @@ var initialProposalID = uint64(100000000000000)
 
 // Simulation operation weights constants
 const (
-	OpWeightMsgDeposit = "op_weight_msg_deposit"
-	OpWeightMsgVote    = "op_weight_msg_vote"
+	OpWeightMsgDeposit      = "op_weight_msg_deposit"
+	OpWeightMsgVote         = "op_weight_msg_vote"
+	OpWeightMsgVoteWeighted = "op_weight_msg_weighted_vote"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -31,8 +32,9 @@ func WeightedOperations(
 ) simulation.WeightedOperations {
 
 	var (
-		weightMsgDeposit int
-		weightMsgVote    int
+		weightMsgDeposit      int
+		weightMsgVote         int
+		weightMsgVoteWeighted int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgDeposit, &weightMsgDeposit, nil,
@@ -47,6 +49,12 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgVoteWeighted, &weightMsgVoteWeighted, nil,
+		func(_ *rand.Rand) {
+			weightMsgVoteWeighted = simappparams.DefaultWeightMsgVoteWeighted
+		},
+	)
+
 	// generate the weighted operations for the proposal contents
 	var wProposalOps simulation.WeightedOperations
 
@@ -74,6 +82,10 @@ func WeightedOperations(
 			weightMsgVote,
 			SimulateMsgVote(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgVoteWeighted,
+			SimulateMsgVoteWeighted(ak, bk, k),
+		),
 	}
 
 	return append(wProposalOps, wGovOps...)
@@ -301,6 +313,49 @@ func operationSimulateMsgVote(ak types.AccountKeeper, bk types.BankKeeper, k kee
 	}
 }
 
+// SimulateMsgVoteWeighted generates a MsgVoteWeighted with random values.
+func SimulateMsgVoteWeighted(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		proposalID, ok := randomProposalID(r, k, ctx, types.StatusVotingPeriod)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgVoteWeighted, "unable to generate proposalID"), nil, nil
+		}
+
+		options := randomWeightedVotingOptions(r)
+		msg := types.NewMsgVoteWeighted(simAccount.Address, proposalID, options)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
 // Pick a random deposit with a random denomination with a
 // deposit amount between (0, min(balance, minDepositAmount))
 // This is to simulate multiple users depositing to get the
@@ -379,3 +434,32 @@ func randomVotingOption(r *rand.Rand) types.VoteOption {
 		panic("invalid vote option")
 	}
 }
+
+// randomWeightedVotingOptions splits a full vote between a random subset of the
+// voting options. The weights are whole percentages that always sum to one.
+func randomWeightedVotingOptions(r *rand.Rand) types.WeightedVoteOptions {
+	options := []types.VoteOption{types.OptionYes, types.OptionAbstain, types.OptionNo, types.OptionNoWithVeto}
+	r.Shuffle(len(options), func(i, j int) { options[i], options[j] = options[j], options[i] })
+
+	var weighted types.WeightedVoteOptions
+
+	remaining := int64(100)
+	for i, option := range options {
+		weight := remaining
+		if i < len(options)-1 {
+			weight = r.Int63n(remaining + 1)
+		}
+		if weight == 0 {
+			continue
+		}
+
+		weighted = append(weighted, types.NewWeightedVoteOption(option, sdk.NewDecWithPrec(weight, 2)))
+
+		remaining -= weight
+		if remaining == 0 {
+			break
+		}
+	}
+
+	return weighted
+}
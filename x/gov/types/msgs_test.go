@@ -118,6 +118,69 @@ func TestMsgVote(t *testing.T) {
 	}
 }
 
+func TestMsgVoteWeighted(t *testing.T) {
+	tests := []struct {
+		proposalID uint64
+		voterAddr  sdk.AccAddress
+		options    WeightedVoteOptions
+		expectPass bool
+	}{
+		{0, addrs[0], NewNonSplitVoteOption(OptionYes), true},
+		{0, sdk.AccAddress{}, NewNonSplitVoteOption(OptionYes), false},
+		{0, addrs[0], WeightedVoteOptions{}, false},
+		{0, addrs[0], NewNonSplitVoteOption(VoteOption(0x13)), false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+			NewWeightedVoteOption(OptionAbstain, sdk.NewDecWithPrec(4, 1)),
+		}, true},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(4, 1)),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(3, 1)),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(11, 1)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(-1, 1)),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.OneDec()),
+			NewWeightedVoteOption(OptionNo, sdk.ZeroDec()),
+		}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgVoteWeighted(tc.voterAddr, tc.proposalID, tc.options)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestWeightedVoteOptionsFromString(t *testing.T) {
+	options, err := WeightedVoteOptionsFromString("Yes=0.6,Abstain=0.4")
+	require.NoError(t, err)
+	require.Equal(t, WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+		NewWeightedVoteOption(OptionAbstain, sdk.NewDecWithPrec(4, 1)),
+	}, options)
+
+	parsed, err := WeightedVoteOptionsFromString(options.String())
+	require.NoError(t, err)
+	require.Equal(t, options, parsed)
+
+	_, err = WeightedVoteOptionsFromString("Yes")
+	require.Error(t, err)
+	_, err = WeightedVoteOptionsFromString("Maybe=1")
+	require.Error(t, err)
+	_, err = WeightedVoteOptionsFromString("Yes=abc")
+	require.Error(t, err)
+}
+
 // this tests that Amino JSON MsgSubmitProposal.GetSignBytes() still works with Content as Any using the ModuleCdc
 func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
 	msg, err := NewMsgSubmitProposal(NewTextProposal("test", "abcd"), sdk.NewCoins(), sdk.AccAddress{})
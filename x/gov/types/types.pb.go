@@ -187,6 +187,85 @@ func (m *MsgVote) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVote proto.InternalMessageInfo
 
+// MsgVoteWeighted defines a message to cast a vote whose voting power is split
+// across several options
+type MsgVoteWeighted struct {
+	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
+	Voter      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
+	Options    WeightedVoteOptions                           `protobuf:"bytes,3,rep,name=options,proto3,castrepeated=WeightedVoteOptions" json:"options"`
+}
+
+func (m *MsgVoteWeighted) Reset()      { *m = MsgVoteWeighted{} }
+func (*MsgVoteWeighted) ProtoMessage() {}
+func (*MsgVoteWeighted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{2}
+}
+func (m *MsgVoteWeighted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteWeighted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteWeighted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteWeighted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteWeighted.Merge(m, src)
+}
+func (m *MsgVoteWeighted) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteWeighted) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteWeighted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteWeighted proto.InternalMessageInfo
+
+// WeightedVoteOption defines the share of a vote's voting power given to a
+// single vote option
+type WeightedVoteOption struct {
+	Option VoteOption                             `protobuf:"varint,1,opt,name=option,proto3,enum=cosmos_sdk.x.gov.v1.VoteOption" json:"option,omitempty"`
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"weight"`
+}
+
+func (m *WeightedVoteOption) Reset()      { *m = WeightedVoteOption{} }
+func (*WeightedVoteOption) ProtoMessage() {}
+func (*WeightedVoteOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{3}
+}
+func (m *WeightedVoteOption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedVoteOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedVoteOption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedVoteOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedVoteOption.Merge(m, src)
+}
+func (m *WeightedVoteOption) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedVoteOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedVoteOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedVoteOption proto.InternalMessageInfo
+
 // MsgDeposit defines a message to submit a deposit to an existing proposal
 type MsgDeposit struct {
 	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
@@ -197,7 +276,7 @@ type MsgDeposit struct {
 func (m *MsgDeposit) Reset()      { *m = MsgDeposit{} }
 func (*MsgDeposit) ProtoMessage() {}
 func (*MsgDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5ae5e91b5b3fb03, []int{2}
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{4}
 }
 func (m *MsgDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -236,7 +315,7 @@ type TextProposal struct {
 func (m *TextProposal) Reset()      { *m = TextProposal{} }
 func (*TextProposal) ProtoMessage() {}
 func (*TextProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5ae5e91b5b3fb03, []int{3}
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{5}
 }
 func (m *TextProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,7 +354,7 @@ type Deposit struct {
 func (m *Deposit) Reset()      { *m = Deposit{} }
 func (*Deposit) ProtoMessage() {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5ae5e91b5b3fb03, []int{4}
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{6}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -320,7 +399,7 @@ type Proposal struct {
 func (m *Proposal) Reset()      { *m = Proposal{} }
 func (*Proposal) ProtoMessage() {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5ae5e91b5b3fb03, []int{5}
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{7}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -360,7 +439,7 @@ type TallyResult struct {
 func (m *TallyResult) Reset()      { *m = TallyResult{} }
 func (*TallyResult) ProtoMessage() {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5ae5e91b5b3fb03, []int{6}
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{8}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -390,17 +469,20 @@ func (m *TallyResult) XXX_DiscardUnknown() {
 var xxx_messageInfo_TallyResult proto.InternalMessageInfo
 
 // Vote defines a vote on a governance proposal. A vote corresponds to a proposal
-// ID, the voter, and the vote option.
+// ID, the voter, and the weighted vote options.
 type Vote struct {
 	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
 	Voter      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
-	Option     VoteOption                                    `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos_sdk.x.gov.v1.VoteOption" json:"option,omitempty"`
+	// option is the vote option of a non-split vote and VOTE_OPTION_UNSPECIFIED
+	// for a split vote. It is kept for clients that don't support weighted votes.
+	Option  VoteOption          `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos_sdk.x.gov.v1.VoteOption" json:"option,omitempty"`
+	Options WeightedVoteOptions `protobuf:"bytes,4,rep,name=options,proto3,castrepeated=WeightedVoteOptions" json:"options"`
 }
 
 func (m *Vote) Reset()      { *m = Vote{} }
 func (*Vote) ProtoMessage() {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5ae5e91b5b3fb03, []int{7}
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{9}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -434,6 +516,8 @@ func init() {
 	proto.RegisterEnum("cosmos_sdk.x.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos_sdk.x.gov.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgVote)(nil), "cosmos_sdk.x.gov.v1.MsgVote")
+	proto.RegisterType((*MsgVoteWeighted)(nil), "cosmos_sdk.x.gov.v1.MsgVoteWeighted")
+	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos_sdk.x.gov.v1.WeightedVoteOption")
 	proto.RegisterType((*MsgDeposit)(nil), "cosmos_sdk.x.gov.v1.MsgDeposit")
 	proto.RegisterType((*TextProposal)(nil), "cosmos_sdk.x.gov.v1.TextProposal")
 	proto.RegisterType((*Deposit)(nil), "cosmos_sdk.x.gov.v1.Deposit")
@@ -445,87 +529,93 @@ func init() {
 func init() { proto.RegisterFile("x/gov/types/types.proto", fileDescriptor_a5ae5e91b5b3fb03) }
 
 var fileDescriptor_a5ae5e91b5b3fb03 = []byte{
-	// 1275 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xbd, 0x6f, 0xdb, 0xd6,
-	0x17, 0x15, 0xe9, 0x0f, 0xd9, 0x4f, 0xb2, 0x4d, 0x3f, 0x1b, 0xb1, 0xc2, 0x1f, 0x7e, 0x24, 0xa3,
-	0x04, 0x81, 0x91, 0x26, 0x74, 0xe2, 0x0c, 0x45, 0x53, 0xa0, 0xa8, 0x64, 0x31, 0x89, 0x82, 0x58,
-	0x12, 0x28, 0xc6, 0x46, 0x5a, 0x14, 0x04, 0x2d, 0xbe, 0xc8, 0x6c, 0x24, 0x3e, 0x55, 0xef, 0x59,
-	0x8d, 0xb6, 0xa0, 0x43, 0x11, 0x68, 0xca, 0xd8, 0x45, 0x40, 0x81, 0x66, 0x28, 0x3a, 0x65, 0xe8,
-	0x1f, 0x61, 0x74, 0x0a, 0x8a, 0x16, 0x08, 0x3a, 0x28, 0x8d, 0x33, 0xb4, 0xe8, 0xd0, 0x21, 0x63,
-	0xa7, 0x82, 0x7c, 0x8f, 0x11, 0x2d, 0x3b, 0x4d, 0xdc, 0x0f, 0xa0, 0xe8, 0x62, 0x98, 0x97, 0xe7,
-	0x9c, 0xfb, 0xee, 0xe1, 0xbd, 0x97, 0x14, 0x58, 0xba, 0xb3, 0x52, 0xc7, 0x9d, 0x15, 0xda, 0x6d,
-	0x21, 0xc2, 0xfe, 0xea, 0xad, 0x36, 0xa6, 0x18, 0x2e, 0xd4, 0x30, 0x69, 0x62, 0x62, 0x13, 0xf7,
-	0xb6, 0x7e, 0x47, 0xaf, 0xe3, 0x8e, 0xde, 0xb9, 0x20, 0xcf, 0x1f, 0xc0, 0xc9, 0xa7, 0xe9, 0xb6,
-	0xd7, 0x76, 0xed, 0x96, 0xd3, 0xa6, 0xdd, 0x95, 0x30, 0xb4, 0x52, 0xc7, 0x75, 0x3c, 0xfc, 0x8f,
-	0xe3, 0xde, 0x38, 0x88, 0x63, 0x19, 0xce, 0xc5, 0x2f, 0x38, 0x58, 0xad, 0x63, 0x5c, 0x6f, 0x20,
-	0x86, 0xdb, 0xda, 0xb9, 0xb5, 0x42, 0xbd, 0x26, 0x22, 0xd4, 0x69, 0xb6, 0x38, 0xe0, 0xf8, 0x28,
-	0xc0, 0xf1, 0xbb, 0xec, 0x56, 0xf6, 0xa1, 0x08, 0xe6, 0xd7, 0x49, 0xbd, 0xba, 0xb3, 0xd5, 0xf4,
-	0x68, 0xa5, 0x8d, 0x5b, 0x98, 0x38, 0x0d, 0xf8, 0x36, 0x48, 0xd6, 0xb0, 0x4f, 0x91, 0x4f, 0x33,
-	0x82, 0x26, 0x2c, 0xa7, 0x56, 0x17, 0x75, 0x26, 0xa1, 0x47, 0x12, 0x7a, 0xce, 0xef, 0xe6, 0x53,
-	0xdf, 0x7c, 0x7d, 0x2e, 0xb9, 0xc6, 0x80, 0x66, 0xc4, 0x80, 0xf7, 0x04, 0x30, 0xe7, 0xf9, 0x1e,
-	0xf5, 0x9c, 0x86, 0xed, 0xa2, 0x16, 0x26, 0x1e, 0xcd, 0x88, 0xda, 0xd8, 0x72, 0x6a, 0x75, 0x41,
-	0x8f, 0xd9, 0xd4, 0xb9, 0xa0, 0xaf, 0x61, 0xcf, 0xcf, 0x5f, 0xdb, 0x1d, 0xa8, 0x89, 0xe7, 0x03,
-	0xf5, 0x58, 0xd7, 0x69, 0x36, 0x2e, 0x65, 0x47, 0x98, 0xd9, 0xaf, 0x9e, 0xa8, 0xcb, 0x75, 0x8f,
-	0x6e, 0xef, 0x6c, 0xe9, 0x35, 0xdc, 0xe4, 0x85, 0x47, 0x66, 0x10, 0xf7, 0x36, 0xb7, 0x37, 0x90,
-	0x22, 0xe6, 0x2c, 0x67, 0x17, 0x18, 0x19, 0xae, 0x83, 0xa9, 0x56, 0x58, 0x13, 0x6a, 0x67, 0xc6,
-	0x34, 0x61, 0x39, 0x9d, 0xbf, 0xf0, 0xdb, 0x40, 0x3d, 0xf7, 0x1a, 0x7a, 0xb9, 0x5a, 0x2d, 0xe7,
-	0xba, 0x6d, 0x44, 0x88, 0xf9, 0x42, 0xe2, 0xd2, 0xf8, 0xcf, 0x9f, 0xab, 0x42, 0xf6, 0x27, 0x01,
-	0x24, 0xd7, 0x49, 0x7d, 0x03, 0x53, 0x04, 0x2d, 0x90, 0x6a, 0x71, 0xd3, 0x6c, 0xcf, 0x0d, 0xcd,
-	0x1a, 0xcf, 0x5f, 0xdc, 0x1b, 0xa8, 0x20, 0xf2, 0xb2, 0x58, 0xf8, 0x65, 0xa0, 0xc6, 0x41, 0xcf,
-	0x07, 0x2a, 0x64, 0xa5, 0xc6, 0x82, 0x59, 0x13, 0x44, 0x57, 0x45, 0x17, 0x5e, 0x01, 0x13, 0x1d,
-	0x4c, 0x51, 0x3b, 0x23, 0xfe, 0xd9, 0x33, 0x33, 0x3e, 0x7c, 0x13, 0x4c, 0xe2, 0x16, 0xf5, 0xb0,
-	0x1f, 0x56, 0x3f, 0xbb, 0xaa, 0xea, 0x87, 0xf4, 0xa9, 0x1e, 0x54, 0x52, 0x0e, 0x61, 0x26, 0x87,
-	0xf3, 0x4a, 0x3f, 0x13, 0x01, 0x58, 0x27, 0xf5, 0xc8, 0xcd, 0x7f, 0xa6, 0xd8, 0x32, 0x98, 0xe6,
-	0xcf, 0x1a, 0xff, 0x85, 0x82, 0x87, 0x1a, 0xf0, 0x03, 0x30, 0xe9, 0x34, 0xf1, 0x8e, 0x4f, 0x33,
-	0x63, 0x2f, 0xef, 0xba, 0xf3, 0x41, 0xd7, 0x1d, 0xa9, 0xb7, 0xb8, 0x28, 0xb7, 0x66, 0x13, 0xa4,
-	0x2d, 0x74, 0x67, 0x38, 0x31, 0x8b, 0x60, 0x82, 0x7a, 0xb4, 0x81, 0x42, 0x57, 0xa6, 0x4d, 0x76,
-	0x01, 0x35, 0x90, 0x72, 0x11, 0xa9, 0xb5, 0x3d, 0xf6, 0x10, 0xc4, 0xf0, 0x5e, 0x3c, 0x74, 0x69,
-	0x2e, 0x50, 0xfb, 0x76, 0x38, 0x46, 0xd9, 0x4f, 0x45, 0x90, 0x8c, 0x0c, 0x37, 0x0e, 0x33, 0xfc,
-	0xd4, 0x7e, 0xc3, 0xff, 0xb3, 0x0e, 0xdf, 0x4d, 0x82, 0xa9, 0x17, 0xf6, 0xe6, 0x0f, 0x73, 0xe2,
-	0xc4, 0x81, 0xd6, 0x13, 0xc3, 0x8e, 0x9b, 0xe6, 0x9b, 0x64, 0xc4, 0x86, 0xd8, 0x52, 0x13, 0x8f,
-	0xbc, 0xd4, 0x36, 0xc1, 0x24, 0xa1, 0x0e, 0xdd, 0x21, 0x7c, 0x92, 0x4e, 0x1e, 0x3a, 0x49, 0xd1,
-	0x61, 0xaa, 0x21, 0x34, 0x2f, 0x0f, 0xd7, 0xda, 0x8b, 0xd3, 0x33, 0x95, 0xac, 0xc9, 0xe5, 0xe0,
-	0x47, 0x00, 0xde, 0xf2, 0x7c, 0xa7, 0x61, 0x53, 0xa7, 0xd1, 0xe8, 0xda, 0x6d, 0x44, 0x76, 0x1a,
-	0x34, 0x33, 0x1e, 0x1e, 0x50, 0x3b, 0x34, 0x89, 0x15, 0x00, 0xcd, 0x10, 0x97, 0x3f, 0xc1, 0x97,
-	0xe7, 0x71, 0x96, 0xe5, 0xa0, 0x52, 0xd6, 0x94, 0xc2, 0x60, 0x8c, 0x04, 0xdf, 0x07, 0x29, 0x12,
-	0xee, 0x7b, 0x3b, 0x78, 0x51, 0x64, 0x26, 0xc2, 0x5c, 0xf2, 0x01, 0x33, 0xac, 0xe8, 0x2d, 0x92,
-	0x57, 0x78, 0x16, 0xde, 0x68, 0x31, 0x72, 0xf6, 0xfe, 0x13, 0x55, 0x30, 0x01, 0x8b, 0x04, 0x04,
-	0xe8, 0x01, 0x89, 0x37, 0x8a, 0x8d, 0x7c, 0x97, 0x65, 0x98, 0x7c, 0x65, 0x86, 0x93, 0x3c, 0xc3,
-	0x12, 0xcb, 0x30, 0xaa, 0xc0, 0xd2, 0xcc, 0xf2, 0xb0, 0xe1, 0xbb, 0x61, 0xaa, 0x4f, 0x04, 0x30,
-	0x43, 0x31, 0x8d, 0xbd, 0x66, 0x92, 0x2f, 0x6f, 0xc7, 0xab, 0x3c, 0xc3, 0x22, 0xcb, 0xb0, 0x8f,
-	0x77, 0xb4, 0x97, 0x4c, 0x3a, 0xe4, 0x46, 0x33, 0xda, 0x00, 0xf3, 0x1d, 0x4c, 0x3d, 0xbf, 0x1e,
-	0x3c, 0xd9, 0x36, 0xb7, 0x74, 0xea, 0x95, 0x05, 0x9f, 0xe2, 0xc7, 0xc9, 0xb0, 0xe3, 0x1c, 0x90,
-	0x60, 0x15, 0xcf, 0xb1, 0x78, 0x35, 0x08, 0x87, 0x25, 0xdf, 0x02, 0x3c, 0x34, 0x34, 0x77, 0xfa,
-	0x95, 0xb9, 0xb2, 0xfb, 0xdf, 0xb0, 0x23, 0x02, 0x2c, 0xd3, 0x0c, 0x8b, 0x72, 0x6b, 0xf9, 0x08,
-	0xee, 0x8a, 0x20, 0x15, 0x6f, 0x9c, 0x77, 0xc1, 0x58, 0x17, 0x11, 0xb6, 0xe2, 0xf2, 0x7a, 0xa0,
-	0xfa, 0xc3, 0x40, 0x3d, 0xfd, 0x1a, 0xc6, 0x15, 0x7d, 0x6a, 0x06, 0x54, 0x78, 0x15, 0x24, 0x9d,
-	0x2d, 0x42, 0x1d, 0x8f, 0x2f, 0xc3, 0x23, 0xab, 0x44, 0x74, 0xf8, 0x0e, 0x10, 0x7d, 0x1c, 0x0e,
-	0xe3, 0xd1, 0x45, 0x44, 0x1f, 0xc3, 0x3a, 0x48, 0xfb, 0xd8, 0xfe, 0xd8, 0xa3, 0xdb, 0x76, 0x07,
-	0x51, 0x1c, 0x4e, 0xdc, 0x74, 0xde, 0x38, 0x9a, 0xd2, 0xf3, 0x81, 0xba, 0xc0, 0x4c, 0x8d, 0x6b,
-	0x65, 0x4d, 0xe0, 0xe3, 0x4d, 0x8f, 0x6e, 0x6f, 0x20, 0x8a, 0xb9, 0x95, 0xdf, 0x0b, 0x60, 0x3c,
-	0xfc, 0x62, 0xf8, 0x9b, 0x76, 0xfa, 0xbf, 0xe4, 0x13, 0xe1, 0xcc, 0xaf, 0x02, 0x00, 0xc3, 0x9b,
-	0xf0, 0x2c, 0x58, 0xda, 0x28, 0x5b, 0x86, 0x5d, 0xae, 0x58, 0xc5, 0x72, 0xc9, 0xbe, 0x51, 0xaa,
-	0x56, 0x8c, 0xb5, 0xe2, 0xe5, 0xa2, 0x51, 0x90, 0x12, 0xf2, 0x5c, 0xaf, 0xaf, 0xa5, 0x18, 0xd0,
-	0x68, 0xb6, 0x68, 0x17, 0x66, 0xc1, 0x5c, 0x1c, 0x7d, 0xd3, 0xa8, 0x4a, 0x82, 0x3c, 0xd3, 0xeb,
-	0x6b, 0xd3, 0x0c, 0x75, 0x13, 0x11, 0x78, 0x06, 0x2c, 0xc4, 0x31, 0xb9, 0x7c, 0xd5, 0xca, 0x15,
-	0x4b, 0x92, 0x28, 0xcf, 0xf7, 0xfa, 0xda, 0x0c, 0xc3, 0xe5, 0x78, 0x4f, 0x68, 0x60, 0x36, 0x8e,
-	0x2d, 0x95, 0xa5, 0x31, 0x39, 0xdd, 0xeb, 0x6b, 0x53, 0x0c, 0x56, 0xc2, 0x70, 0x15, 0x64, 0xf6,
-	0x23, 0xec, 0xcd, 0xa2, 0x75, 0xd5, 0xde, 0x30, 0xac, 0xb2, 0x34, 0x2e, 0x2f, 0xf6, 0xfa, 0x9a,
-	0x14, 0x61, 0xa3, 0x07, 0x28, 0xa7, 0xef, 0x7d, 0xa1, 0x24, 0xbe, 0x7c, 0xa0, 0x24, 0x1e, 0x3e,
-	0x50, 0x12, 0x67, 0xbe, 0x13, 0xc1, 0xec, 0xfe, 0x35, 0x0f, 0x75, 0xf0, 0xbf, 0x8a, 0x59, 0xae,
-	0x94, 0xab, 0xb9, 0xeb, 0x76, 0xd5, 0xca, 0x59, 0x37, 0xaa, 0x23, 0x85, 0x87, 0x25, 0x31, 0x70,
-	0xc9, 0x0b, 0xbe, 0xae, 0x95, 0x51, 0x7c, 0xc1, 0xa8, 0x94, 0xab, 0x45, 0xcb, 0xae, 0x18, 0x66,
-	0xb1, 0x5c, 0x90, 0x04, 0x79, 0xa9, 0xd7, 0xd7, 0x16, 0x18, 0x85, 0x6f, 0x9a, 0x0a, 0x6a, 0x7b,
-	0xd8, 0x85, 0x6f, 0x81, 0xff, 0x8f, 0x92, 0x37, 0xca, 0x56, 0xb1, 0x74, 0x25, 0xe2, 0x8a, 0xf2,
-	0xb1, 0x5e, 0x5f, 0x83, 0x8c, 0xbb, 0x11, 0x4e, 0x35, 0xa7, 0x9e, 0x05, 0xc7, 0x46, 0xa9, 0x95,
-	0x5c, 0xb5, 0x6a, 0x14, 0xa4, 0x31, 0x59, 0xea, 0xf5, 0xb5, 0x34, 0xe3, 0x54, 0x1c, 0x42, 0x90,
-	0x0b, 0xcf, 0x83, 0xcc, 0x28, 0xda, 0x34, 0xae, 0x19, 0x6b, 0x96, 0x51, 0x90, 0xc6, 0x65, 0xd8,
-	0xeb, 0x6b, 0xb3, 0x0c, 0x6f, 0xa2, 0x0f, 0x51, 0x8d, 0xa2, 0x43, 0xf5, 0x2f, 0xe7, 0x8a, 0xd7,
-	0x8d, 0x82, 0x34, 0x11, 0xd7, 0xbf, 0xec, 0x78, 0x0d, 0xe4, 0xee, 0xb7, 0x35, 0x5f, 0xda, 0x7d,
-	0xaa, 0x24, 0x1e, 0x3f, 0x55, 0x12, 0x77, 0xf7, 0x94, 0xc4, 0xee, 0x9e, 0x22, 0x3c, 0xda, 0x53,
-	0x84, 0x1f, 0xf7, 0x14, 0xe1, 0xfe, 0x33, 0x25, 0xf1, 0xe8, 0x99, 0x92, 0x78, 0xfc, 0x4c, 0x49,
-	0xbc, 0xf7, 0xc7, 0x4b, 0x3a, 0xf6, 0xe3, 0x6c, 0x6b, 0x32, 0xdc, 0x83, 0x17, 0x7f, 0x0f, 0x00,
-	0x00, 0xff, 0xff, 0xd6, 0x34, 0x18, 0x2d, 0xb2, 0x0d, 0x00, 0x00,
+	// 1373 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xc1, 0x6f, 0x13, 0x47,
+	0x17, 0xf7, 0xae, 0x93, 0x38, 0x19, 0x3b, 0x89, 0x33, 0x89, 0x88, 0x59, 0xf4, 0xed, 0x2e, 0x06,
+	0xf1, 0x45, 0x7c, 0xb0, 0x81, 0x70, 0xf8, 0x54, 0x2a, 0xb5, 0xf5, 0xc6, 0x0b, 0x18, 0x11, 0xdb,
+	0x5a, 0x2f, 0x89, 0x68, 0xd5, 0xae, 0x36, 0xde, 0xc1, 0xd9, 0x62, 0xef, 0xb8, 0xde, 0x89, 0xc1,
+	0x37, 0xd4, 0x43, 0x85, 0x7c, 0xe2, 0x54, 0xf5, 0x62, 0xa9, 0x52, 0x39, 0x54, 0xa8, 0x07, 0x0e,
+	0xed, 0xff, 0x10, 0xf5, 0x84, 0xaa, 0x1e, 0x50, 0x0f, 0xa6, 0x84, 0x43, 0xab, 0x1e, 0x7a, 0xc8,
+	0xb1, 0xa7, 0xca, 0x3b, 0xb3, 0x78, 0x63, 0x9b, 0x42, 0x68, 0x51, 0xab, 0x5e, 0x2c, 0xef, 0xdb,
+	0xdf, 0xfb, 0xbd, 0x79, 0xbf, 0x79, 0xf3, 0xde, 0x2c, 0x58, 0xbc, 0xb5, 0x5c, 0xc1, 0xcd, 0x65,
+	0xd2, 0xaa, 0x23, 0x8f, 0xfe, 0x2a, 0xf5, 0x06, 0x26, 0x18, 0xce, 0x97, 0xb1, 0x57, 0xc3, 0x9e,
+	0xe9, 0xd9, 0x37, 0x94, 0x5b, 0x4a, 0x05, 0x37, 0x95, 0xe6, 0x59, 0x61, 0x6e, 0x08, 0x27, 0x9c,
+	0x20, 0x5b, 0x4e, 0xc3, 0x36, 0xeb, 0x56, 0x83, 0xb4, 0x96, 0x7d, 0xd3, 0x72, 0x05, 0x57, 0x70,
+	0xff, 0x1f, 0xc3, 0xfd, 0x6f, 0x18, 0x47, 0x23, 0x9c, 0x0e, 0x3f, 0x30, 0xb0, 0x54, 0xc1, 0xb8,
+	0x52, 0x45, 0x14, 0xb7, 0xb9, 0x7d, 0x7d, 0x99, 0x38, 0x35, 0xe4, 0x11, 0xab, 0x56, 0x67, 0x80,
+	0xc3, 0x83, 0x00, 0xcb, 0x6d, 0xd1, 0x57, 0xe9, 0x07, 0x3c, 0x98, 0x5b, 0xf3, 0x2a, 0xa5, 0xed,
+	0xcd, 0x9a, 0x43, 0x8a, 0x0d, 0x5c, 0xc7, 0x9e, 0x55, 0x85, 0x6f, 0x82, 0x58, 0x19, 0xbb, 0x04,
+	0xb9, 0x24, 0xc5, 0xc9, 0xdc, 0x52, 0x7c, 0x65, 0x41, 0xa1, 0x14, 0x4a, 0x40, 0xa1, 0x64, 0xdc,
+	0x96, 0x1a, 0xff, 0xf6, 0xeb, 0xd3, 0xb1, 0x55, 0x0a, 0xd4, 0x03, 0x0f, 0x78, 0x87, 0x03, 0xb3,
+	0x8e, 0xeb, 0x10, 0xc7, 0xaa, 0x9a, 0x36, 0xaa, 0x63, 0xcf, 0x21, 0x29, 0x5e, 0x8e, 0x2e, 0xc5,
+	0x57, 0xe6, 0x95, 0x90, 0x4c, 0xcd, 0xb3, 0xca, 0x2a, 0x76, 0x5c, 0xf5, 0xf2, 0x4e, 0x57, 0x8a,
+	0xec, 0x75, 0xa5, 0x43, 0x2d, 0xab, 0x56, 0x3d, 0x9f, 0x1e, 0xf0, 0x4c, 0xdf, 0x7f, 0x2c, 0x2d,
+	0x55, 0x1c, 0xb2, 0xb5, 0xbd, 0xa9, 0x94, 0x71, 0x8d, 0x25, 0x1e, 0x88, 0xe1, 0xd9, 0x37, 0x98,
+	0xbc, 0x3d, 0x2a, 0x4f, 0x9f, 0x61, 0xde, 0x59, 0xea, 0x0c, 0xd7, 0xc0, 0x64, 0xdd, 0xcf, 0x09,
+	0x35, 0x52, 0x51, 0x99, 0x5b, 0x4a, 0xa8, 0x67, 0x7f, 0xeb, 0x4a, 0xa7, 0x5f, 0x82, 0x2f, 0x53,
+	0x2e, 0x67, 0x6c, 0xbb, 0x81, 0x3c, 0x4f, 0x7f, 0x46, 0x71, 0x7e, 0xec, 0xe7, 0xcf, 0x25, 0x2e,
+	0xfd, 0x13, 0x07, 0x62, 0x6b, 0x5e, 0x65, 0x1d, 0x13, 0x04, 0x0d, 0x10, 0xaf, 0x33, 0xd1, 0x4c,
+	0xc7, 0xf6, 0xc5, 0x1a, 0x53, 0xcf, 0xed, 0x76, 0x25, 0x10, 0x68, 0x99, 0xcb, 0xfe, 0xd2, 0x95,
+	0xc2, 0xa0, 0xbd, 0xae, 0x04, 0x69, 0xaa, 0x21, 0x63, 0x5a, 0x07, 0xc1, 0x53, 0xce, 0x86, 0x17,
+	0xc1, 0x78, 0x13, 0x13, 0xd4, 0x48, 0xf1, 0xaf, 0xba, 0x66, 0xea, 0x0f, 0xff, 0x0f, 0x26, 0x70,
+	0x9d, 0x38, 0xd8, 0xf5, 0xb3, 0x9f, 0x59, 0x91, 0x94, 0x11, 0x75, 0xaa, 0xf4, 0x32, 0x29, 0xf8,
+	0x30, 0x9d, 0xc1, 0x59, 0xa6, 0x9f, 0xf2, 0x60, 0x96, 0x65, 0xba, 0x81, 0x9c, 0xca, 0x16, 0x41,
+	0xf6, 0x3f, 0x3d, 0xe3, 0x0f, 0x40, 0x8c, 0xa6, 0xe0, 0xa5, 0xa2, 0x7e, 0xcd, 0xfd, 0x77, 0x64,
+	0xca, 0x41, 0x3a, 0xfd, 0xd4, 0xd5, 0x23, 0xbd, 0x3a, 0xbc, 0xff, 0x58, 0x9a, 0x1f, 0x7e, 0xe7,
+	0xe9, 0x01, 0x29, 0x13, 0xe6, 0x2b, 0x0e, 0xc0, 0x61, 0x58, 0x48, 0x6e, 0xee, 0x40, 0x72, 0xc3,
+	0x0d, 0x30, 0x71, 0xd3, 0xa7, 0xf3, 0xf3, 0x9f, 0x52, 0xdf, 0xee, 0xad, 0xe5, 0x87, 0xae, 0x74,
+	0xe2, 0x25, 0x34, 0xc8, 0xa2, 0xf2, 0x5e, 0x57, 0x9a, 0xa6, 0x02, 0x53, 0x96, 0xb4, 0xce, 0xe8,
+	0xd8, 0x72, 0x3f, 0xe3, 0x01, 0x58, 0xf3, 0x2a, 0xc1, 0xa9, 0x78, 0x3d, 0x5b, 0x58, 0x00, 0x53,
+	0xec, 0xcc, 0xe2, 0x3f, 0xb1, 0x8d, 0x7d, 0x0e, 0xf8, 0x3e, 0x98, 0xb0, 0x6a, 0x78, 0xdb, 0x25,
+	0xa9, 0xe8, 0xf3, 0xbb, 0xc7, 0x19, 0xb6, 0x6b, 0x2f, 0xdf, 0x23, 0x18, 0x29, 0x93, 0x66, 0x03,
+	0x24, 0x0c, 0x74, 0xab, 0xdf, 0xf9, 0x16, 0xc0, 0x38, 0x71, 0x48, 0x15, 0xf9, 0xaa, 0x4c, 0xe9,
+	0xf4, 0x01, 0xca, 0x20, 0x6e, 0x23, 0xaf, 0xdc, 0x70, 0xe8, 0xee, 0xfa, 0x9b, 0xa4, 0x87, 0x4d,
+	0xe7, 0x67, 0x7b, 0x6c, 0xdf, 0xf5, 0xdb, 0x61, 0xfa, 0x13, 0x1e, 0xc4, 0x02, 0xc1, 0xb5, 0x51,
+	0x82, 0x1f, 0xdf, 0x2f, 0xf8, 0xbf, 0x56, 0xe1, 0xdb, 0x31, 0x30, 0xf9, 0x4c, 0x5e, 0x75, 0x94,
+	0x12, 0x47, 0x87, 0x4a, 0x8f, 0xf7, 0x2b, 0x6e, 0x8a, 0x4d, 0x84, 0x01, 0x19, 0x42, 0xc3, 0x89,
+	0x3f, 0xf0, 0x70, 0xda, 0x00, 0x13, 0x1e, 0xb1, 0xc8, 0xb6, 0xc7, 0x3a, 0xe2, 0xb1, 0x91, 0x47,
+	0x34, 0x58, 0x4c, 0xc9, 0x87, 0xaa, 0x42, 0x7f, 0x3c, 0x3d, 0x5b, 0x3d, 0x65, 0x49, 0xeb, 0x8c,
+	0x0e, 0x7e, 0x04, 0xe0, 0x75, 0xc7, 0xb5, 0xaa, 0x26, 0xb1, 0xaa, 0xd5, 0x96, 0xd9, 0x40, 0xde,
+	0x76, 0x95, 0xa4, 0xc6, 0xfc, 0x05, 0xca, 0x23, 0x83, 0x18, 0x3d, 0xa0, 0xee, 0xe3, 0xd4, 0xa3,
+	0x6c, 0x08, 0x1e, 0xa6, 0x51, 0x86, 0x99, 0xd2, 0x7a, 0xd2, 0x37, 0x86, 0x9c, 0xe0, 0x7b, 0x20,
+	0xee, 0xf9, 0x73, 0xdb, 0xec, 0x0d, 0xfc, 0xd4, 0xb8, 0x1f, 0x4b, 0x18, 0x12, 0xc3, 0x08, 0x6e,
+	0x03, 0xaa, 0xc8, 0xa2, 0xb0, 0x42, 0x0b, 0x39, 0xa7, 0xef, 0x3e, 0x96, 0x38, 0x1d, 0x50, 0x4b,
+	0xcf, 0x01, 0x3a, 0x20, 0xc9, 0x0a, 0xc5, 0x44, 0xae, 0x4d, 0x23, 0x4c, 0xbc, 0x30, 0xc2, 0x31,
+	0x16, 0x61, 0x91, 0x46, 0x18, 0x64, 0xa0, 0x61, 0x66, 0x98, 0x59, 0x73, 0x6d, 0x3f, 0xd4, 0xc7,
+	0x1c, 0x98, 0x26, 0x98, 0x84, 0xae, 0x0b, 0xb1, 0xe7, 0x97, 0xe3, 0x25, 0x16, 0x61, 0x81, 0x46,
+	0xd8, 0xe7, 0x77, 0xb0, 0xcb, 0x42, 0xc2, 0xf7, 0x0d, 0xce, 0x68, 0x15, 0xcc, 0x35, 0x31, 0x71,
+	0xdc, 0x4a, 0x6f, 0x67, 0x1b, 0x4c, 0xd2, 0xc9, 0x17, 0x26, 0x7c, 0x9c, 0x2d, 0x27, 0x45, 0x97,
+	0x33, 0x44, 0x41, 0x33, 0x9e, 0xa5, 0xf6, 0x52, 0xcf, 0xec, 0xa7, 0x7c, 0x1d, 0x30, 0x53, 0x5f,
+	0xdc, 0xa9, 0x17, 0xc6, 0x4a, 0xef, 0xbf, 0x29, 0x0d, 0x10, 0xd0, 0x48, 0xd3, 0xd4, 0xca, 0xa4,
+	0x65, 0x47, 0x70, 0x87, 0x07, 0xf1, 0x70, 0xe1, 0xbc, 0x03, 0xa2, 0x2d, 0xe4, 0xd1, 0x16, 0xa7,
+	0x2a, 0x07, 0x98, 0x35, 0x39, 0x97, 0xe8, 0x3d, 0x57, 0x78, 0x09, 0xc4, 0xac, 0x4d, 0x8f, 0x58,
+	0x0e, 0x6b, 0x86, 0x07, 0x66, 0x09, 0xdc, 0xe1, 0x5b, 0x80, 0x77, 0x71, 0x2a, 0xfa, 0x4a, 0x24,
+	0xbc, 0x8b, 0x61, 0x05, 0x24, 0x5c, 0x6c, 0xde, 0x74, 0xc8, 0x96, 0xd9, 0x44, 0x04, 0xfb, 0x27,
+	0x6e, 0x4a, 0xd5, 0x0e, 0xc6, 0xb4, 0xd7, 0x95, 0xe6, 0xa9, 0xa8, 0x61, 0xae, 0xb4, 0x0e, 0x5c,
+	0xbc, 0xe1, 0x90, 0xad, 0x75, 0x44, 0x30, 0x93, 0xf2, 0x1b, 0x1e, 0x8c, 0xf9, 0x37, 0xbf, 0xbf,
+	0xa8, 0xa7, 0xff, 0xed, 0x57, 0xbd, 0xf0, 0x8d, 0x69, 0xec, 0xb5, 0xdd, 0x98, 0x4e, 0xfe, 0xca,
+	0x01, 0xd0, 0x7f, 0x0d, 0x4f, 0x81, 0xc5, 0xf5, 0x82, 0xa1, 0x99, 0x85, 0xa2, 0x91, 0x2b, 0xe4,
+	0xcd, 0xab, 0xf9, 0x52, 0x51, 0x5b, 0xcd, 0x5d, 0xc8, 0x69, 0xd9, 0x64, 0x44, 0x98, 0x6d, 0x77,
+	0xe4, 0x38, 0x05, 0x6a, 0xb5, 0x3a, 0x69, 0xc1, 0x34, 0x98, 0x0d, 0xa3, 0xaf, 0x69, 0xa5, 0x24,
+	0x27, 0x4c, 0xb7, 0x3b, 0xf2, 0x14, 0x45, 0x5d, 0x43, 0x1e, 0x3c, 0x09, 0xe6, 0xc3, 0x98, 0x8c,
+	0x5a, 0x32, 0x32, 0xb9, 0x7c, 0x92, 0x17, 0xe6, 0xda, 0x1d, 0x79, 0x9a, 0xe2, 0x32, 0xac, 0xe6,
+	0x64, 0x30, 0x13, 0xc6, 0xe6, 0x0b, 0xc9, 0xa8, 0x90, 0x68, 0x77, 0xe4, 0x49, 0x0a, 0xcb, 0x63,
+	0xb8, 0x02, 0x52, 0xfb, 0x11, 0xe6, 0x46, 0xce, 0xb8, 0x64, 0xae, 0x6b, 0x46, 0x21, 0x39, 0x26,
+	0x2c, 0xb4, 0x3b, 0x72, 0x32, 0xc0, 0x06, 0x05, 0x22, 0x24, 0xee, 0x7c, 0x21, 0x46, 0xbe, 0xbc,
+	0x27, 0x46, 0x1e, 0xdc, 0x13, 0x23, 0x27, 0xbf, 0xe7, 0xc1, 0xcc, 0xfe, 0x31, 0x02, 0x15, 0x70,
+	0xa4, 0xa8, 0x17, 0x8a, 0x85, 0x52, 0xe6, 0x8a, 0x59, 0x32, 0x32, 0xc6, 0xd5, 0xd2, 0x40, 0xe2,
+	0x7e, 0x4a, 0x14, 0x9c, 0x77, 0x7a, 0x5f, 0x61, 0xe2, 0x20, 0x3e, 0xab, 0x15, 0x0b, 0xa5, 0x9c,
+	0x61, 0x16, 0x35, 0x3d, 0x57, 0xc8, 0x26, 0x39, 0x61, 0xb1, 0xdd, 0x91, 0xe7, 0xa9, 0x0b, 0xeb,
+	0x64, 0x45, 0xd4, 0x70, 0xb0, 0x0d, 0xdf, 0x00, 0xff, 0x19, 0x74, 0x5e, 0x2f, 0x18, 0xb9, 0xfc,
+	0xc5, 0xc0, 0x97, 0x17, 0x0e, 0xb5, 0x3b, 0x32, 0xa4, 0xbe, 0xeb, 0x7e, 0xd7, 0x60, 0xae, 0xa7,
+	0xc0, 0xa1, 0x41, 0xd7, 0x62, 0xa6, 0x54, 0xd2, 0xb2, 0xc9, 0xa8, 0x90, 0x6c, 0x77, 0xe4, 0x04,
+	0xf5, 0x29, 0x5a, 0x9e, 0x87, 0x6c, 0x78, 0x06, 0xa4, 0x06, 0xd1, 0xba, 0x76, 0x59, 0x5b, 0x35,
+	0xb4, 0x6c, 0x72, 0x4c, 0x80, 0xed, 0x8e, 0x3c, 0x43, 0xf1, 0x3a, 0xfa, 0x10, 0x95, 0x09, 0x1a,
+	0xc9, 0x7f, 0x21, 0x93, 0xbb, 0xa2, 0x65, 0x93, 0xe3, 0x61, 0xfe, 0x0b, 0x96, 0x53, 0x45, 0xf6,
+	0x7e, 0x59, 0xd5, 0xfc, 0xce, 0x13, 0x31, 0xf2, 0xe8, 0x89, 0x18, 0xb9, 0xbd, 0x2b, 0x46, 0x76,
+	0x76, 0x45, 0xee, 0xe1, 0xae, 0xc8, 0xfd, 0xb8, 0x2b, 0x72, 0x77, 0x9f, 0x8a, 0x91, 0x87, 0x4f,
+	0xc5, 0xc8, 0xa3, 0xa7, 0x62, 0xe4, 0xdd, 0x3f, 0x1e, 0x02, 0xa1, 0x8f, 0xf8, 0xcd, 0x09, 0xbf,
+	0xcf, 0x9e, 0xfb, 0x7d, 0x00, 0xfd, 0xa5, 0x4b, 0x79, 0xda, 0x0f, 0x00, 0x00,
 }
 
 func (this *MsgSubmitProposal) Equal(that interface{}) bool {
@@ -593,6 +683,68 @@ func (this *MsgVote) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgVoteWeighted) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgVoteWeighted)
+	if !ok {
+		that2, ok := that.(MsgVoteWeighted)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProposalID != that1.ProposalID {
+		return false
+	}
+	if !bytes.Equal(this.Voter, that1.Voter) {
+		return false
+	}
+	if len(this.Options) != len(that1.Options) {
+		return false
+	}
+	for i := range this.Options {
+		if !this.Options[i].Equal(&that1.Options[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *WeightedVoteOption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WeightedVoteOption)
+	if !ok {
+		that2, ok := that.(WeightedVoteOption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Option != that1.Option {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
 func (this *MsgDeposit) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -804,6 +956,14 @@ func (this *Vote) Equal(that interface{}) bool {
 	if this.Option != that1.Option {
 		return false
 	}
+	if len(this.Options) != len(that1.Options) {
+		return false
+	}
+	for i := range this.Options {
+		if !this.Options[i].Equal(&that1.Options[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *MsgSubmitProposal) Marshal() (dAtA []byte, err error) {
@@ -902,6 +1062,93 @@ func (m *MsgVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgVoteWeighted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteWeighted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteWeighted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Option != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1221,6 +1468,20 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Option != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.Option))
 		i--
@@ -1294,6 +1555,42 @@ func (m *MsgVote) Size() (n int) {
 	return n
 }
 
+func (m *MsgVoteWeighted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalID))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovTypes(uint64(m.Option))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func (m *MsgDeposit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1423,6 +1720,12 @@ func (m *Vote) Size() (n int) {
 	if m.Option != 0 {
 		n += 1 + sovTypes(uint64(m.Option))
 	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1714,6 +2017,252 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteWeighted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteWeighted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2727,6 +3276,40 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
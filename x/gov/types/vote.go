@@ -3,15 +3,34 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// NewVote creates a new Vote instance
-func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter, option}
+// NewVote creates a new Vote instance. The legacy Option field is only set for
+// non-split votes.
+func NewVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	option := OptionEmpty
+	if len(options) == 1 && options[0].Weight.Equal(sdk.OneDec()) {
+		option = options[0].Option
+	}
+
+	return Vote{proposalID, voter, option, options}
+}
+
+// VoteOptions returns the weighted options of the vote. Votes stored before
+// weighted votes were introduced only have the Option field set, in which
+// case it is returned with a weight of 1.
+func (v Vote) VoteOptions() WeightedVoteOptions {
+	if len(v.Options) == 0 && v.Option != OptionEmpty {
+		return NewNonSplitVoteOption(v.Option)
+	}
+
+	return v.Options
 }
 
 func (v Vote) String() string {
@@ -43,7 +62,7 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalID)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.VoteOptions())
 	}
 	return out
 }
@@ -53,6 +72,98 @@ func (v Vote) Empty() bool {
 	return v.Equal(Vote{})
 }
 
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{Option: option, Weight: weight}
+}
+
+// NewNonSplitVoteOption returns the weighted vote options of a vote that
+// gives all of its voting power to a single option.
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{NewWeightedVoteOption(option, sdk.OneDec())}
+}
+
+// String implements the Stringer interface.
+func (o WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s=%s", o.Option, o.Weight)
+}
+
+// WeightedVoteOptions describes the split of a vote's voting power
+type WeightedVoteOptions []WeightedVoteOption
+
+// String implements the Stringer interface, returning the options in the
+// same "option=weight,..." form accepted by WeightedVoteOptionsFromString.
+func (v WeightedVoteOptions) String() string {
+	parts := make([]string, len(v))
+	for i, option := range v {
+		parts[i] = option.String()
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// ValidWeightedVoteOptions returns an error if any of the options is invalid
+// or has a non-positive weight, if an option appears twice, or if the weights
+// don't sum up to 1.
+func ValidWeightedVoteOptions(options WeightedVoteOptions) error {
+	if len(options) == 0 {
+		return sdkerrors.Wrap(ErrInvalidVote, "no vote options")
+	}
+
+	totalWeight := sdk.ZeroDec()
+	usedOptions := make(map[VoteOption]bool)
+
+	for _, option := range options {
+		if !ValidVoteOption(option.Option) {
+			return sdkerrors.Wrap(ErrInvalidVote, option.Option.String())
+		}
+
+		if option.Weight.IsNil() || !option.Weight.IsPositive() || option.Weight.GT(sdk.OneDec()) {
+			return sdkerrors.Wrapf(ErrInvalidVote, "invalid weight %s for option %s", option.Weight, option.Option)
+		}
+
+		if usedOptions[option.Option] {
+			return sdkerrors.Wrapf(ErrInvalidVote, "duplicate vote option %s", option.Option)
+		}
+		usedOptions[option.Option] = true
+
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidVote, "total weight of vote options must be 1, got %s", totalWeight)
+	}
+
+	return nil
+}
+
+// WeightedVoteOptionsFromString parses weighted vote options of the form
+// "Yes=0.6,Abstain=0.4". Option names must be valid for VoteOptionFromString.
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	var options WeightedVoteOptions
+
+	for _, part := range strings.Split(str, ",") {
+		fields := strings.Split(strings.TrimSpace(part), "=")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("'%s' is not a valid weighted vote option, expected <option>=<weight>", part)
+		}
+
+		option, err := VoteOptionFromString(fields[0])
+		if err != nil {
+			return nil, err
+		}
+
+		weight, err := sdk.NewDecFromStr(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight for vote option %s: %w", option, err)
+		}
+
+		options = append(options, NewWeightedVoteOption(option, weight))
+	}
+
+	return options, nil
+}
+
 // VoteOptionFromString returns a VoteOption from a string. It returns an error
 // if the string is invalid.
 func VoteOptionFromString(str string) (VoteOption, error) {
@@ -0,0 +1,191 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// GetQueryCmd returns the parent querying command for the group module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the group module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(flags.GetCommands(
+		GetCmdQueryGroup(cdc),
+		GetCmdQueryGroupMembers(cdc),
+		GetCmdQueryGroupPolicy(cdc),
+		GetCmdQueryGroupPolicies(cdc),
+		GetCmdQueryProposal(cdc),
+		GetCmdQueryProposals(cdc),
+		GetCmdQueryVotes(cdc),
+	)...)
+
+	return cmd
+}
+
+// GetCmdQueryGroup returns a CLI command handler for querying a group.
+func GetCmdQueryGroup(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "group [group_id]",
+		Short: "Query a group by its ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("group id %s is not a valid uint", args[0])
+			}
+
+			var group types.GroupInfo
+			return query(cdc, types.QueryGroup, types.NewQueryGroupParams(groupID), &group)
+		},
+	}
+}
+
+// GetCmdQueryGroupMembers returns a CLI command handler for querying the
+// members of a group.
+func GetCmdQueryGroupMembers(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "group-members [group_id]",
+		Short: "Query the members of a group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("group id %s is not a valid uint", args[0])
+			}
+
+			var members []types.GroupMember
+			return query(cdc, types.QueryGroupMembers, types.NewQueryGroupParams(groupID), &members)
+		},
+	}
+}
+
+// GetCmdQueryGroupPolicy returns a CLI command handler for querying a group
+// policy.
+func GetCmdQueryGroupPolicy(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "group-policy [group_policy_address]",
+		Short: "Query a group policy by its account address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var policyInfo types.GroupPolicyInfo
+			return query(cdc, types.QueryGroupPolicy, types.NewQueryGroupPolicyParams(address), &policyInfo)
+		},
+	}
+}
+
+// GetCmdQueryGroupPolicies returns a CLI command handler for querying the
+// policies of a group.
+func GetCmdQueryGroupPolicies(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "group-policies [group_id]",
+		Short: "Query the policies of a group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("group id %s is not a valid uint", args[0])
+			}
+
+			var policies types.GroupPolicies
+			return query(cdc, types.QueryGroupPolicies, types.NewQueryGroupParams(groupID), &policies)
+		},
+	}
+}
+
+// GetCmdQueryProposal returns a CLI command handler for querying a proposal.
+func GetCmdQueryProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "proposal [proposal_id]",
+		Short: "Query a group proposal by its ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal id %s is not a valid uint", args[0])
+			}
+
+			var proposal types.Proposal
+			return query(cdc, types.QueryProposal, types.NewQueryProposalParams(proposalID), &proposal)
+		},
+	}
+}
+
+// GetCmdQueryProposals returns a CLI command handler for querying the
+// proposals submitted to a group policy.
+func GetCmdQueryProposals(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "proposals [group_policy_address]",
+		Short: "Query the proposals submitted to a group policy",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var proposals types.Proposals
+			return query(cdc, types.QueryProposals, types.NewQueryGroupPolicyParams(address), &proposals)
+		},
+	}
+}
+
+// GetCmdQueryVotes returns a CLI command handler for querying the votes cast
+// on a proposal.
+func GetCmdQueryVotes(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "votes [proposal_id]",
+		Short: "Query the votes cast on a group proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal id %s is not a valid uint", args[0])
+			}
+
+			var votes []types.Vote
+			return query(cdc, types.QueryVotes, types.NewQueryProposalParams(proposalID), &votes)
+		},
+	}
+}
+
+// query performs a query against the group module's querier route and prints
+// the result decoded into res.
+func query(cdc *codec.Codec, path string, params, res interface{}) error {
+	clientCtx := client.NewContext().WithCodec(cdc)
+
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path)
+	out, _, err := clientCtx.QueryWithData(route, bz)
+	if err != nil {
+		return err
+	}
+
+	if err := cdc.UnmarshalJSON(out, res); err != nil {
+		return err
+	}
+
+	return clientCtx.PrintOutput(res)
+}
@@ -0,0 +1,393 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+const (
+	FlagMetadata  = "metadata"
+	FlagProposers = "proposers"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/group transaction commands.
+func NewTxCmd(clientCtx client.Context) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Group transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewCreateGroupCmd(clientCtx),
+		NewUpdateGroupMembersCmd(clientCtx),
+		NewUpdateGroupAdminCmd(clientCtx),
+		NewCreateGroupPolicyCmd(clientCtx),
+		NewSubmitProposalCmd(clientCtx),
+		NewVoteCmd(clientCtx),
+		NewExecCmd(clientCtx),
+	)
+
+	return txCmd
+}
+
+// NewCreateGroupCmd returns a CLI command handler for creating a
+// MsgCreateGroup transaction.
+func NewCreateGroupCmd(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group [members_json_file] --from [admin]",
+		Short: "Create a group with the members defined in a JSON file",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a group administered by the sender with the members defined in a
+JSON file, e.g.:
+
+{
+  "members": [
+    {"address": "cosmos1..", "weight": "1", "metadata": "alice"},
+    {"address": "cosmos1..", "weight": "2"}
+  ]
+}
+
+Example:
+$ %s tx %s create-group members.json --metadata="my group" --from=admin
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx = clientCtx.InitWithInput(cmd.InOrStdin())
+
+			members, err := parseMembers(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateGroup(clientCtx.GetFromAddress(), members, viper.GetString(FlagMetadata))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "Metadata of the group")
+
+	return flags.PostCommands(cmd)[0]
+}
+
+// NewUpdateGroupMembersCmd returns a CLI command handler for creating a
+// MsgUpdateGroupMembers transaction.
+func NewUpdateGroupMembersCmd(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-members [group_id] [members_json_file] --from [admin]",
+		Short: "Update the members of a group",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add, update or remove members of a group using a JSON file in the same
+format as create-group. Members with a weight of zero are removed.
+
+Example:
+$ %s tx %s update-group-members 1 members.json --from=admin
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx = clientCtx.InitWithInput(cmd.InOrStdin())
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("group id %s is not a valid uint", args[0])
+			}
+
+			members, err := parseMembers(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupMembers(clientCtx.GetFromAddress(), groupID, members)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
+
+// NewUpdateGroupAdminCmd returns a CLI command handler for creating a
+// MsgUpdateGroupAdmin transaction.
+func NewUpdateGroupAdminCmd(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-admin [group_id] [new_admin_address] --from [admin]",
+		Short: "Transfer the administration of a group to a new admin",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx = clientCtx.InitWithInput(cmd.InOrStdin())
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("group id %s is not a valid uint", args[0])
+			}
+
+			newAdmin, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupAdmin(clientCtx.GetFromAddress(), groupID, newAdmin)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
+
+// NewCreateGroupPolicyCmd returns a CLI command handler for creating a
+// MsgCreateGroupPolicy transaction with a threshold decision policy.
+func NewCreateGroupPolicyCmd(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group-policy [group_id] [threshold] [timeout] --from [admin]",
+		Short: "Create a group policy account with a threshold decision policy",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a group policy account for a group. Proposals submitted to the
+policy are accepted once the weight of the yes votes reaches the threshold
+before the timeout elapses.
+
+Example:
+$ %s tx %s create-group-policy 1 2 72h --metadata="treasury" --from=admin
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx = clientCtx.InitWithInput(cmd.InOrStdin())
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("group id %s is not a valid uint", args[0])
+			}
+
+			threshold, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			timeout, err := time.ParseDuration(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgCreateGroupPolicy(
+				clientCtx.GetFromAddress(), groupID, viper.GetString(FlagMetadata),
+				types.NewThresholdDecisionPolicy(threshold, timeout),
+			)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "Metadata of the group policy")
+
+	return flags.PostCommands(cmd)[0]
+}
+
+// NewSubmitProposalCmd returns a CLI command handler for creating a
+// MsgSubmitProposal transaction from the messages of a generated transaction.
+func NewSubmitProposalCmd(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-proposal [group_policy_address] [msg_tx_json_file] --from [proposer]",
+		Short: "Submit a proposal for a group policy account to execute messages",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal for a group policy account to execute the messages of a
+transaction generated with --generate-only. The messages must be signed by the
+group policy account. Additional proposers can be provided with --proposers, in
+which case the transaction must be signed by all of them.
+
+Example:
+$ %s tx %s submit-proposal cosmos1.. tx.json --metadata="pay contributors" --from=member
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx = clientCtx.InitWithInput(cmd.InOrStdin())
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			stdTx, err := authclient.ReadStdTxFromFile(clientCtx.Codec, args[1])
+			if err != nil {
+				return err
+			}
+
+			proposers := []sdk.AccAddress{clientCtx.GetFromAddress()}
+			for _, p := range viper.GetStringSlice(FlagProposers) {
+				proposer, err := sdk.AccAddressFromBech32(p)
+				if err != nil {
+					return err
+				}
+				proposers = append(proposers, proposer)
+			}
+
+			msg, err := types.NewMsgSubmitProposal(address, proposers, stdTx.GetMsgs(), viper.GetString(FlagMetadata))
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "Metadata of the proposal")
+	cmd.Flags().StringSlice(FlagProposers, nil, "Addresses of additional proposers")
+
+	return flags.PostCommands(cmd)[0]
+}
+
+// NewVoteCmd returns a CLI command handler for creating a MsgVote transaction.
+func NewVoteCmd(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [proposal_id] [choice] --from [voter]",
+		Short: "Vote on a group proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Vote on a group proposal. The choice is one of yes, no, abstain or veto.
+
+Example:
+$ %s tx %s vote 1 yes --from=member
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx = clientCtx.InitWithInput(cmd.InOrStdin())
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal id %s is not a valid uint", args[0])
+			}
+
+			choice, err := types.VoteChoiceFromString(strings.ToUpper(args[1]))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVote(proposalID, clientCtx.GetFromAddress(), choice, viper.GetString(FlagMetadata))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "Metadata of the vote")
+
+	return flags.PostCommands(cmd)[0]
+}
+
+// NewExecCmd returns a CLI command handler for creating a MsgExec
+// transaction.
+func NewExecCmd(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [proposal_id] --from [signer]",
+		Short: "Execute the messages of an accepted group proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx = clientCtx.InitWithInput(cmd.InOrStdin())
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal id %s is not a valid uint", args[0])
+			}
+
+			msg := types.NewMsgExec(proposalID, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
+
+// membersJSON defines the format of the members file passed to create-group
+// and update-group-members.
+type membersJSON struct {
+	Members []struct {
+		Address  string `json:"address"`
+		Weight   string `json:"weight"`
+		Metadata string `json:"metadata"`
+	} `json:"members"`
+}
+
+// parseMembers reads and parses the group members defined in a JSON file.
+func parseMembers(path string) ([]types.Member, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var input membersJSON
+	if err := json.Unmarshal(bz, &input); err != nil {
+		return nil, err
+	}
+
+	members := make([]types.Member, len(input.Members))
+	for i, m := range input.Members {
+		address, err := sdk.AccAddressFromBech32(m.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		weight, err := sdk.NewDecFromStr(m.Weight)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight for member %s: %w", m.Address, err)
+		}
+
+		members[i] = types.NewMember(address, weight, m.Metadata)
+	}
+
+	return members, nil
+}
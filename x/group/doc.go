@@ -0,0 +1,16 @@
+/*
+Package group implements on-chain groups of accounts that can collectively
+decide on and execute messages, enabling DAOs and multisig-like accounts
+without off-chain coordination.
+
+A group is an administered set of members, each with a weight. A group policy
+is an account controlled by a group according to a DecisionPolicy; the module
+provides a ThresholdDecisionPolicy which accepts a proposal once the weight of
+its yes votes reaches a threshold within a timeout.
+
+Group members submit proposals containing arbitrary messages signed by the
+group policy account and vote on them. Once a proposal is accepted, anyone can
+execute it with MsgExec, dispatching its messages through the application's
+message router. Updating a group's members aborts all of its open proposals.
+*/
+package group
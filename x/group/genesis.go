@@ -0,0 +1,70 @@
+package group
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// InitGenesis initializes the group module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
+	}
+
+	var maxGroupID, maxProposalID uint64
+	for _, group := range gs.Groups {
+		k.SetGroupInfo(ctx, group)
+		if group.GroupID > maxGroupID {
+			maxGroupID = group.GroupID
+		}
+	}
+	for _, member := range gs.GroupMembers {
+		k.SetGroupMember(ctx, member)
+	}
+	for _, policyInfo := range gs.GroupPolicies {
+		k.SetGroupPolicyInfo(ctx, policyInfo)
+	}
+	for _, proposal := range gs.Proposals {
+		k.SetProposal(ctx, proposal)
+		if proposal.ProposalID > maxProposalID {
+			maxProposalID = proposal.ProposalID
+		}
+	}
+	for _, vote := range gs.Votes {
+		k.SetVote(ctx, vote)
+	}
+
+	k.SetNextIDs(ctx, maxGroupID+1, uint64(len(gs.GroupPolicies))+1, maxProposalID+1)
+}
+
+// ExportGenesis returns the group module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	gs := types.DefaultGenesisState()
+
+	k.IterateGroups(ctx, func(group types.GroupInfo) bool {
+		gs.Groups = append(gs.Groups, group)
+		return false
+	})
+	k.IterateGroupMembers(ctx, func(member types.GroupMember) bool {
+		gs.GroupMembers = append(gs.GroupMembers, member)
+		return false
+	})
+	k.IterateGroupPolicies(ctx, func(policyInfo types.GroupPolicyInfo) bool {
+		gs.GroupPolicies = append(gs.GroupPolicies, policyInfo)
+		return false
+	})
+	k.IterateProposals(ctx, func(proposal types.Proposal) bool {
+		gs.Proposals = append(gs.Proposals, proposal)
+		return false
+	})
+	k.IterateVotes(ctx, func(vote types.Vote) bool {
+		gs.Votes = append(gs.Votes, vote)
+		return false
+	})
+
+	return gs
+}
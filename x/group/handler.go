@@ -0,0 +1,131 @@
+package group
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// NewHandler returns a handler for group messages.
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *types.MsgCreateGroup:
+			return handleMsgCreateGroup(ctx, k, msg)
+
+		case *types.MsgUpdateGroupMembers:
+			return handleMsgUpdateGroupMembers(ctx, k, msg)
+
+		case *types.MsgUpdateGroupAdmin:
+			return handleMsgUpdateGroupAdmin(ctx, k, msg)
+
+		case *types.MsgCreateGroupPolicy:
+			return handleMsgCreateGroupPolicy(ctx, k, msg)
+
+		case *types.MsgSubmitProposal:
+			return handleMsgSubmitProposal(ctx, k, msg)
+
+		case *types.MsgVote:
+			return handleMsgVote(ctx, k, msg)
+
+		case *types.MsgExec:
+			return handleMsgExec(ctx, k, msg)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
+		}
+	}
+}
+
+func handleMsgCreateGroup(ctx sdk.Context, k keeper.Keeper, msg *types.MsgCreateGroup) (*sdk.Result, error) {
+	groupID, err := k.CreateGroup(ctx, msg.Admin, msg.Members, msg.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.Admin)
+	return &sdk.Result{
+		Data:   types.GetIDBytes(groupID),
+		Events: ctx.EventManager().ABCIEvents(),
+	}, nil
+}
+
+func handleMsgUpdateGroupMembers(ctx sdk.Context, k keeper.Keeper, msg *types.MsgUpdateGroupMembers) (*sdk.Result, error) {
+	if err := k.UpdateGroupMembers(ctx, msg.Admin, msg.GroupID, msg.MemberUpdates); err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.Admin)
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgUpdateGroupAdmin(ctx sdk.Context, k keeper.Keeper, msg *types.MsgUpdateGroupAdmin) (*sdk.Result, error) {
+	if err := k.UpdateGroupAdmin(ctx, msg.Admin, msg.GroupID, msg.NewAdmin); err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.Admin)
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgCreateGroupPolicy(ctx sdk.Context, k keeper.Keeper, msg *types.MsgCreateGroupPolicy) (*sdk.Result, error) {
+	address, err := k.CreateGroupPolicy(ctx, msg.Admin, msg.GroupID, msg.Metadata, msg.GetDecisionPolicy())
+	if err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.Admin)
+	return &sdk.Result{
+		Data:   address,
+		Events: ctx.EventManager().ABCIEvents(),
+	}, nil
+}
+
+func handleMsgSubmitProposal(ctx sdk.Context, k keeper.Keeper, msg *types.MsgSubmitProposal) (*sdk.Result, error) {
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	proposalID, err := k.SubmitProposal(ctx, msg.Address, msg.Proposers, msgs, msg.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.Proposers[0])
+	return &sdk.Result{
+		Data:   types.GetIDBytes(proposalID),
+		Events: ctx.EventManager().ABCIEvents(),
+	}, nil
+}
+
+func handleMsgVote(ctx sdk.Context, k keeper.Keeper, msg *types.MsgVote) (*sdk.Result, error) {
+	if err := k.Vote(ctx, msg.ProposalID, msg.Voter, msg.Choice, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.Voter)
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgExec(ctx sdk.Context, k keeper.Keeper, msg *types.MsgExec) (*sdk.Result, error) {
+	if err := k.Exec(ctx, msg.ProposalID); err != nil {
+		return nil, err
+	}
+
+	emitMessageEvent(ctx, msg.Signer)
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func emitMessageEvent(ctx sdk.Context, sender sdk.AccAddress) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		),
+	)
+}
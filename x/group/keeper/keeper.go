@@ -0,0 +1,358 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// Keeper defines the group module's keeper. It persists groups, group
+// policies, proposals and votes, and dispatches the messages of accepted
+// proposals through the application's message router.
+type Keeper struct {
+	storeKey      sdk.StoreKey
+	cdc           codec.Marshaler
+	accountKeeper types.AccountKeeper
+	router        sdk.Router
+}
+
+// NewKeeper constructs a group Keeper. The router is used to dispatch the
+// messages of accepted proposals.
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.Marshaler, ak types.AccountKeeper, router sdk.Router) Keeper {
+	return Keeper{
+		storeKey:      storeKey,
+		cdc:           cdc,
+		accountKeeper: ak,
+		router:        router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// getNextID returns the next value of the sequence stored under the provided
+// key and increments it. Sequences start at 1.
+func (k Keeper) getNextID(ctx sdk.Context, key []byte) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	id := uint64(1)
+	if bz := store.Get(key); bz != nil {
+		id = types.GetIDFromBytes(bz)
+	}
+
+	store.Set(key, types.GetIDBytes(id+1))
+	return id
+}
+
+// SetNextIDs sets the next group, group policy and proposal IDs. It is used
+// when initializing the module from genesis.
+func (k Keeper) SetNextIDs(ctx sdk.Context, groupID, policyID, proposalID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GroupIDKey, types.GetIDBytes(groupID))
+	store.Set(types.GroupPolicyIDKey, types.GetIDBytes(policyID))
+	store.Set(types.ProposalIDKey, types.GetIDBytes(proposalID))
+}
+
+// ----------------------------------------------------------------------------
+// Groups
+// ----------------------------------------------------------------------------
+
+// CreateGroup creates a new group with the provided admin and members and
+// returns its ID.
+func (k Keeper) CreateGroup(ctx sdk.Context, admin sdk.AccAddress, members types.Members, metadata string) (uint64, error) {
+	if err := members.ValidateBasic(); err != nil {
+		return 0, err
+	}
+
+	groupID := k.getNextID(ctx, types.GroupIDKey)
+	totalWeight := sdk.ZeroDec()
+	for _, m := range members {
+		if !m.Weight.IsPositive() {
+			return 0, sdkerrors.Wrapf(types.ErrInvalidMembers, "member %s must have a positive weight", m.Address)
+		}
+
+		k.SetGroupMember(ctx, types.GroupMember{GroupID: groupID, Member: m})
+		totalWeight = totalWeight.Add(m.Weight)
+	}
+
+	k.SetGroupInfo(ctx, types.GroupInfo{
+		GroupID:     groupID,
+		Admin:       admin,
+		Metadata:    metadata,
+		Version:     1,
+		TotalWeight: totalWeight,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateGroup,
+			sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", groupID)),
+		),
+	)
+
+	return groupID, nil
+}
+
+// GetGroupInfo returns the group with the provided ID.
+func (k Keeper) GetGroupInfo(ctx sdk.Context, groupID uint64) (group types.GroupInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GroupKey(groupID))
+	if bz == nil {
+		return group, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &group)
+	return group, true
+}
+
+// SetGroupInfo stores a group.
+func (k Keeper) SetGroupInfo(ctx sdk.Context, group types.GroupInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GroupKey(group.GroupID), k.cdc.MustMarshalBinaryBare(&group))
+}
+
+// IterateGroups iterates over all groups and performs a callback function.
+func (k Keeper) IterateGroups(ctx sdk.Context, cb func(group types.GroupInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GroupKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var group types.GroupInfo
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &group)
+
+		if cb(group) {
+			break
+		}
+	}
+}
+
+// GetGroupMember returns a member of a group.
+func (k Keeper) GetGroupMember(ctx sdk.Context, groupID uint64, address sdk.AccAddress) (member types.GroupMember, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GroupMemberKey(groupID, address))
+	if bz == nil {
+		return member, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &member)
+	return member, true
+}
+
+// SetGroupMember stores a group member.
+func (k Keeper) SetGroupMember(ctx sdk.Context, member types.GroupMember) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GroupMemberKey(member.GroupID, member.Member.Address), k.cdc.MustMarshalBinaryBare(&member))
+}
+
+// IterateGroupMembers iterates over the members of all groups and performs a
+// callback function.
+func (k Keeper) IterateGroupMembers(ctx sdk.Context, cb func(member types.GroupMember) (stop bool)) {
+	k.iterateGroupMembers(ctx, types.GroupMemberKeyPrefix, cb)
+}
+
+// GetGroupMembers returns all the members of a group.
+func (k Keeper) GetGroupMembers(ctx sdk.Context, groupID uint64) []types.GroupMember {
+	members := []types.GroupMember{}
+	k.iterateGroupMembers(ctx, types.GroupMembersKey(groupID), func(member types.GroupMember) bool {
+		members = append(members, member)
+		return false
+	})
+
+	return members
+}
+
+func (k Keeper) iterateGroupMembers(ctx sdk.Context, prefix []byte, cb func(member types.GroupMember) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var member types.GroupMember
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &member)
+
+		if cb(member) {
+			break
+		}
+	}
+}
+
+// UpdateGroupMembers adds, updates or removes members of a group. Members
+// with a zero weight are removed. Updating the members increments the group's
+// version, which aborts all proposals still open against the group.
+func (k Keeper) UpdateGroupMembers(ctx sdk.Context, admin sdk.AccAddress, groupID uint64, updates types.Members) error {
+	group, err := k.getGroupAsAdmin(ctx, admin, groupID)
+	if err != nil {
+		return err
+	}
+	if err := updates.ValidateBasic(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, m := range updates {
+		if existing, found := k.GetGroupMember(ctx, groupID, m.Address); found {
+			group.TotalWeight = group.TotalWeight.Sub(existing.Member.Weight)
+		} else if m.Weight.IsZero() {
+			return sdkerrors.Wrapf(types.ErrInvalidMembers, "cannot remove %s: not a member of group %d", m.Address, groupID)
+		}
+
+		if m.Weight.IsZero() {
+			store.Delete(types.GroupMemberKey(groupID, m.Address))
+			continue
+		}
+
+		k.SetGroupMember(ctx, types.GroupMember{GroupID: groupID, Member: m})
+		group.TotalWeight = group.TotalWeight.Add(m.Weight)
+	}
+
+	if !group.TotalWeight.IsPositive() {
+		return sdkerrors.Wrap(types.ErrInvalidMembers, "a group must have at least one member")
+	}
+
+	group.Version++
+	k.SetGroupInfo(ctx, group)
+
+	k.emitUpdateGroupEvent(ctx, groupID)
+	return nil
+}
+
+// UpdateGroupAdmin transfers the administration of a group to a new admin.
+func (k Keeper) UpdateGroupAdmin(ctx sdk.Context, admin sdk.AccAddress, groupID uint64, newAdmin sdk.AccAddress) error {
+	group, err := k.getGroupAsAdmin(ctx, admin, groupID)
+	if err != nil {
+		return err
+	}
+
+	group.Admin = newAdmin
+	k.SetGroupInfo(ctx, group)
+
+	k.emitUpdateGroupEvent(ctx, groupID)
+	return nil
+}
+
+// getGroupAsAdmin returns the group with the provided ID, failing if it does
+// not exist or if admin is not its administrator.
+func (k Keeper) getGroupAsAdmin(ctx sdk.Context, admin sdk.AccAddress, groupID uint64) (types.GroupInfo, error) {
+	group, found := k.GetGroupInfo(ctx, groupID)
+	if !found {
+		return group, sdkerrors.Wrapf(types.ErrGroupNotFound, "group %d", groupID)
+	}
+	if !group.Admin.Equals(admin) {
+		return group, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the admin of group %d", admin, groupID)
+	}
+
+	return group, nil
+}
+
+func (k Keeper) emitUpdateGroupEvent(ctx sdk.Context, groupID uint64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateGroup,
+			sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", groupID)),
+		),
+	)
+}
+
+// ----------------------------------------------------------------------------
+// Group policies
+// ----------------------------------------------------------------------------
+
+// CreateGroupPolicy creates a new group policy account for a group and returns
+// its address.
+func (k Keeper) CreateGroupPolicy(
+	ctx sdk.Context, admin sdk.AccAddress, groupID uint64, metadata string, decisionPolicy types.DecisionPolicy,
+) (sdk.AccAddress, error) {
+	if _, err := k.getGroupAsAdmin(ctx, admin, groupID); err != nil {
+		return nil, err
+	}
+	if err := decisionPolicy.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// Skip any address that is already in use, e.g. because funds were sent to
+	// it before the policy was created.
+	var address sdk.AccAddress
+	for {
+		address = types.GroupPolicyAddress(k.getNextID(ctx, types.GroupPolicyIDKey))
+		if _, found := k.GetGroupPolicyInfo(ctx, address); found {
+			continue
+		}
+		if k.accountKeeper.GetAccount(ctx, address) == nil {
+			break
+		}
+	}
+
+	policyInfo, err := types.NewGroupPolicyInfo(address, groupID, admin, metadata, decisionPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	k.accountKeeper.SetAccount(ctx, k.accountKeeper.NewAccountWithAddress(ctx, address))
+	k.SetGroupPolicyInfo(ctx, policyInfo)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateGroupPolicy,
+			sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", groupID)),
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+		),
+	)
+
+	return address, nil
+}
+
+// GetGroupPolicyInfo returns the group policy with the provided address.
+func (k Keeper) GetGroupPolicyInfo(ctx sdk.Context, address sdk.AccAddress) (policyInfo types.GroupPolicyInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GroupPolicyKey(address))
+	if bz == nil {
+		return policyInfo, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &policyInfo)
+	return policyInfo, true
+}
+
+// SetGroupPolicyInfo stores a group policy.
+func (k Keeper) SetGroupPolicyInfo(ctx sdk.Context, policyInfo types.GroupPolicyInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GroupPolicyKey(policyInfo.Address), k.cdc.MustMarshalBinaryBare(&policyInfo))
+}
+
+// IterateGroupPolicies iterates over all group policies and performs a
+// callback function.
+func (k Keeper) IterateGroupPolicies(ctx sdk.Context, cb func(policyInfo types.GroupPolicyInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GroupPolicyKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var policyInfo types.GroupPolicyInfo
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &policyInfo)
+
+		if cb(policyInfo) {
+			break
+		}
+	}
+}
+
+// GetGroupPoliciesByGroup returns all the policies of a group.
+func (k Keeper) GetGroupPoliciesByGroup(ctx sdk.Context, groupID uint64) types.GroupPolicies {
+	policies := types.GroupPolicies{}
+	k.IterateGroupPolicies(ctx, func(policyInfo types.GroupPolicyInfo) bool {
+		if policyInfo.GroupID == groupID {
+			policies = append(policies, policyInfo)
+		}
+		return false
+	})
+
+	return policies
+}
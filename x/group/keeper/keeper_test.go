@@ -0,0 +1,285 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app   *simapp.SimApp
+	ctx   sdk.Context
+	addrs []sdk.AccAddress
+
+	groupID uint64
+	policy  sdk.AccAddress
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 5, sdk.NewInt(30000000))
+
+	// members with a total weight of 6 and a threshold of 3
+	members := types.Members{
+		types.NewMember(suite.addrs[1], sdk.NewDec(1), ""),
+		types.NewMember(suite.addrs[2], sdk.NewDec(2), ""),
+		types.NewMember(suite.addrs[3], sdk.NewDec(3), ""),
+	}
+
+	var err error
+	suite.groupID, err = app.GroupKeeper.CreateGroup(ctx, suite.addrs[0], members, "test group")
+	suite.Require().NoError(err)
+
+	policy := types.NewThresholdDecisionPolicy(sdk.NewDec(3), time.Hour)
+	suite.policy, err = app.GroupKeeper.CreateGroupPolicy(ctx, suite.addrs[0], suite.groupID, "", policy)
+	suite.Require().NoError(err)
+
+	err = app.BankKeeper.SetBalances(ctx, suite.policy, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) sendMsgs(amount int64) []sdk.Msg {
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", amount))
+	return []sdk.Msg{banktypes.NewMsgSend(suite.policy, suite.addrs[4], coins)}
+}
+
+func (suite *KeeperTestSuite) TestCreateGroup() {
+	app, ctx, addrs := suite.app, suite.ctx, suite.addrs
+
+	group, found := app.GroupKeeper.GetGroupInfo(ctx, suite.groupID)
+	suite.Require().True(found)
+	suite.Require().Equal(addrs[0], group.Admin)
+	suite.Require().Equal(sdk.NewDec(6), group.TotalWeight)
+	suite.Require().Equal(uint64(1), group.Version)
+	suite.Require().Len(app.GroupKeeper.GetGroupMembers(ctx, suite.groupID), 3)
+
+	policyInfo, found := app.GroupKeeper.GetGroupPolicyInfo(ctx, suite.policy)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.groupID, policyInfo.GroupID)
+	suite.Require().Equal(time.Hour, policyInfo.GetDecisionPolicy().GetTimeout())
+	suite.Require().NotNil(app.AccountKeeper.GetAccount(ctx, suite.policy))
+
+	// a second policy gets a distinct account
+	policy2, err := app.GroupKeeper.CreateGroupPolicy(ctx, addrs[0], suite.groupID, "", types.NewThresholdDecisionPolicy(sdk.NewDec(1), time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(suite.policy, policy2)
+	suite.Require().Len(app.GroupKeeper.GetGroupPoliciesByGroup(ctx, suite.groupID), 2)
+
+	// only the admin can create policies
+	_, err = app.GroupKeeper.CreateGroupPolicy(ctx, addrs[1], suite.groupID, "", types.NewThresholdDecisionPolicy(sdk.NewDec(1), time.Hour))
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestUpdateGroup() {
+	app, ctx, addrs := suite.app, suite.ctx, suite.addrs
+
+	updates := types.Members{
+		types.NewMember(addrs[1], sdk.ZeroDec(), ""),
+		types.NewMember(addrs[2], sdk.NewDec(5), ""),
+		types.NewMember(addrs[4], sdk.NewDec(1), ""),
+	}
+	suite.Require().Error(app.GroupKeeper.UpdateGroupMembers(ctx, addrs[1], suite.groupID, updates))
+	suite.Require().NoError(app.GroupKeeper.UpdateGroupMembers(ctx, addrs[0], suite.groupID, updates))
+
+	group, _ := app.GroupKeeper.GetGroupInfo(ctx, suite.groupID)
+	suite.Require().Equal(sdk.NewDec(9), group.TotalWeight)
+	suite.Require().Equal(uint64(2), group.Version)
+
+	_, found := app.GroupKeeper.GetGroupMember(ctx, suite.groupID, addrs[1])
+	suite.Require().False(found)
+	suite.Require().Len(app.GroupKeeper.GetGroupMembers(ctx, suite.groupID), 3)
+
+	// removing a non-member fails
+	err := app.GroupKeeper.UpdateGroupMembers(ctx, addrs[0], suite.groupID, types.Members{types.NewMember(addrs[1], sdk.ZeroDec(), "")})
+	suite.Require().Error(err)
+
+	suite.Require().NoError(app.GroupKeeper.UpdateGroupAdmin(ctx, addrs[0], suite.groupID, addrs[2]))
+	group, _ = app.GroupKeeper.GetGroupInfo(ctx, suite.groupID)
+	suite.Require().Equal(addrs[2], group.Admin)
+	suite.Require().Error(app.GroupKeeper.UpdateGroupAdmin(ctx, addrs[0], suite.groupID, addrs[1]))
+}
+
+func (suite *KeeperTestSuite) TestProposalAccepted() {
+	app, ctx, addrs := suite.app, suite.ctx, suite.addrs
+
+	// proposers must be group members
+	_, err := app.GroupKeeper.SubmitProposal(ctx, suite.policy, []sdk.AccAddress{addrs[4]}, suite.sendMsgs(30), "")
+	suite.Require().Error(err)
+
+	// msgs must be signed by the group policy account
+	otherMsgs := []sdk.Msg{banktypes.NewMsgSend(addrs[1], addrs[4], sdk.NewCoins(sdk.NewInt64Coin("stake", 30)))}
+	_, err = app.GroupKeeper.SubmitProposal(ctx, suite.policy, []sdk.AccAddress{addrs[1]}, otherMsgs, "")
+	suite.Require().True(types.ErrInvalidProposal.Is(err))
+
+	proposalID, err := app.GroupKeeper.SubmitProposal(ctx, suite.policy, []sdk.AccAddress{addrs[1]}, suite.sendMsgs(30), "")
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(app.GroupKeeper.Vote(ctx, proposalID, addrs[1], types.ChoiceYes, ""))
+	suite.Require().Error(app.GroupKeeper.Vote(ctx, proposalID, addrs[1], types.ChoiceNo, ""))
+	suite.Require().Error(app.GroupKeeper.Vote(ctx, proposalID, addrs[4], types.ChoiceYes, ""))
+
+	// the threshold has not been reached yet
+	suite.Require().Error(app.GroupKeeper.Exec(ctx, proposalID))
+
+	suite.Require().NoError(app.GroupKeeper.Vote(ctx, proposalID, addrs[2], types.ChoiceYes, ""))
+	proposal, _ := app.GroupKeeper.GetProposal(ctx, proposalID)
+	suite.Require().Equal(types.ProposalStatusClosed, proposal.Status)
+	suite.Require().Equal(types.ProposalResultAccepted, proposal.Result)
+	suite.Require().Equal(sdk.NewDec(3), proposal.VoteState.YesCount)
+	suite.Require().Len(app.GroupKeeper.GetVotes(ctx, proposalID), 2)
+
+	// votes are no longer accepted once the proposal is closed
+	suite.Require().Error(app.GroupKeeper.Vote(ctx, proposalID, addrs[3], types.ChoiceNo, ""))
+
+	suite.Require().NoError(app.GroupKeeper.Exec(ctx, proposalID))
+	proposal, _ = app.GroupKeeper.GetProposal(ctx, proposalID)
+	suite.Require().Equal(types.ProposalExecutorResultSuccess, proposal.ExecutorResult)
+	suite.Require().Equal(sdk.NewInt64Coin("stake", 70), app.BankKeeper.GetBalance(ctx, suite.policy, "stake"))
+
+	// executing again does not run the messages twice
+	suite.Require().NoError(app.GroupKeeper.Exec(ctx, proposalID))
+	suite.Require().Equal(sdk.NewInt64Coin("stake", 70), app.BankKeeper.GetBalance(ctx, suite.policy, "stake"))
+}
+
+func (suite *KeeperTestSuite) TestProposalExecutionFailure() {
+	app, ctx, addrs := suite.app, suite.ctx, suite.addrs
+
+	proposalID, err := app.GroupKeeper.SubmitProposal(ctx, suite.policy, []sdk.AccAddress{addrs[3]}, suite.sendMsgs(1000), "")
+	suite.Require().NoError(err)
+	suite.Require().NoError(app.GroupKeeper.Vote(ctx, proposalID, addrs[3], types.ChoiceYes, ""))
+
+	suite.Require().NoError(app.GroupKeeper.Exec(ctx, proposalID))
+	proposal, _ := app.GroupKeeper.GetProposal(ctx, proposalID)
+	suite.Require().Equal(types.ProposalExecutorResultFailure, proposal.ExecutorResult)
+	suite.Require().Equal(sdk.NewInt64Coin("stake", 100), app.BankKeeper.GetBalance(ctx, suite.policy, "stake"))
+
+	// a failed execution can be retried
+	err = app.BankKeeper.SetBalances(ctx, suite.policy, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)))
+	suite.Require().NoError(err)
+	suite.Require().NoError(app.GroupKeeper.Exec(ctx, proposalID))
+	proposal, _ = app.GroupKeeper.GetProposal(ctx, proposalID)
+	suite.Require().Equal(types.ProposalExecutorResultSuccess, proposal.ExecutorResult)
+}
+
+func (suite *KeeperTestSuite) TestProposalRejected() {
+	app, ctx, addrs := suite.app, suite.ctx, suite.addrs
+
+	// the threshold can no longer be reached once weight 4 votes no
+	proposalID, err := app.GroupKeeper.SubmitProposal(ctx, suite.policy, []sdk.AccAddress{addrs[1]}, suite.sendMsgs(30), "")
+	suite.Require().NoError(err)
+	suite.Require().NoError(app.GroupKeeper.Vote(ctx, proposalID, addrs[1], types.ChoiceNo, ""))
+	suite.Require().NoError(app.GroupKeeper.Vote(ctx, proposalID, addrs[3], types.ChoiceVeto, ""))
+
+	proposal, _ := app.GroupKeeper.GetProposal(ctx, proposalID)
+	suite.Require().Equal(types.ProposalStatusClosed, proposal.Status)
+	suite.Require().Equal(types.ProposalResultRejected, proposal.Result)
+
+	suite.Require().NoError(app.GroupKeeper.Exec(ctx, proposalID))
+	proposal, _ = app.GroupKeeper.GetProposal(ctx, proposalID)
+	suite.Require().Equal(types.ProposalExecutorResultNotRun, proposal.ExecutorResult)
+	suite.Require().Equal(sdk.NewInt64Coin("stake", 100), app.BankKeeper.GetBalance(ctx, suite.policy, "stake"))
+
+	// a proposal without enough votes is rejected after its timeout
+	proposalID, err = app.GroupKeeper.SubmitProposal(ctx, suite.policy, []sdk.AccAddress{addrs[1]}, suite.sendMsgs(30), "")
+	suite.Require().NoError(err)
+	suite.Require().NoError(app.GroupKeeper.Vote(ctx, proposalID, addrs[2], types.ChoiceYes, ""))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	suite.Require().Error(app.GroupKeeper.Vote(ctx, proposalID, addrs[3], types.ChoiceYes, ""))
+	suite.Require().NoError(app.GroupKeeper.Exec(ctx, proposalID))
+
+	proposal, _ = app.GroupKeeper.GetProposal(ctx, proposalID)
+	suite.Require().Equal(types.ProposalStatusClosed, proposal.Status)
+	suite.Require().Equal(types.ProposalResultRejected, proposal.Result)
+}
+
+func (suite *KeeperTestSuite) TestProposalAborted() {
+	app, ctx, addrs := suite.app, suite.ctx, suite.addrs
+
+	proposalID, err := app.GroupKeeper.SubmitProposal(ctx, suite.policy, []sdk.AccAddress{addrs[1]}, suite.sendMsgs(30), "")
+	suite.Require().NoError(err)
+
+	updates := types.Members{types.NewMember(addrs[4], sdk.NewDec(1), "")}
+	suite.Require().NoError(app.GroupKeeper.UpdateGroupMembers(ctx, addrs[0], suite.groupID, updates))
+
+	suite.Require().Error(app.GroupKeeper.Vote(ctx, proposalID, addrs[3], types.ChoiceYes, ""))
+	suite.Require().NoError(app.GroupKeeper.Exec(ctx, proposalID))
+
+	proposal, _ := app.GroupKeeper.GetProposal(ctx, proposalID)
+	suite.Require().Equal(types.ProposalStatusAborted, proposal.Status)
+	suite.Require().Equal(types.ProposalExecutorResultNotRun, proposal.ExecutorResult)
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	app, ctx, addrs := suite.app, suite.ctx, suite.addrs
+
+	proposalID, err := app.GroupKeeper.SubmitProposal(ctx, suite.policy, []sdk.AccAddress{addrs[1]}, suite.sendMsgs(30), "")
+	suite.Require().NoError(err)
+	suite.Require().NoError(app.GroupKeeper.Vote(ctx, proposalID, addrs[1], types.ChoiceYes, ""))
+
+	exported := group.ExportGenesis(ctx, app.GroupKeeper)
+	suite.Require().NoError(exported.Validate())
+	suite.Require().Len(exported.Groups, 1)
+	suite.Require().Len(exported.GroupMembers, 3)
+	suite.Require().Len(exported.GroupPolicies, 1)
+	suite.Require().Len(exported.Proposals, 1)
+	suite.Require().Len(exported.Votes, 1)
+
+	// round trip through JSON as done when exporting and importing a chain
+	cdc := app.AppCodec()
+	var gs types.GenesisState
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(exported), &gs)
+
+	app2 := simapp.Setup(false)
+	ctx2 := app2.BaseApp.NewContext(false, abci.Header{Time: ctx.BlockTime()})
+	group.InitGenesis(ctx2, app2.GroupKeeper, gs)
+	suite.Require().Equal(cdc.MustMarshalJSON(exported), cdc.MustMarshalJSON(group.ExportGenesis(ctx2, app2.GroupKeeper)))
+
+	// new IDs do not collide with imported ones
+	groupID, err := app2.GroupKeeper.CreateGroup(ctx2, addrs[0], types.Members{types.NewMember(addrs[1], sdk.OneDec(), "")}, "")
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.groupID+1, groupID)
+
+	policy, err := app2.GroupKeeper.CreateGroupPolicy(ctx2, addrs[0], groupID, "", types.NewThresholdDecisionPolicy(sdk.OneDec(), time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(suite.policy, policy)
+}
+
+func (suite *KeeperTestSuite) TestQuerier() {
+	app, ctx := suite.app, suite.ctx
+	querier := keeper.NewQuerier(app.GroupKeeper)
+	cdc := app.AppCodec()
+
+	req := abci.RequestQuery{Data: cdc.MustMarshalJSON(types.NewQueryGroupParams(suite.groupID))}
+	bz, err := querier(ctx, []string{types.QueryGroupPolicies}, req)
+	suite.Require().NoError(err)
+
+	var policies types.GroupPolicies
+	suite.Require().NoError(cdc.UnmarshalJSON(bz, &policies))
+	suite.Require().Len(policies, 1)
+	suite.Require().Equal(suite.policy, policies[0].Address)
+	suite.Require().NotNil(policies[0].GetDecisionPolicy())
+
+	req = abci.RequestQuery{Data: cdc.MustMarshalJSON(types.NewQueryGroupParams(suite.groupID + 1))}
+	_, err = querier(ctx, []string{types.QueryGroup}, req)
+	suite.Require().Error(err)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
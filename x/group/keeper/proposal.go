@@ -0,0 +1,356 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// SubmitProposal submits a new proposal for the group policy account to
+// execute the provided messages and returns its ID. All proposers must be
+// members of the policy's group.
+func (k Keeper) SubmitProposal(
+	ctx sdk.Context, address sdk.AccAddress, proposers []sdk.AccAddress, msgs []sdk.Msg, metadata string,
+) (uint64, error) {
+	policyInfo, found := k.GetGroupPolicyInfo(ctx, address)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrGroupPolicyNotFound, "group policy %s", address)
+	}
+
+	group, found := k.GetGroupInfo(ctx, policyInfo.GroupID)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrGroupNotFound, "group %d", policyInfo.GroupID)
+	}
+
+	for _, proposer := range proposers {
+		if _, found := k.GetGroupMember(ctx, group.GroupID, proposer); !found {
+			return 0, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a member of group %d", proposer, group.GroupID)
+		}
+	}
+
+	// reject proposals that could never be executed before they are voted on
+	if err := validateProposalMsgs(address, msgs); err != nil {
+		return 0, err
+	}
+
+	proposalID := k.getNextID(ctx, types.ProposalIDKey)
+	timeout := ctx.BlockTime().Add(policyInfo.GetDecisionPolicy().GetTimeout())
+	proposal, err := types.NewProposal(
+		proposalID, address, metadata, proposers, msgs, ctx.BlockTime(), group.Version, timeout,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	k.SetProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+		),
+	)
+
+	return proposalID, nil
+}
+
+// GetProposal returns the proposal with the provided ID.
+func (k Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (proposal types.Proposal, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ProposalKey(proposalID))
+	if bz == nil {
+		return proposal, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &proposal)
+	return proposal, true
+}
+
+// SetProposal stores a proposal.
+func (k Keeper) SetProposal(ctx sdk.Context, proposal types.Proposal) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ProposalKey(proposal.ProposalID), k.cdc.MustMarshalBinaryBare(&proposal))
+}
+
+// IterateProposals iterates over all proposals and performs a callback
+// function.
+func (k Keeper) IterateProposals(ctx sdk.Context, cb func(proposal types.Proposal) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ProposalKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.Proposal
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &proposal)
+
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
+// GetProposalsByGroupPolicy returns all the proposals submitted to a group
+// policy.
+func (k Keeper) GetProposalsByGroupPolicy(ctx sdk.Context, address sdk.AccAddress) types.Proposals {
+	proposals := types.Proposals{}
+	k.IterateProposals(ctx, func(proposal types.Proposal) bool {
+		if proposal.Address.Equals(address) {
+			proposals = append(proposals, proposal)
+		}
+		return false
+	})
+
+	return proposals
+}
+
+// Vote casts a group member's vote on an open proposal. The vote is weighted
+// by the member's weight and the proposal is closed as soon as the decision
+// policy reaches a final result.
+func (k Keeper) Vote(
+	ctx sdk.Context, proposalID uint64, voter sdk.AccAddress, choice types.VoteChoice, metadata string,
+) error {
+	proposal, policyInfo, err := k.getProposalAndPolicy(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	if proposal.Status != types.ProposalStatusSubmitted {
+		return sdkerrors.Wrapf(types.ErrInvalidProposalState, "proposal %d is %s", proposalID, proposal.Status)
+	}
+	if !ctx.BlockTime().Before(proposal.Timeout) {
+		return sdkerrors.Wrapf(types.ErrExpired, "proposal %d", proposalID)
+	}
+
+	group, found := k.GetGroupInfo(ctx, policyInfo.GroupID)
+	if !found || group.Version != proposal.GroupVersion {
+		return sdkerrors.Wrapf(types.ErrInvalidProposalState, "group %d was modified after proposal %d was submitted", policyInfo.GroupID, proposalID)
+	}
+
+	member, found := k.GetGroupMember(ctx, group.GroupID, voter)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a member of group %d", voter, group.GroupID)
+	}
+
+	if _, found := k.GetVote(ctx, proposalID, voter); found {
+		return sdkerrors.Wrapf(types.ErrInvalidVote, "%s has already voted on proposal %d", voter, proposalID)
+	}
+
+	if err := proposal.VoteState.Add(choice, member.Member.Weight); err != nil {
+		return err
+	}
+
+	k.SetVote(ctx, types.Vote{
+		ProposalID:  proposalID,
+		Voter:       voter,
+		Choice:      choice,
+		Metadata:    metadata,
+		SubmittedAt: ctx.BlockTime(),
+	})
+
+	if err := k.updateProposalStatus(ctx, &proposal, policyInfo); err != nil {
+		return err
+	}
+	k.SetProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVote,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
+			sdk.NewAttribute(types.AttributeKeyChoice, choice.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetVote returns the vote cast by a voter on a proposal.
+func (k Keeper) GetVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (vote types.Vote, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.VoteKey(proposalID, voter))
+	if bz == nil {
+		return vote, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &vote)
+	return vote, true
+}
+
+// SetVote stores a vote.
+func (k Keeper) SetVote(ctx sdk.Context, vote types.Vote) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.VoteKey(vote.ProposalID, vote.Voter), k.cdc.MustMarshalBinaryBare(&vote))
+}
+
+// IterateVotes iterates over the votes cast on all proposals and performs a
+// callback function.
+func (k Keeper) IterateVotes(ctx sdk.Context, cb func(vote types.Vote) (stop bool)) {
+	k.iterateVotes(ctx, types.VoteKeyPrefix, cb)
+}
+
+// GetVotes returns all the votes cast on a proposal.
+func (k Keeper) GetVotes(ctx sdk.Context, proposalID uint64) []types.Vote {
+	votes := []types.Vote{}
+	k.iterateVotes(ctx, types.VotesKey(proposalID), func(vote types.Vote) bool {
+		votes = append(votes, vote)
+		return false
+	})
+
+	return votes
+}
+
+func (k Keeper) iterateVotes(ctx sdk.Context, prefix []byte, cb func(vote types.Vote) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.Vote
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &vote)
+
+		if cb(vote) {
+			break
+		}
+	}
+}
+
+// Exec executes the messages of an accepted proposal on behalf of its group
+// policy account. The status of a proposal whose voting period has ended is
+// finalized first. A failed execution is recorded on the proposal and can be
+// retried; only the messages' state changes are discarded.
+func (k Keeper) Exec(ctx sdk.Context, proposalID uint64) error {
+	proposal, policyInfo, err := k.getProposalAndPolicy(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	if err := k.updateProposalStatus(ctx, &proposal, policyInfo); err != nil {
+		return err
+	}
+	if proposal.Status == types.ProposalStatusSubmitted {
+		return sdkerrors.Wrapf(types.ErrInvalidProposalState, "proposal %d is still open", proposalID)
+	}
+
+	if proposal.Status == types.ProposalStatusClosed &&
+		proposal.Result == types.ProposalResultAccepted &&
+		proposal.ExecutorResult != types.ProposalExecutorResultSuccess {
+		if err := k.executeMsgs(ctx, proposal); err != nil {
+			proposal.ExecutorResult = types.ProposalExecutorResultFailure
+			k.Logger(ctx).Info("proposal execution failed", "proposal", proposalID, "err", err.Error())
+		} else {
+			proposal.ExecutorResult = types.ProposalExecutorResultSuccess
+		}
+	}
+
+	k.SetProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExec,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyResult, proposal.ExecutorResult.String()),
+		),
+	)
+
+	return nil
+}
+
+// executeMsgs dispatches the messages of a proposal in a cached context,
+// persisting their state changes only if all of them succeed.
+func (k Keeper) executeMsgs(ctx sdk.Context, proposal types.Proposal) error {
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	if err := validateProposalMsgs(proposal.Address, msgs); err != nil {
+		return err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	var events sdk.Events
+	for i, msg := range msgs {
+		handler := k.router.Route(cacheCtx, msg.Route())
+		if handler == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", msg.Route())
+		}
+
+		msgResult, err := handler(cacheCtx, msg)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "message %d", i)
+		}
+
+		for _, event := range msgResult.Events {
+			events = append(events, sdk.Event(event))
+		}
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(events)
+
+	return nil
+}
+
+// validateProposalMsgs checks that every message of a proposal is signed only
+// by the group policy account the proposal was submitted to.
+func validateProposalMsgs(address sdk.AccAddress, msgs []sdk.Msg) error {
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(address) {
+			return sdkerrors.Wrapf(types.ErrInvalidProposal, "message %d must only be signed by the group policy account", i)
+		}
+	}
+
+	return nil
+}
+
+// getProposalAndPolicy returns a proposal and the group policy it was
+// submitted to.
+func (k Keeper) getProposalAndPolicy(ctx sdk.Context, proposalID uint64) (types.Proposal, types.GroupPolicyInfo, error) {
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return proposal, types.GroupPolicyInfo{}, sdkerrors.Wrapf(types.ErrProposalNotFound, "proposal %d", proposalID)
+	}
+
+	policyInfo, found := k.GetGroupPolicyInfo(ctx, proposal.Address)
+	if !found {
+		return proposal, policyInfo, sdkerrors.Wrapf(types.ErrGroupPolicyNotFound, "group policy %s", proposal.Address)
+	}
+
+	return proposal, policyInfo, nil
+}
+
+// updateProposalStatus finalizes the status of an open proposal if its group
+// was modified or its decision policy reaches a final result.
+func (k Keeper) updateProposalStatus(ctx sdk.Context, proposal *types.Proposal, policyInfo types.GroupPolicyInfo) error {
+	if proposal.Status != types.ProposalStatusSubmitted {
+		return nil
+	}
+
+	group, found := k.GetGroupInfo(ctx, policyInfo.GroupID)
+	if !found || group.Version != proposal.GroupVersion {
+		proposal.Status = types.ProposalStatusAborted
+		return nil
+	}
+
+	result, err := policyInfo.GetDecisionPolicy().Allow(
+		proposal.VoteState, group.TotalWeight, ctx.BlockTime().Sub(proposal.SubmittedAt),
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.Final {
+		proposal.Status = types.ProposalStatusClosed
+		if result.Allow {
+			proposal.Result = types.ProposalResultAccepted
+		} else {
+			proposal.Result = types.ProposalResultRejected
+		}
+	}
+
+	return nil
+}
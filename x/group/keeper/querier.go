@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// NewQuerier returns a new sdk.Querier for the group module.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		var (
+			res []byte
+			err error
+		)
+
+		switch path[0] {
+		case types.QueryGroup:
+			res, err = queryGroup(ctx, req, k)
+
+		case types.QueryGroupMembers:
+			res, err = queryGroupMembers(ctx, req, k)
+
+		case types.QueryGroupPolicy:
+			res, err = queryGroupPolicy(ctx, req, k)
+
+		case types.QueryGroupPolicies:
+			res, err = queryGroupPolicies(ctx, req, k)
+
+		case types.QueryProposal:
+			res, err = queryProposal(ctx, req, k)
+
+		case types.QueryProposals:
+			res, err = queryProposals(ctx, req, k)
+
+		case types.QueryVotes:
+			res, err = queryVotes(ctx, req, k)
+
+		default:
+			err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
+		}
+
+		return res, err
+	}
+}
+
+func queryGroup(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryGroupParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	group, found := k.GetGroupInfo(ctx, params.GroupID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGroupNotFound, "group %d", params.GroupID)
+	}
+
+	return marshalJSON(k, group)
+}
+
+func queryGroupMembers(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryGroupParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	return marshalJSON(k, k.GetGroupMembers(ctx, params.GroupID))
+}
+
+func queryGroupPolicy(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryGroupPolicyParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	policyInfo, found := k.GetGroupPolicyInfo(ctx, params.Address)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGroupPolicyNotFound, "group policy %s", params.Address)
+	}
+
+	return marshalJSON(k, policyInfo)
+}
+
+func queryGroupPolicies(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryGroupParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	return marshalJSON(k, k.GetGroupPoliciesByGroup(ctx, params.GroupID))
+}
+
+func queryProposal(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryProposalParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	proposal, found := k.GetProposal(ctx, params.ProposalID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrProposalNotFound, "proposal %d", params.ProposalID)
+	}
+
+	return marshalJSON(k, proposal)
+}
+
+func queryProposals(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryGroupPolicyParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	return marshalJSON(k, k.GetProposalsByGroupPolicy(ctx, params.Address))
+}
+
+func queryVotes(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryProposalParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	return marshalJSON(k, k.GetVotes(ctx, params.ProposalID))
+}
+
+func marshalJSON(k Keeper, o interface{}) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(k.cdc, o)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterCodec registers all the necessary types and interfaces for the
+// group module.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterInterface((*DecisionPolicy)(nil), nil)
+	cdc.RegisterConcrete(&ThresholdDecisionPolicy{}, "cosmos-sdk/ThresholdDecisionPolicy", nil)
+	cdc.RegisterConcrete(&MsgCreateGroup{}, "cosmos-sdk/MsgCreateGroup", nil)
+	cdc.RegisterConcrete(&MsgUpdateGroupMembers{}, "cosmos-sdk/MsgUpdateGroupMembers", nil)
+	cdc.RegisterConcrete(&MsgUpdateGroupAdmin{}, "cosmos-sdk/MsgUpdateGroupAdmin", nil)
+	cdc.RegisterConcrete(&MsgCreateGroupPolicy{}, "cosmos-sdk/MsgCreateGroupPolicy", nil)
+	cdc.RegisterConcrete(&MsgSubmitProposal{}, "cosmos-sdk/group/MsgSubmitProposal", nil)
+	cdc.RegisterConcrete(&MsgVote{}, "cosmos-sdk/group/MsgVote", nil)
+	cdc.RegisterConcrete(&MsgExec{}, "cosmos-sdk/group/MsgExec", nil)
+}
+
+// RegisterInterfaces registers the group module's interface types and
+// implementations with the provided registry.
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateGroup{},
+		&MsgUpdateGroupMembers{},
+		&MsgUpdateGroupAdmin{},
+		&MsgCreateGroupPolicy{},
+		&MsgSubmitProposal{},
+		&MsgVote{},
+		&MsgExec{},
+	)
+	registry.RegisterInterface(
+		"cosmos_sdk.group.v1.DecisionPolicy",
+		(*DecisionPolicy)(nil),
+		&ThresholdDecisionPolicy{},
+	)
+}
+
+var (
+	amino = codec.New()
+
+	// ModuleCdc references the global x/group module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding as Amino is
+	// still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/group and
+	// defined at the application level.
+	ModuleCdc = codec.NewHybridCodec(amino, types.NewInterfaceRegistry())
+)
+
+func init() {
+	RegisterCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
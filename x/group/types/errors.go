@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/group module sentinel errors
+var (
+	ErrGroupNotFound            = sdkerrors.Register(ModuleName, 2, "group not found")
+	ErrGroupPolicyNotFound      = sdkerrors.Register(ModuleName, 3, "group policy not found")
+	ErrProposalNotFound         = sdkerrors.Register(ModuleName, 4, "proposal not found")
+	ErrInvalidMembers           = sdkerrors.Register(ModuleName, 5, "invalid group members")
+	ErrInvalidDecisionPolicy    = sdkerrors.Register(ModuleName, 6, "invalid decision policy")
+	ErrInvalidProposal          = sdkerrors.Register(ModuleName, 7, "invalid proposal")
+	ErrInvalidVote              = sdkerrors.Register(ModuleName, 8, "invalid vote")
+	ErrInvalidProposalState     = sdkerrors.Register(ModuleName, 9, "invalid proposal state")
+	ErrExpired                  = sdkerrors.Register(ModuleName, 10, "voting period has ended")
+	ErrGroupPolicyAccountExists = sdkerrors.Register(ModuleName, 11, "group policy account already exists")
+)
@@ -0,0 +1,19 @@
+package types
+
+// group module events
+const (
+	EventTypeCreateGroup       = "create_group"
+	EventTypeUpdateGroup       = "update_group"
+	EventTypeCreateGroupPolicy = "create_group_policy"
+	EventTypeSubmitProposal    = "submit_proposal"
+	EventTypeVote              = "vote"
+	EventTypeExec              = "exec"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyGroupID    = "group_id"
+	AttributeKeyAddress    = "address"
+	AttributeKeyProposalID = "proposal_id"
+	AttributeKeyVoter      = "voter"
+	AttributeKeyChoice     = "choice"
+	AttributeKeyResult     = "result"
+)
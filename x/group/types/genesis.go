@@ -0,0 +1,131 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ types.UnpackInterfacesMessage = GenesisState{}
+
+// GenesisState defines the group module's genesis state.
+type GenesisState struct {
+	Groups        []GroupInfo       `json:"groups" yaml:"groups"`
+	GroupMembers  []GroupMember     `json:"group_members" yaml:"group_members"`
+	GroupPolicies []GroupPolicyInfo `json:"group_policies" yaml:"group_policies"`
+	Proposals     []Proposal        `json:"proposals" yaml:"proposals"`
+	Votes         []Vote            `json:"votes" yaml:"votes"`
+}
+
+// NewGenesisState creates a new GenesisState object.
+func NewGenesisState(
+	groups []GroupInfo, groupMembers []GroupMember, groupPolicies []GroupPolicyInfo, proposals []Proposal, votes []Vote,
+) GenesisState {
+	return GenesisState{
+		Groups:        groups,
+		GroupMembers:  groupMembers,
+		GroupPolicies: groupPolicies,
+		Proposals:     proposals,
+		Votes:         votes,
+	}
+}
+
+// DefaultGenesisState returns the group module's default genesis state.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]GroupInfo{}, []GroupMember{}, []GroupPolicyInfo{}, []Proposal{}, []Vote{})
+}
+
+// Validate performs genesis state validation returning an error upon any
+// failure. Every member, policy, proposal and vote must refer to an entry
+// defined earlier in the genesis state.
+func (gs GenesisState) Validate() error {
+	groups := make(map[uint64]bool, len(gs.Groups))
+	for _, g := range gs.Groups {
+		if g.GroupID == 0 {
+			return fmt.Errorf("group id cannot be zero")
+		}
+		if groups[g.GroupID] {
+			return fmt.Errorf("duplicate group %d", g.GroupID)
+		}
+		if g.Admin.Empty() {
+			return fmt.Errorf("group %d: admin cannot be empty", g.GroupID)
+		}
+		groups[g.GroupID] = true
+	}
+
+	members := make(map[uint64]Members)
+	for _, m := range gs.GroupMembers {
+		if !groups[m.GroupID] {
+			return fmt.Errorf("member %s: unknown group %d", m.Member.Address, m.GroupID)
+		}
+		members[m.GroupID] = append(members[m.GroupID], m.Member)
+	}
+	for groupID, ms := range members {
+		if err := ms.ValidateBasic(); err != nil {
+			return fmt.Errorf("group %d: %w", groupID, err)
+		}
+	}
+
+	policies := make(map[string]bool, len(gs.GroupPolicies))
+	for _, p := range gs.GroupPolicies {
+		if p.Address.Empty() {
+			return fmt.Errorf("group policy address cannot be empty")
+		}
+		if policies[p.Address.String()] {
+			return fmt.Errorf("duplicate group policy %s", p.Address)
+		}
+		if !groups[p.GroupID] {
+			return fmt.Errorf("group policy %s: unknown group %d", p.Address, p.GroupID)
+		}
+
+		decisionPolicy := p.GetDecisionPolicy()
+		if decisionPolicy == nil {
+			return fmt.Errorf("group policy %s: decision policy cannot be nil", p.Address)
+		}
+		if err := decisionPolicy.ValidateBasic(); err != nil {
+			return fmt.Errorf("group policy %s: %w", p.Address, err)
+		}
+		policies[p.Address.String()] = true
+	}
+
+	proposals := make(map[uint64]bool, len(gs.Proposals))
+	for _, p := range gs.Proposals {
+		if p.ProposalID == 0 {
+			return fmt.Errorf("proposal id cannot be zero")
+		}
+		if proposals[p.ProposalID] {
+			return fmt.Errorf("duplicate proposal %d", p.ProposalID)
+		}
+		if !policies[p.Address.String()] {
+			return fmt.Errorf("proposal %d: unknown group policy %s", p.ProposalID, p.Address)
+		}
+		proposals[p.ProposalID] = true
+	}
+
+	for _, v := range gs.Votes {
+		if !proposals[v.ProposalID] {
+			return fmt.Errorf("vote by %s: unknown proposal %d", v.Voter, v.ProposalID)
+		}
+		if v.Voter.Empty() {
+			return fmt.Errorf("proposal %d: voter cannot be empty", v.ProposalID)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, p := range gs.GroupPolicies {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	for _, p := range gs.Proposals {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ types.UnpackInterfacesMessage = GroupPolicyInfo{}
+	_ types.UnpackInterfacesMessage = Proposal{}
+)
+
+// NewMember creates a new group Member.
+func NewMember(address sdk.AccAddress, weight sdk.Dec, metadata string) Member {
+	return Member{
+		Address:  address,
+		Weight:   weight,
+		Metadata: metadata,
+	}
+}
+
+// ValidateBasic performs stateless validation of a group member. A zero
+// weight is only valid when removing a member from a group.
+func (m Member) ValidateBasic() error {
+	if m.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing member address")
+	}
+	if m.Weight.IsNil() || m.Weight.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidMembers, "member %s must have a non-negative weight", m.Address)
+	}
+
+	return nil
+}
+
+// Members defines a list of group members.
+type Members []Member
+
+// ValidateBasic performs stateless validation of a list of group members,
+// ensuring every member is valid and appears only once.
+func (ms Members) ValidateBasic() error {
+	seen := make(map[string]bool, len(ms))
+	for _, m := range ms {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+
+		addr := m.Address.String()
+		if seen[addr] {
+			return sdkerrors.Wrapf(ErrInvalidMembers, "duplicate member %s", addr)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
+
+// NewGroupPolicyInfo creates a new GroupPolicyInfo wrapping the provided
+// DecisionPolicy.
+func NewGroupPolicyInfo(
+	address sdk.AccAddress, groupID uint64, admin sdk.AccAddress, metadata string, decisionPolicy DecisionPolicy,
+) (GroupPolicyInfo, error) {
+	any, err := types.NewAnyWithValue(decisionPolicy)
+	if err != nil {
+		return GroupPolicyInfo{}, err
+	}
+
+	return GroupPolicyInfo{
+		Address:        address,
+		GroupID:        groupID,
+		Admin:          admin,
+		Metadata:       metadata,
+		DecisionPolicy: any,
+	}, nil
+}
+
+// GetDecisionPolicy returns the cached DecisionPolicy of the group policy or
+// nil if it has not been unpacked.
+func (p GroupPolicyInfo) GetDecisionPolicy() DecisionPolicy {
+	decisionPolicy, ok := p.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return decisionPolicy
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p GroupPolicyInfo) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(p.DecisionPolicy, &decisionPolicy)
+}
+
+// NewProposal creates a new open Proposal for the group policy account to
+// execute the provided messages.
+func NewProposal(
+	proposalID uint64, address sdk.AccAddress, metadata string, proposers []sdk.AccAddress, msgs []sdk.Msg,
+	submittedAt time.Time, groupVersion uint64, timeout time.Time,
+) (Proposal, error) {
+	anys, err := packMsgs(msgs)
+	if err != nil {
+		return Proposal{}, err
+	}
+
+	return Proposal{
+		ProposalID:     proposalID,
+		Address:        address,
+		Metadata:       metadata,
+		Proposers:      proposers,
+		SubmittedAt:    submittedAt,
+		GroupVersion:   groupVersion,
+		Status:         ProposalStatusSubmitted,
+		Result:         ProposalResultUnfinalized,
+		VoteState:      NewTally(),
+		Timeout:        timeout,
+		ExecutorResult: ProposalExecutorResultNotRun,
+		Msgs:           anys,
+	}, nil
+}
+
+// GetMsgs returns the unpacked messages of the proposal.
+func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
+	return unpackMsgs(p.Msgs)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p Proposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return unpackMsgsInterfaces(p.Msgs, unpacker)
+}
+
+// packMsgs packs a list of messages into Anys.
+func packMsgs(msgs []sdk.Msg) ([]*types.Any, error) {
+	anys := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := types.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+
+	return anys, nil
+}
+
+// unpackMsgs returns the cached messages of a list of Anys.
+func unpackMsgs(anys []*types.Any) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(anys))
+	for i, any := range anys {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "message %d cannot be unpacked into %T", i, (*sdk.Msg)(nil))
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+func unpackMsgsInterfaces(anys []*types.Any, unpacker types.AnyUnpacker) error {
+	for _, any := range anys {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateProposalMsgs ensures a proposal's messages are valid and can only be
+// signed by the group policy account.
+func validateProposalMsgs(address sdk.AccAddress, msgs []sdk.Msg) error {
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "message %d", i)
+		}
+
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(address) {
+			return sdkerrors.Wrapf(ErrInvalidProposal, "message %d must only be signed by the group policy account", i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "group"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// KVStore keys
+//
+// - 0x00: next group ID
+// - 0x01<group_id_bytes>: GroupInfo
+// - 0x02<group_id_bytes><member_address_bytes>: GroupMember
+// - 0x03: next group policy ID
+// - 0x04<policy_address_bytes>: GroupPolicyInfo
+// - 0x05: next proposal ID
+// - 0x06<proposal_id_bytes>: Proposal
+// - 0x07<proposal_id_bytes><voter_address_bytes>: Vote
+var (
+	GroupIDKey           = []byte{0x00}
+	GroupKeyPrefix       = []byte{0x01}
+	GroupMemberKeyPrefix = []byte{0x02}
+	GroupPolicyIDKey     = []byte{0x03}
+	GroupPolicyKeyPrefix = []byte{0x04}
+	ProposalIDKey        = []byte{0x05}
+	ProposalKeyPrefix    = []byte{0x06}
+	VoteKeyPrefix        = []byte{0x07}
+)
+
+// GetIDBytes returns the big endian byte representation of an ID.
+func GetIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// GetIDFromBytes returns an ID from its big endian byte representation.
+func GetIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// GroupKey returns the store key of a group.
+func GroupKey(groupID uint64) []byte {
+	return append(GroupKeyPrefix, GetIDBytes(groupID)...)
+}
+
+// GroupMembersKey returns the store prefix of the members of a group.
+func GroupMembersKey(groupID uint64) []byte {
+	return append(GroupMemberKeyPrefix, GetIDBytes(groupID)...)
+}
+
+// GroupMemberKey returns the store key of a group member.
+func GroupMemberKey(groupID uint64, member sdk.AccAddress) []byte {
+	return append(GroupMembersKey(groupID), member.Bytes()...)
+}
+
+// GroupPolicyKey returns the store key of a group policy.
+func GroupPolicyKey(address sdk.AccAddress) []byte {
+	return append(GroupPolicyKeyPrefix, address.Bytes()...)
+}
+
+// ProposalKey returns the store key of a proposal.
+func ProposalKey(proposalID uint64) []byte {
+	return append(ProposalKeyPrefix, GetIDBytes(proposalID)...)
+}
+
+// VotesKey returns the store prefix of the votes cast on a proposal.
+func VotesKey(proposalID uint64) []byte {
+	return append(VoteKeyPrefix, GetIDBytes(proposalID)...)
+}
+
+// VoteKey returns the store key of a vote cast by a voter on a proposal.
+func VoteKey(proposalID uint64, voter sdk.AccAddress) []byte {
+	return append(VotesKey(proposalID), voter.Bytes()...)
+}
+
+// GroupPolicyAddress returns the deterministic account address of the group
+// policy with the provided sequence number.
+func GroupPolicyAddress(policyID uint64) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("%s/policy/%d", ModuleName, policyID))))
+}
@@ -0,0 +1,414 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// group message types
+const (
+	TypeMsgCreateGroup        = "create_group"
+	TypeMsgUpdateGroupMembers = "update_group_members"
+	TypeMsgUpdateGroupAdmin   = "update_group_admin"
+	TypeMsgCreateGroupPolicy  = "create_group_policy"
+	TypeMsgSubmitProposal     = "submit_proposal"
+	TypeMsgVote               = "vote"
+	TypeMsgExec               = "exec"
+)
+
+var (
+	_ sdk.Msg                       = &MsgCreateGroup{}
+	_ sdk.Msg                       = &MsgUpdateGroupMembers{}
+	_ sdk.Msg                       = &MsgUpdateGroupAdmin{}
+	_ sdk.Msg                       = &MsgCreateGroupPolicy{}
+	_ sdk.Msg                       = &MsgSubmitProposal{}
+	_ sdk.Msg                       = &MsgVote{}
+	_ sdk.Msg                       = &MsgExec{}
+	_ types.UnpackInterfacesMessage = MsgCreateGroupPolicy{}
+	_ types.UnpackInterfacesMessage = MsgSubmitProposal{}
+)
+
+// NewMsgCreateGroup creates a new MsgCreateGroup.
+func NewMsgCreateGroup(admin sdk.AccAddress, members []Member, metadata string) *MsgCreateGroup {
+	return &MsgCreateGroup{
+		Admin:    admin,
+		Members:  members,
+		Metadata: metadata,
+	}
+}
+
+// Route returns the MsgCreateGroup's route.
+func (msg MsgCreateGroup) Route() string { return RouterKey }
+
+// Type returns the MsgCreateGroup's type.
+func (msg MsgCreateGroup) Type() string { return TypeMsgCreateGroup }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a
+// MsgCreateGroup.
+func (msg MsgCreateGroup) ValidateBasic() error {
+	if msg.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+	if len(msg.Members) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMembers, "a group must have at least one member")
+	}
+	for _, m := range msg.Members {
+		if !m.Weight.IsNil() && m.Weight.IsZero() {
+			return sdkerrors.Wrapf(ErrInvalidMembers, "member %s must have a positive weight", m.Address)
+		}
+	}
+
+	return Members(msg.Members).ValidateBasic()
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when
+// submitting a MsgCreateGroup message.
+func (msg MsgCreateGroup) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the single expected signer for a MsgCreateGroup.
+func (msg MsgCreateGroup) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// NewMsgUpdateGroupMembers creates a new MsgUpdateGroupMembers.
+func NewMsgUpdateGroupMembers(admin sdk.AccAddress, groupID uint64, memberUpdates []Member) *MsgUpdateGroupMembers {
+	return &MsgUpdateGroupMembers{
+		Admin:         admin,
+		GroupID:       groupID,
+		MemberUpdates: memberUpdates,
+	}
+}
+
+// Route returns the MsgUpdateGroupMembers's route.
+func (msg MsgUpdateGroupMembers) Route() string { return RouterKey }
+
+// Type returns the MsgUpdateGroupMembers's type.
+func (msg MsgUpdateGroupMembers) Type() string { return TypeMsgUpdateGroupMembers }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a
+// MsgUpdateGroupMembers.
+func (msg MsgUpdateGroupMembers) ValidateBasic() error {
+	if msg.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+	if msg.GroupID == 0 {
+		return sdkerrors.Wrap(ErrGroupNotFound, "missing group id")
+	}
+	if len(msg.MemberUpdates) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMembers, "missing member updates")
+	}
+
+	return Members(msg.MemberUpdates).ValidateBasic()
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when
+// submitting a MsgUpdateGroupMembers message.
+func (msg MsgUpdateGroupMembers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the single expected signer for a MsgUpdateGroupMembers.
+func (msg MsgUpdateGroupMembers) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// NewMsgUpdateGroupAdmin creates a new MsgUpdateGroupAdmin.
+func NewMsgUpdateGroupAdmin(admin sdk.AccAddress, groupID uint64, newAdmin sdk.AccAddress) *MsgUpdateGroupAdmin {
+	return &MsgUpdateGroupAdmin{
+		Admin:    admin,
+		GroupID:  groupID,
+		NewAdmin: newAdmin,
+	}
+}
+
+// Route returns the MsgUpdateGroupAdmin's route.
+func (msg MsgUpdateGroupAdmin) Route() string { return RouterKey }
+
+// Type returns the MsgUpdateGroupAdmin's type.
+func (msg MsgUpdateGroupAdmin) Type() string { return TypeMsgUpdateGroupAdmin }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a
+// MsgUpdateGroupAdmin.
+func (msg MsgUpdateGroupAdmin) ValidateBasic() error {
+	if msg.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+	if msg.NewAdmin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing new admin address")
+	}
+	if msg.Admin.Equals(msg.NewAdmin) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "new admin is the same as the current admin")
+	}
+	if msg.GroupID == 0 {
+		return sdkerrors.Wrap(ErrGroupNotFound, "missing group id")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when
+// submitting a MsgUpdateGroupAdmin message.
+func (msg MsgUpdateGroupAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the single expected signer for a MsgUpdateGroupAdmin.
+func (msg MsgUpdateGroupAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// NewMsgCreateGroupPolicy creates a new MsgCreateGroupPolicy.
+func NewMsgCreateGroupPolicy(
+	admin sdk.AccAddress, groupID uint64, metadata string, decisionPolicy DecisionPolicy,
+) (*MsgCreateGroupPolicy, error) {
+	any, err := types.NewAnyWithValue(decisionPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgCreateGroupPolicy{
+		Admin:          admin,
+		GroupID:        groupID,
+		Metadata:       metadata,
+		DecisionPolicy: any,
+	}, nil
+}
+
+// Route returns the MsgCreateGroupPolicy's route.
+func (msg MsgCreateGroupPolicy) Route() string { return RouterKey }
+
+// Type returns the MsgCreateGroupPolicy's type.
+func (msg MsgCreateGroupPolicy) Type() string { return TypeMsgCreateGroupPolicy }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a
+// MsgCreateGroupPolicy.
+func (msg MsgCreateGroupPolicy) ValidateBasic() error {
+	if msg.Admin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing admin address")
+	}
+	if msg.GroupID == 0 {
+		return sdkerrors.Wrap(ErrGroupNotFound, "missing group id")
+	}
+
+	decisionPolicy := msg.GetDecisionPolicy()
+	if decisionPolicy == nil {
+		return sdkerrors.Wrap(ErrInvalidDecisionPolicy, "missing decision policy")
+	}
+
+	return decisionPolicy.ValidateBasic()
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when
+// submitting a MsgCreateGroupPolicy message.
+func (msg MsgCreateGroupPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the single expected signer for a MsgCreateGroupPolicy.
+func (msg MsgCreateGroupPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// GetDecisionPolicy returns the cached DecisionPolicy of the message or nil
+// if it has not been unpacked.
+func (msg MsgCreateGroupPolicy) GetDecisionPolicy() DecisionPolicy {
+	decisionPolicy, ok := msg.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return decisionPolicy
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgCreateGroupPolicy) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(msg.DecisionPolicy, &decisionPolicy)
+}
+
+// NewMsgSubmitProposal creates a new MsgSubmitProposal.
+func NewMsgSubmitProposal(
+	address sdk.AccAddress, proposers []sdk.AccAddress, msgs []sdk.Msg, metadata string,
+) (*MsgSubmitProposal, error) {
+	anys, err := packMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSubmitProposal{
+		Address:   address,
+		Proposers: proposers,
+		Metadata:  metadata,
+		Msgs:      anys,
+	}, nil
+}
+
+// Route returns the MsgSubmitProposal's route.
+func (msg MsgSubmitProposal) Route() string { return RouterKey }
+
+// Type returns the MsgSubmitProposal's type.
+func (msg MsgSubmitProposal) Type() string { return TypeMsgSubmitProposal }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a
+// MsgSubmitProposal.
+func (msg MsgSubmitProposal) ValidateBasic() error {
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing group policy address")
+	}
+	if len(msg.Proposers) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProposal, "missing proposers")
+	}
+
+	seen := make(map[string]bool, len(msg.Proposers))
+	for _, proposer := range msg.Proposers {
+		if proposer.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing proposer address")
+		}
+		if seen[proposer.String()] {
+			return sdkerrors.Wrapf(ErrInvalidProposal, "duplicate proposer %s", proposer)
+		}
+		seen[proposer.String()] = true
+	}
+
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	return validateProposalMsgs(msg.Address, msgs)
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when
+// submitting a MsgSubmitProposal message. The proposal's messages are
+// represented by their own sign bytes since their concrete types are not known
+// to the module codec.
+func (msg MsgSubmitProposal) GetSignBytes() []byte {
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		panic(err)
+	}
+
+	msgsBytes := make([]json.RawMessage, 0, len(msgs))
+	for _, m := range msgs {
+		msgsBytes = append(msgsBytes, json.RawMessage(m.GetSignBytes()))
+	}
+
+	bz, err := ModuleCdc.MarshalJSON(submitProposalSignDoc{
+		Address:   msg.Address,
+		Proposers: msg.Proposers,
+		Metadata:  msg.Metadata,
+		Msgs:      msgsBytes,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the proposers, which must all sign a MsgSubmitProposal.
+func (msg MsgSubmitProposal) GetSigners() []sdk.AccAddress {
+	return msg.Proposers
+}
+
+// GetMsgs returns the unpacked messages of the proposal.
+func (msg MsgSubmitProposal) GetMsgs() ([]sdk.Msg, error) {
+	return unpackMsgs(msg.Msgs)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgSubmitProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return unpackMsgsInterfaces(msg.Msgs, unpacker)
+}
+
+// submitProposalSignDoc defines the structure signed by the proposers of a
+// MsgSubmitProposal.
+type submitProposalSignDoc struct {
+	Address   sdk.AccAddress    `json:"address" yaml:"address"`
+	Proposers []sdk.AccAddress  `json:"proposers" yaml:"proposers"`
+	Metadata  string            `json:"metadata" yaml:"metadata"`
+	Msgs      []json.RawMessage `json:"msgs" yaml:"msgs"`
+}
+
+// NewMsgVote creates a new MsgVote.
+func NewMsgVote(proposalID uint64, voter sdk.AccAddress, choice VoteChoice, metadata string) *MsgVote {
+	return &MsgVote{
+		ProposalID: proposalID,
+		Voter:      voter,
+		Choice:     choice,
+		Metadata:   metadata,
+	}
+}
+
+// Route returns the MsgVote's route.
+func (msg MsgVote) Route() string { return RouterKey }
+
+// Type returns the MsgVote's type.
+func (msg MsgVote) Type() string { return TypeMsgVote }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgVote.
+func (msg MsgVote) ValidateBasic() error {
+	if msg.ProposalID == 0 {
+		return sdkerrors.Wrap(ErrProposalNotFound, "missing proposal id")
+	}
+	if msg.Voter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing voter address")
+	}
+	if _, ok := VoteChoice_name[int32(msg.Choice)]; !ok || msg.Choice == ChoiceUnspecified {
+		return sdkerrors.Wrapf(ErrInvalidVote, "invalid vote choice %s", msg.Choice)
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when
+// submitting a MsgVote message.
+func (msg MsgVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the single expected signer for a MsgVote.
+func (msg MsgVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+// NewMsgExec creates a new MsgExec.
+func NewMsgExec(proposalID uint64, signer sdk.AccAddress) *MsgExec {
+	return &MsgExec{
+		ProposalID: proposalID,
+		Signer:     signer,
+	}
+}
+
+// Route returns the MsgExec's route.
+func (msg MsgExec) Route() string { return RouterKey }
+
+// Type returns the MsgExec's type.
+func (msg MsgExec) Type() string { return TypeMsgExec }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgExec.
+func (msg MsgExec) ValidateBasic() error {
+	if msg.ProposalID == 0 {
+		return sdkerrors.Wrap(ErrProposalNotFound, "missing proposal id")
+	}
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing signer address")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when
+// submitting a MsgExec message.
+func (msg MsgExec) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the single expected signer for a MsgExec.
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
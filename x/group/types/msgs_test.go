@@ -0,0 +1,106 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	admin   = sdk.AccAddress([]byte("admin_______________"))
+	member1 = sdk.AccAddress([]byte("member1_____________"))
+	member2 = sdk.AccAddress([]byte("member2_____________"))
+	policy  = GroupPolicyAddress(1)
+)
+
+func TestMsgCreateGroup(t *testing.T) {
+	var emptyAddr sdk.AccAddress
+	one := sdk.OneDec()
+
+	cases := []struct {
+		admin   sdk.AccAddress
+		members []Member
+		valid   bool
+	}{
+		{admin, []Member{NewMember(member1, one, ""), NewMember(member2, one, "")}, true},
+		{emptyAddr, []Member{NewMember(member1, one, "")}, false},
+		{admin, nil, false},
+		{admin, []Member{NewMember(member1, one, ""), NewMember(member1, one, "")}, false},
+		{admin, []Member{NewMember(member1, sdk.ZeroDec(), "")}, false},
+		{admin, []Member{NewMember(member1, sdk.NewDec(-1), "")}, false},
+		{admin, []Member{NewMember(emptyAddr, one, "")}, false},
+	}
+
+	for i, tc := range cases {
+		msg := NewMsgCreateGroup(tc.admin, tc.members, "")
+		require.Equal(t, RouterKey, msg.Route())
+		require.Equal(t, TypeMsgCreateGroup, msg.Type())
+
+		if tc.valid {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+			require.Equal(t, []sdk.AccAddress{tc.admin}, msg.GetSigners())
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgUpdateGroupMembers(t *testing.T) {
+	// a zero weight removes a member
+	msg := NewMsgUpdateGroupMembers(admin, 1, []Member{NewMember(member1, sdk.ZeroDec(), "")})
+	require.NoError(t, msg.ValidateBasic())
+
+	require.Error(t, NewMsgUpdateGroupMembers(admin, 0, []Member{NewMember(member1, sdk.OneDec(), "")}).ValidateBasic())
+	require.Error(t, NewMsgUpdateGroupMembers(admin, 1, nil).ValidateBasic())
+}
+
+func TestMsgCreateGroupPolicy(t *testing.T) {
+	msg, err := NewMsgCreateGroupPolicy(admin, 1, "", NewThresholdDecisionPolicy(sdk.OneDec(), time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+
+	msg, err = NewMsgCreateGroupPolicy(admin, 1, "", NewThresholdDecisionPolicy(sdk.OneDec(), 0))
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgSubmitProposal(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	send := banktypes.NewMsgSend(policy, member1, coins)
+
+	msg, err := NewMsgSubmitProposal(policy, []sdk.AccAddress{member1, member2}, []sdk.Msg{send}, "")
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{member1, member2}, msg.GetSigners())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+
+	msgs, err := msg.GetMsgs()
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{send}, msgs)
+
+	// messages must be signed by the group policy account
+	msg, err = NewMsgSubmitProposal(policy, []sdk.AccAddress{member1}, []sdk.Msg{banktypes.NewMsgSend(member1, member2, coins)}, "")
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+
+	msg, err = NewMsgSubmitProposal(policy, []sdk.AccAddress{member1, member1}, []sdk.Msg{send}, "")
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+
+	msg, err = NewMsgSubmitProposal(policy, nil, []sdk.Msg{send}, "")
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgVote(t *testing.T) {
+	require.NoError(t, NewMsgVote(1, member1, ChoiceYes, "").ValidateBasic())
+	require.Error(t, NewMsgVote(0, member1, ChoiceYes, "").ValidateBasic())
+	require.Error(t, NewMsgVote(1, nil, ChoiceYes, "").ValidateBasic())
+	require.Error(t, NewMsgVote(1, member1, ChoiceUnspecified, "").ValidateBasic())
+	require.Error(t, NewMsgVote(1, member1, VoteChoice(10), "").ValidateBasic())
+}
@@ -0,0 +1,119 @@
+package types
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DecisionPolicyResult is the result of applying a decision policy to the
+// votes cast on a proposal.
+type DecisionPolicyResult struct {
+	// Allow is true if the proposal is accepted.
+	Allow bool
+	// Final is true if no further votes can change the result.
+	Final bool
+}
+
+// DecisionPolicy defines the rules by which the votes cast on a proposal
+// decide its outcome.
+type DecisionPolicy interface {
+	proto.Message
+
+	// GetTimeout returns the duration after submission during which members
+	// can vote on a proposal.
+	GetTimeout() time.Duration
+
+	// Allow decides the outcome of a proposal given the current tally, the
+	// total weight of the group and the time elapsed since the proposal was
+	// submitted.
+	Allow(tally Tally, totalWeight sdk.Dec, votingDuration time.Duration) (DecisionPolicyResult, error)
+
+	ValidateBasic() error
+}
+
+var _ DecisionPolicy = &ThresholdDecisionPolicy{}
+
+// NewThresholdDecisionPolicy creates a new ThresholdDecisionPolicy.
+func NewThresholdDecisionPolicy(threshold sdk.Dec, timeout time.Duration) *ThresholdDecisionPolicy {
+	return &ThresholdDecisionPolicy{
+		Threshold: threshold,
+		Timeout:   timeout,
+	}
+}
+
+// Allow implements DecisionPolicy. A proposal is accepted as soon as the yes
+// votes reach the threshold, capped at the group's total weight, and rejected
+// as soon as that is no longer possible or the timeout has passed.
+func (p ThresholdDecisionPolicy) Allow(tally Tally, totalWeight sdk.Dec, votingDuration time.Duration) (DecisionPolicyResult, error) {
+	threshold := sdk.MinDec(p.Threshold, totalWeight)
+	if tally.YesCount.GTE(threshold) {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+
+	undecided := totalWeight.Sub(tally.TotalCounts())
+	if tally.YesCount.Add(undecided).LT(threshold) || votingDuration >= p.Timeout {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+// ValidateBasic implements DecisionPolicy.
+func (p ThresholdDecisionPolicy) ValidateBasic() error {
+	if p.Threshold.IsNil() || !p.Threshold.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidDecisionPolicy, "threshold must be positive")
+	}
+	if p.Timeout <= 0 {
+		return sdkerrors.Wrap(ErrInvalidDecisionPolicy, "timeout must be positive")
+	}
+
+	return nil
+}
+
+// NewTally returns a Tally with no votes.
+func NewTally() Tally {
+	return Tally{
+		YesCount:     sdk.ZeroDec(),
+		NoCount:      sdk.ZeroDec(),
+		AbstainCount: sdk.ZeroDec(),
+		VetoCount:    sdk.ZeroDec(),
+	}
+}
+
+// Add adds the weight of a vote to the tally of its choice.
+func (t *Tally) Add(choice VoteChoice, weight sdk.Dec) error {
+	switch choice {
+	case ChoiceYes:
+		t.YesCount = t.YesCount.Add(weight)
+	case ChoiceNo:
+		t.NoCount = t.NoCount.Add(weight)
+	case ChoiceAbstain:
+		t.AbstainCount = t.AbstainCount.Add(weight)
+	case ChoiceVeto:
+		t.VetoCount = t.VetoCount.Add(weight)
+	default:
+		return sdkerrors.Wrapf(ErrInvalidVote, "unknown choice %s", choice)
+	}
+
+	return nil
+}
+
+// TotalCounts returns the sum of the weights of all votes in the tally.
+func (t Tally) TotalCounts() sdk.Dec {
+	return t.YesCount.Add(t.NoCount).Add(t.AbstainCount).Add(t.VetoCount)
+}
+
+// VoteChoiceFromString returns a VoteChoice from a string. It returns an error
+// if the string is not a valid choice.
+func VoteChoiceFromString(str string) (VoteChoice, error) {
+	choice, ok := VoteChoice_value["VOTE_CHOICE_"+str]
+	if !ok || VoteChoice(choice) == ChoiceUnspecified {
+		return ChoiceUnspecified, sdkerrors.Wrapf(ErrInvalidVote, "invalid vote choice %q", str)
+	}
+
+	return VoteChoice(choice), nil
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestThresholdDecisionPolicyAllow(t *testing.T) {
+	policy := NewThresholdDecisionPolicy(sdk.NewDec(3), time.Hour)
+	totalWeight := sdk.NewDec(6)
+
+	tally := func(yes, no int64) Tally {
+		t := NewTally()
+		t.YesCount = sdk.NewDec(yes)
+		t.NoCount = sdk.NewDec(no)
+		return t
+	}
+
+	cases := []struct {
+		name           string
+		tally          Tally
+		totalWeight    sdk.Dec
+		votingDuration time.Duration
+		expected       DecisionPolicyResult
+	}{
+		{"no votes", tally(0, 0), totalWeight, time.Minute, DecisionPolicyResult{Allow: false, Final: false}},
+		{"threshold reached", tally(3, 0), totalWeight, time.Minute, DecisionPolicyResult{Allow: true, Final: true}},
+		{"threshold can still be reached", tally(1, 3), totalWeight, time.Minute, DecisionPolicyResult{Allow: false, Final: false}},
+		{"threshold cannot be reached", tally(1, 4), totalWeight, time.Minute, DecisionPolicyResult{Allow: false, Final: true}},
+		{"timeout", tally(2, 0), totalWeight, time.Hour, DecisionPolicyResult{Allow: false, Final: true}},
+		{"threshold reached at timeout", tally(3, 0), totalWeight, time.Hour, DecisionPolicyResult{Allow: true, Final: true}},
+		{"threshold above total weight", tally(2, 0), sdk.NewDec(2), time.Minute, DecisionPolicyResult{Allow: true, Final: true}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := policy.Allow(tc.tally, tc.totalWeight, tc.votingDuration)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestThresholdDecisionPolicyValidateBasic(t *testing.T) {
+	require.NoError(t, NewThresholdDecisionPolicy(sdk.NewDec(1), time.Hour).ValidateBasic())
+	require.Error(t, NewThresholdDecisionPolicy(sdk.ZeroDec(), time.Hour).ValidateBasic())
+	require.Error(t, NewThresholdDecisionPolicy(sdk.NewDec(-1), time.Hour).ValidateBasic())
+	require.Error(t, NewThresholdDecisionPolicy(sdk.NewDec(1), 0).ValidateBasic())
+}
+
+func TestVoteChoiceFromString(t *testing.T) {
+	choice, err := VoteChoiceFromString("YES")
+	require.NoError(t, err)
+	require.Equal(t, ChoiceYes, choice)
+
+	_, err = VoteChoiceFromString("UNSPECIFIED")
+	require.Error(t, err)
+	_, err = VoteChoiceFromString("MAYBE")
+	require.Error(t, err)
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Querier routes for the group module
+const (
+	QueryGroup         = "group"
+	QueryGroupMembers  = "group_members"
+	QueryGroupPolicy   = "group_policy"
+	QueryGroupPolicies = "group_policies"
+	QueryProposal      = "proposal"
+	QueryProposals     = "proposals"
+	QueryVotes         = "votes"
+)
+
+// QueryGroupParams defines the parameters necessary for querying a group, its
+// members or its policies.
+type QueryGroupParams struct {
+	GroupID uint64 `json:"group_id" yaml:"group_id"`
+}
+
+func NewQueryGroupParams(groupID uint64) QueryGroupParams {
+	return QueryGroupParams{GroupID: groupID}
+}
+
+// QueryGroupPolicyParams defines the parameters necessary for querying a group
+// policy or the proposals submitted to it.
+type QueryGroupPolicyParams struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+func NewQueryGroupPolicyParams(address sdk.AccAddress) QueryGroupPolicyParams {
+	return QueryGroupPolicyParams{Address: address}
+}
+
+// QueryProposalParams defines the parameters necessary for querying a proposal
+// or the votes cast on it.
+type QueryProposalParams struct {
+	ProposalID uint64 `json:"proposal_id" yaml:"proposal_id"`
+}
+
+func NewQueryProposalParams(proposalID uint64) QueryProposalParams {
+	return QueryProposalParams{ProposalID: proposalID}
+}
+
+// GroupPolicies defines a list of group policies, as returned by the
+// group_policies query.
+type GroupPolicies []GroupPolicyInfo
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (ps GroupPolicies) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, p := range ps {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Proposals defines a list of proposals, as returned by the proposals query.
+type Proposals []Proposal
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (ps Proposals) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, p := range ps {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
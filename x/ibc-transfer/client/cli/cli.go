@@ -20,6 +20,7 @@ func GetQueryCmd(cdc *codec.Codec, queryRoute string) *cobra.Command {
 
 	ics20TransferQueryCmd.AddCommand(flags.GetCommands(
 		GetCmdQueryNextSequence(cdc, queryRoute),
+		GetCmdQueryDenomTrace(cdc, queryRoute),
 	)...)
 
 	return ics20TransferQueryCmd
@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/ibc-transfer/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc-transfer/types"
 )
 
 // GetCmdQueryNextSequence defines the command to query a next receive sequence
@@ -46,3 +47,46 @@ $ %s query ibc-transfer next-recv [port-id] [channel-id]
 
 	return cmd
 }
+
+// GetCmdQueryDenomTrace defines the command to query the trace of a voucher
+// denomination
+func GetCmdQueryDenomTrace(cdc *codec.Codec, queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-trace [denom]",
+		Short: "Query the source port/channel path and base denomination of a voucher",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the trace of a fungible token voucher, i.e the sequence of
+{port}/{channel} hops it was received through and its base denomination on the source chain.
+The denomination must have a supply on the chain and be received through one of its channels.
+
+Example:
+$ %s query ibc-transfer denom-trace transfer/channeltoa/uatom
+		`, version.ClientName),
+		),
+		Example: fmt.Sprintf("%s query ibc-transfer denom-trace [denom]", version.ClientName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryDenomTraceParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDenomTrace)
+			res, height, err := clientCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var denomTrace types.DenomTrace
+			if err := cdc.UnmarshalJSON(res, &denomTrace); err != nil {
+				return err
+			}
+
+			clientCtx = clientCtx.WithHeight(height)
+			return clientCtx.PrintOutput(denomTrace)
+		},
+	}
+
+	return cmd
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -114,3 +115,27 @@ func (k Keeper) SetPort(ctx sdk.Context, portID string) {
 func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
 	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
 }
+
+// GetDenomTrace returns the trace of a denomination held on this chain. The
+// denomination must have a supply and, for vouchers, the channel of the most
+// recent hop must be a channel of this chain, as that is where the voucher was
+// received and minted.
+func (k Keeper) GetDenomTrace(ctx sdk.Context, denom string) (types.DenomTrace, error) {
+	denomTrace := types.ParseDenomTrace(denom)
+	if err := denomTrace.Validate(); err != nil {
+		return types.DenomTrace{}, err
+	}
+
+	if k.bankKeeper.GetSupply(ctx).GetTotal().AmountOf(denom).IsZero() {
+		return types.DenomTrace{}, sdkerrors.Wrapf(types.ErrTraceNotFound, "no supply of %s", denom)
+	}
+
+	if !denomTrace.IsNativeDenom() {
+		hop := strings.SplitN(denomTrace.Path, "/", 3)
+		if _, found := k.channelKeeper.GetChannel(ctx, hop[0], hop[1]); !found {
+			return types.DenomTrace{}, sdkerrors.Wrapf(types.ErrTraceNotFound, "channel %s/%s does not exist", hop[0], hop[1])
+		}
+	}
+
+	return denomTrace, nil
+}
@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-transfer/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc-transfer/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryDenomTrace() {
+	app := suite.chainA.App
+	ctx := suite.chainA.GetContext()
+	querier := keeper.NewQuerier(app.TransferKeeper)
+
+	voucher := "bank/firstchannel/testportid/secondchannel/atom"
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(
+		sdk.NewInt64Coin(voucher, 100), sdk.NewInt64Coin("unknownport/unknownchannel/atom", 100),
+	)))
+	suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channeltypes.OPEN, channeltypes.ORDERED, testConnection)
+
+	query := func(denom string) (types.DenomTrace, error) {
+		req := abci.RequestQuery{Data: suite.cdc.MustMarshalJSON(types.NewQueryDenomTraceParams(denom))}
+		bz, err := querier(ctx, []string{types.QueryDenomTrace}, req)
+		if err != nil {
+			return types.DenomTrace{}, err
+		}
+
+		var denomTrace types.DenomTrace
+		suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &denomTrace))
+		return denomTrace, nil
+	}
+
+	denomTrace, err := query(voucher)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DenomTrace{Path: "bank/firstchannel/testportid/secondchannel", BaseDenom: "atom"}, denomTrace)
+
+	// the voucher was not received through a channel of this chain
+	_, err = query("unknownport/unknownchannel/atom")
+	suite.Require().True(types.ErrTraceNotFound.Is(err))
+
+	// the denomination has no supply
+	_, err = query("bank/firstchannel/uatom")
+	suite.Require().True(types.ErrTraceNotFound.Is(err))
+
+	_, err = query("bank/firstchannel/")
+	suite.Require().True(types.ErrInvalidDenomTrace.Is(err))
+}
@@ -117,7 +117,7 @@ func (AppModule) QuerierRoute() string {
 
 // NewQuerierHandler implements the AppModule interface
 func (am AppModule) NewQuerierHandler() sdk.Querier {
-	return nil
+	return keeper.NewQuerier(am.keeper)
 }
 
 func (am AppModule) RegisterQueryService(grpc.Server) {}
@@ -9,4 +9,6 @@ var (
 	ErrInvalidPacketTimeout    = sdkerrors.Register(ModuleName, 2, "invalid packet timeout")
 	ErrOnlyOneDenomAllowed     = sdkerrors.Register(ModuleName, 3, "only one denom allowed")
 	ErrInvalidDenomForTransfer = sdkerrors.Register(ModuleName, 4, "invalid denomination for cross-chain transfer")
+	ErrInvalidDenomTrace       = sdkerrors.Register(ModuleName, 5, "invalid denomination trace")
+	ErrTraceNotFound           = sdkerrors.Register(ModuleName, 6, "denomination trace not found")
 )
@@ -0,0 +1,17 @@
+package types
+
+// query endpoints supported by the IBC transfer Querier
+const (
+	QueryDenomTrace = "denom_trace"
+)
+
+// QueryDenomTraceParams defines the parameters necessary for querying the
+// trace of a denomination.
+type QueryDenomTraceParams struct {
+	Denom string `json:"denom" yaml:"denom"`
+}
+
+// NewQueryDenomTraceParams creates a new instance of QueryDenomTraceParams.
+func NewQueryDenomTraceParams(denom string) QueryDenomTraceParams {
+	return QueryDenomTraceParams{Denom: denom}
+}
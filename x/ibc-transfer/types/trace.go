@@ -0,0 +1,87 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// DenomTrace contains the base denomination of a fungible token and the
+// {port}/{channel} hops it has travelled through, as recorded in the prefix
+// of the voucher denomination minted on the receiving chain.
+type DenomTrace struct {
+	// Path is the sequence of {port}/{channel} pairs, starting with the most
+	// recent hop. It is empty for native tokens.
+	Path string `json:"path" yaml:"path"`
+	// BaseDenom is the denomination on the chain the token originates from.
+	BaseDenom string `json:"base_denom" yaml:"base_denom"`
+}
+
+// ParseDenomTrace parses a (possibly prefixed) denomination into its trace.
+// The last element that does not complete a {port}/{channel} pair is taken
+// as the base denomination.
+func ParseDenomTrace(denom string) DenomTrace {
+	elements := strings.Split(denom, "/")
+
+	// every hop contributes exactly two elements: the port and the channel
+	hops := (len(elements) - 1) / 2
+
+	return DenomTrace{
+		Path:      strings.Join(elements[:2*hops], "/"),
+		BaseDenom: strings.Join(elements[2*hops:], "/"),
+	}
+}
+
+// IsNativeDenom returns true if the denomination has not been transferred
+// over any channel.
+func (dt DenomTrace) IsNativeDenom() bool {
+	return dt.Path == ""
+}
+
+// GetFullDenomPath returns the denomination with all the hop prefixes, as it
+// is held by accounts on this chain.
+func (dt DenomTrace) GetFullDenomPath() string {
+	if dt.IsNativeDenom() {
+		return dt.BaseDenom
+	}
+
+	return dt.Path + "/" + dt.BaseDenom
+}
+
+// Validate performs a basic validation of the trace: the base denomination must
+// be non-empty and every hop in the path a valid port and channel identifier.
+func (dt DenomTrace) Validate() error {
+	if strings.TrimSpace(dt.BaseDenom) == "" {
+		return sdkerrors.Wrap(ErrInvalidDenomTrace, "base denomination cannot be blank")
+	}
+
+	if dt.IsNativeDenom() {
+		return nil
+	}
+
+	elements := strings.Split(dt.Path, "/")
+	if len(elements)%2 != 0 {
+		return sdkerrors.Wrapf(ErrInvalidDenomTrace, "path %s is not a sequence of {port}/{channel} pairs", dt.Path)
+	}
+
+	for i := 0; i < len(elements); i += 2 {
+		if err := host.PortIdentifierValidator(elements[i]); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidDenomTrace, "invalid port ID at position %d: %s", i, err)
+		}
+
+		if err := host.ChannelIdentifierValidator(elements[i+1]); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidDenomTrace, "invalid channel ID at position %d: %s", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (dt DenomTrace) String() string {
+	return fmt.Sprintf(`DenomTrace:
+  Path:      %s
+  BaseDenom: %s`, dt.Path, dt.BaseDenom)
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDenomTrace(t *testing.T) {
+	testCases := []struct {
+		name     string
+		denom    string
+		expTrace DenomTrace
+	}{
+		{"native denom", "uatom", DenomTrace{BaseDenom: "uatom"}},
+		{"single hop", "transfer/channeltoa/uatom", DenomTrace{Path: "transfer/channeltoa", BaseDenom: "uatom"}},
+		{"multiple hops", "transfer/channeltoa/transfer/channeltob/uatom", DenomTrace{Path: "transfer/channeltoa/transfer/channeltob", BaseDenom: "uatom"}},
+		{"incomplete hop", "transfer/uatom", DenomTrace{BaseDenom: "transfer/uatom"}},
+	}
+
+	for _, tc := range testCases {
+		trace := ParseDenomTrace(tc.denom)
+		require.Equal(t, tc.expTrace, trace, tc.name)
+		require.Equal(t, tc.denom, trace.GetFullDenomPath(), tc.name)
+	}
+}
+
+func TestDenomTraceValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		trace   DenomTrace
+		expPass bool
+	}{
+		{"native denom", DenomTrace{BaseDenom: "uatom"}, true},
+		{"valid single hop", DenomTrace{Path: "transfer/channeltoa", BaseDenom: "uatom"}, true},
+		{"empty base denom", DenomTrace{Path: "transfer/channeltoa"}, false},
+		{"odd path", DenomTrace{Path: "transfer", BaseDenom: "uatom"}, false},
+		{"invalid channel", DenomTrace{Path: "transfer/c", BaseDenom: "uatom"}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.trace.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// flagOwner is the flag restricting the nfts query to those held by an owner.
+const flagOwner = "owner"
+
+// GetQueryCmd returns the parent querying command for the nft module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the nft module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(flags.GetCommands(
+		GetCmdQueryClass(cdc),
+		GetCmdQueryClasses(cdc),
+		GetCmdQueryNFT(cdc),
+		GetCmdQueryNFTs(cdc),
+		GetCmdQueryOwner(cdc),
+		GetCmdQueryBalance(cdc),
+		GetCmdQuerySupply(cdc),
+	)...)
+
+	return cmd
+}
+
+// GetCmdQueryClass returns a CLI command handler for querying an nft class.
+func GetCmdQueryClass(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "class [class_id]",
+		Short: "Query an nft class by its ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc)
+
+			res, err := types.NewQueryClient(clientCtx).Class(context.Background(), &types.QueryClassRequest{ClassID: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res.Class)
+		},
+	}
+}
+
+// GetCmdQueryClasses returns a CLI command handler for querying all nft
+// classes.
+func GetCmdQueryClasses(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "classes",
+		Short: "Query all nft classes",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc)
+
+			res, err := types.NewQueryClient(clientCtx).Classes(context.Background(), &types.QueryClassesRequest{
+				Pagination: readPageRequest(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	addPaginationFlags(cmd, "classes")
+	return cmd
+}
+
+// GetCmdQueryNFT returns a CLI command handler for querying an nft.
+func GetCmdQueryNFT(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "nft [class_id] [nft_id]",
+		Short: "Query an nft by its class and ID",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc)
+
+			res, err := types.NewQueryClient(clientCtx).NFT(context.Background(), &types.QueryNFTRequest{
+				ClassID: args[0],
+				ID:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res.NFT)
+		},
+	}
+}
+
+// GetCmdQueryNFTs returns a CLI command handler for querying the nfts of a
+// class, optionally restricted to those held by an owner.
+func GetCmdQueryNFTs(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nfts [class_id]",
+		Short: "Query the nfts of a class",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc)
+
+			var owner sdk.AccAddress
+			if s := viper.GetString(flagOwner); s != "" {
+				var err error
+				if owner, err = sdk.AccAddressFromBech32(s); err != nil {
+					return err
+				}
+			}
+
+			res, err := types.NewQueryClient(clientCtx).NFTs(context.Background(), &types.QueryNFTsRequest{
+				ClassID:    args[0],
+				Owner:      owner,
+				Pagination: readPageRequest(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	cmd.Flags().String(flagOwner, "", "Only return the nfts held by this address")
+	addPaginationFlags(cmd, "nfts")
+	return cmd
+}
+
+// GetCmdQueryOwner returns a CLI command handler for querying the owner of an
+// nft.
+func GetCmdQueryOwner(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "owner [class_id] [nft_id]",
+		Short: "Query the owner of an nft",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc)
+
+			res, err := types.NewQueryClient(clientCtx).Owner(context.Background(), &types.QueryOwnerRequest{
+				ClassID: args[0],
+				ID:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+}
+
+// GetCmdQueryBalance returns a CLI command handler for querying the number of
+// nfts of a class held by an owner.
+func GetCmdQueryBalance(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "balance [owner] [class_id]",
+		Short: "Query the number of nfts of a class held by an owner",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).Balance(context.Background(), &types.QueryBalanceRequest{
+				ClassID: args[1],
+				Owner:   owner,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+}
+
+// GetCmdQuerySupply returns a CLI command handler for querying the number of
+// nfts of a class.
+func GetCmdQuerySupply(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "supply [class_id]",
+		Short: "Query the number of nfts of a class",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.NewContext().WithCodec(cdc)
+
+			res, err := types.NewQueryClient(clientCtx).Supply(context.Background(), &types.QuerySupplyRequest{ClassID: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+}
+
+func addPaginationFlags(cmd *cobra.Command, query string) {
+	cmd.Flags().Uint64(flags.FlagPage, 1, fmt.Sprintf("pagination page of %s to query for", query))
+	cmd.Flags().Uint64(flags.FlagLimit, 100, fmt.Sprintf("pagination limit of %s to query for", query))
+}
+
+// readPageRequest builds a page request from the pagination flags.
+func readPageRequest() *query.PageRequest {
+	page := viper.GetUint64(flags.FlagPage)
+	limit := viper.GetUint64(flags.FlagLimit)
+	if page == 0 {
+		page = 1
+	}
+
+	return &query.PageRequest{Offset: (page - 1) * limit, Limit: limit}
+}
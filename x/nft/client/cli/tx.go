@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/nft transaction commands.
+func NewTxCmd(clientCtx client.Context) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "NFT transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(NewSendTxCmd(clientCtx))
+
+	return txCmd
+}
+
+// NewSendTxCmd returns a CLI command handler for creating a MsgSend transaction.
+func NewSendTxCmd(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send [class_id] [nft_id] [receiver]",
+		Short: "Transfer an nft to another account",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx = clientCtx.InitWithInput(cmd.InOrStdin())
+
+			receiver, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSend(args[0], args[1], clientCtx.GetFromAddress(), receiver)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
@@ -0,0 +1,17 @@
+/*
+Package nft implements non-fungible tokens, providing chains with a common base
+layer for issuing and transferring unique assets.
+
+Every nft belongs to a class, e.g. a collection, identified by a unique ID and
+carrying a name, symbol, description and metadata URI. An nft is identified by
+its class and an ID unique within the class, and carries its own metadata URI.
+The module tracks the owner of each nft, indexes the nfts of each class by
+owner and maintains the total supply of each class.
+
+Classes are defined and nfts minted, burned and updated by other modules through
+the keeper, which leaves the issuance rules to the application. Owners transfer
+their nfts with MsgSend. The Query service exposes classes, nfts, owners,
+balances and supplies, and lists the nfts of a class, optionally restricted to
+an owner, with pagination.
+*/
+package nft
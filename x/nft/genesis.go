@@ -0,0 +1,55 @@
+package nft
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/keeper"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// InitGenesis initializes the nft module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
+	}
+
+	for _, class := range gs.Classes {
+		if err := k.SaveClass(ctx, class); err != nil {
+			panic(err)
+		}
+	}
+	for _, entry := range gs.Entries {
+		for _, nft := range entry.NFTs {
+			if err := k.Mint(ctx, nft, entry.Owner); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
+
+// ExportGenesis returns the nft module's exported genesis. The nfts of each
+// class are grouped by owner.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	gs := types.DefaultGenesisState()
+
+	entries := make(map[string]int)
+	for _, class := range k.GetClasses(ctx) {
+		gs.Classes = append(gs.Classes, class)
+
+		for _, nft := range k.GetNFTsOfClass(ctx, class.ID) {
+			owner := k.GetOwner(ctx, nft.ClassID, nft.ID)
+
+			i, ok := entries[owner.String()]
+			if !ok {
+				i = len(gs.Entries)
+				entries[owner.String()] = i
+				gs.Entries = append(gs.Entries, types.Entry{Owner: owner})
+			}
+			gs.Entries[i].NFTs = append(gs.Entries[i].NFTs, nft)
+		}
+	}
+
+	return gs
+}
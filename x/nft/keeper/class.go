@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// SaveClass defines a new nft class. It returns an error if the class ID is
+// invalid or already taken.
+func (k Keeper) SaveClass(ctx sdk.Context, class types.Class) error {
+	if err := types.ValidateClassID(class.ID); err != nil {
+		return err
+	}
+	if k.HasClass(ctx, class.ID) {
+		return sdkerrors.Wrap(types.ErrClassExists, class.ID)
+	}
+
+	k.setClass(ctx, class)
+	return nil
+}
+
+// UpdateClass updates the metadata of an existing nft class.
+func (k Keeper) UpdateClass(ctx sdk.Context, class types.Class) error {
+	if !k.HasClass(ctx, class.ID) {
+		return sdkerrors.Wrap(types.ErrClassNotExists, class.ID)
+	}
+
+	k.setClass(ctx, class)
+	return nil
+}
+
+// GetClass returns the nft class with the provided ID and whether it exists.
+func (k Keeper) GetClass(ctx sdk.Context, classID string) (types.Class, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.ClassStoreKey(classID))
+	if bz == nil {
+		return types.Class{}, false
+	}
+
+	var class types.Class
+	k.cdc.MustUnmarshalBinaryBare(bz, &class)
+	return class, true
+}
+
+// GetClasses returns all nft classes ordered by ID.
+func (k Keeper) GetClasses(ctx sdk.Context) []types.Class {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ClassKeyPrefix)
+	defer iterator.Close()
+
+	var classes []types.Class
+	for ; iterator.Valid(); iterator.Next() {
+		var class types.Class
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &class)
+		classes = append(classes, class)
+	}
+
+	return classes
+}
+
+// HasClass returns whether the nft class with the provided ID exists.
+func (k Keeper) HasClass(ctx sdk.Context, classID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ClassStoreKey(classID))
+}
+
+func (k Keeper) setClass(ctx sdk.Context, class types.Class) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ClassStoreKey(class.ID), k.cdc.MustMarshalBinaryBare(&class))
+}
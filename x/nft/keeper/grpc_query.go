@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Balance implements the Query/Balance gRPC method
+func (k Keeper) Balance(c context.Context, req *types.QueryBalanceRequest) (*types.QueryBalanceResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateClassID(req.ClassID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if len(req.Owner) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryBalanceResponse{Amount: k.GetBalance(ctx, req.ClassID, req.Owner)}, nil
+}
+
+// Owner implements the Query/Owner gRPC method
+func (k Keeper) Owner(c context.Context, req *types.QueryOwnerRequest) (*types.QueryOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validateIDs(req.ClassID, req.ID); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	owner := k.GetOwner(ctx, req.ClassID, req.ID)
+	if owner.Empty() {
+		return nil, status.Errorf(codes.NotFound, "nft %s/%s not found", req.ClassID, req.ID)
+	}
+
+	return &types.QueryOwnerResponse{Owner: owner}, nil
+}
+
+// Supply implements the Query/Supply gRPC method
+func (k Keeper) Supply(c context.Context, req *types.QuerySupplyRequest) (*types.QuerySupplyResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateClassID(req.ClassID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QuerySupplyResponse{Amount: k.GetTotalSupply(ctx, req.ClassID)}, nil
+}
+
+// NFTs implements the Query/NFTs gRPC method. If an owner is provided, only the
+// nfts of the class held by the owner are returned.
+func (k Keeper) NFTs(c context.Context, req *types.QueryNFTsRequest) (*types.QueryNFTsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateClassID(req.ClassID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+
+	var (
+		nfts    []types.NFT
+		pageRes *query.PageResponse
+		err     error
+	)
+
+	if len(req.Owner) == 0 {
+		nftStore := prefix.NewStore(store, types.NFTOfClassStoreKey(req.ClassID))
+		pageRes, err = query.Paginate(nftStore, req.Pagination, func(_ []byte, value []byte) error {
+			var nft types.NFT
+			if err := k.cdc.UnmarshalBinaryBare(value, &nft); err != nil {
+				return err
+			}
+
+			nfts = append(nfts, nft)
+			return nil
+		})
+	} else {
+		ownerStore := prefix.NewStore(store, types.NFTOfClassByOwnerStoreKey(req.Owner, req.ClassID))
+		pageRes, err = query.Paginate(ownerStore, req.Pagination, func(key []byte, _ []byte) error {
+			nft, _ := k.GetNFT(ctx, req.ClassID, string(key))
+			nfts = append(nfts, nft)
+			return nil
+		})
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryNFTsResponse{NFTs: nfts, Pagination: pageRes}, nil
+}
+
+// NFT implements the Query/NFT gRPC method
+func (k Keeper) NFT(c context.Context, req *types.QueryNFTRequest) (*types.QueryNFTResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validateIDs(req.ClassID, req.ID); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	nft, found := k.GetNFT(ctx, req.ClassID, req.ID)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "nft %s/%s not found", req.ClassID, req.ID)
+	}
+
+	return &types.QueryNFTResponse{NFT: &nft}, nil
+}
+
+// Class implements the Query/Class gRPC method
+func (k Keeper) Class(c context.Context, req *types.QueryClassRequest) (*types.QueryClassResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateClassID(req.ClassID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	class, found := k.GetClass(ctx, req.ClassID)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "class %s not found", req.ClassID)
+	}
+
+	return &types.QueryClassResponse{Class: &class}, nil
+}
+
+// Classes implements the Query/Classes gRPC method
+func (k Keeper) Classes(c context.Context, req *types.QueryClassesRequest) (*types.QueryClassesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassKeyPrefix)
+
+	classes := []types.Class{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var class types.Class
+		if err := k.cdc.UnmarshalBinaryBare(value, &class); err != nil {
+			return err
+		}
+
+		classes = append(classes, class)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryClassesResponse{Classes: classes, Pagination: pageRes}, nil
+}
+
+// validateIDs returns an InvalidArgument status error if the class or nft ID
+// is invalid.
+func validateIDs(classID, nftID string) error {
+	if err := types.ValidateClassID(classID); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := types.ValidateNFTID(nftID); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+func (suite *KeeperTestSuite) queryClient() types.QueryClient {
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx)
+	types.RegisterQueryServer(queryHelper, suite.app.NFTKeeper)
+	return types.NewQueryClient(queryHelper)
+}
+
+func (suite *KeeperTestSuite) TestQueryNFT() {
+	k, ctx := suite.app.NFTKeeper, suite.ctx
+	owner := suite.addrs[0]
+	expNFT := types.NFT{ClassID: testClassID, ID: testNFTID, URI: "ipfs://kitty1"}
+	suite.Require().NoError(k.Mint(ctx, expNFT, owner))
+
+	queryClient := suite.queryClient()
+
+	_, err := queryClient.NFT(gocontext.Background(), &types.QueryNFTRequest{ClassID: testClassID})
+	suite.Require().Error(err)
+
+	_, err = queryClient.NFT(gocontext.Background(), &types.QueryNFTRequest{ClassID: testClassID, ID: "kitty2"})
+	suite.Require().Error(err)
+
+	nftRes, err := queryClient.NFT(gocontext.Background(), &types.QueryNFTRequest{ClassID: testClassID, ID: testNFTID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expNFT, *nftRes.NFT)
+
+	ownerRes, err := queryClient.Owner(gocontext.Background(), &types.QueryOwnerRequest{ClassID: testClassID, ID: testNFTID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(owner, ownerRes.Owner)
+
+	_, err = queryClient.Balance(gocontext.Background(), &types.QueryBalanceRequest{ClassID: testClassID})
+	suite.Require().Error(err)
+
+	balanceRes, err := queryClient.Balance(gocontext.Background(), &types.QueryBalanceRequest{ClassID: testClassID, Owner: owner})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), balanceRes.Amount)
+
+	supplyRes, err := queryClient.Supply(gocontext.Background(), &types.QuerySupplyRequest{ClassID: testClassID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), supplyRes.Amount)
+}
+
+func (suite *KeeperTestSuite) TestQueryNFTs() {
+	k, ctx := suite.app.NFTKeeper, suite.ctx
+
+	// kitty0, kitty2 and kitty4 are held by the first address
+	for i, id := range []string{"kitty0", "kitty1", "kitty2", "kitty3", "kitty4"} {
+		suite.Require().NoError(k.Mint(ctx, types.NFT{ClassID: testClassID, ID: id}, suite.addrs[i%2]))
+	}
+
+	queryClient := suite.queryClient()
+
+	_, err := queryClient.NFTs(gocontext.Background(), &types.QueryNFTsRequest{})
+	suite.Require().Error(err)
+
+	res, err := queryClient.NFTs(gocontext.Background(), &types.QueryNFTsRequest{
+		ClassID:    testClassID,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.NFT{{ClassID: testClassID, ID: "kitty0"}, {ClassID: testClassID, ID: "kitty1"}}, res.NFTs)
+	suite.Require().Equal(uint64(5), res.Pagination.Total)
+
+	res, err = queryClient.NFTs(gocontext.Background(), &types.QueryNFTsRequest{
+		ClassID:    testClassID,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.NFT{{ClassID: testClassID, ID: "kitty2"}, {ClassID: testClassID, ID: "kitty3"}}, res.NFTs)
+
+	res, err = queryClient.NFTs(gocontext.Background(), &types.QueryNFTsRequest{
+		ClassID:    testClassID,
+		Owner:      suite.addrs[0],
+		Pagination: &query.PageRequest{Offset: 1, Limit: 5},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.NFT{{ClassID: testClassID, ID: "kitty2"}, {ClassID: testClassID, ID: "kitty4"}}, res.NFTs)
+}
+
+func (suite *KeeperTestSuite) TestQueryClasses() {
+	k, ctx := suite.app.NFTKeeper, suite.ctx
+	suite.Require().NoError(k.SaveClass(ctx, types.Class{ID: "puppy", Name: "Puppy"}))
+
+	queryClient := suite.queryClient()
+
+	_, err := queryClient.Class(gocontext.Background(), &types.QueryClassRequest{ClassID: "bunny"})
+	suite.Require().Error(err)
+
+	classRes, err := queryClient.Class(gocontext.Background(), &types.QueryClassRequest{ClassID: "puppy"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.Class{ID: "puppy", Name: "Puppy"}, *classRes.Class)
+
+	classesRes, err := queryClient.Classes(gocontext.Background(), &types.QueryClassesRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(classesRes.Classes, 1)
+	suite.Require().Equal(testClassID, classesRes.Classes[0].ID)
+	suite.Require().Equal(uint64(2), classesRes.Pagination.Total)
+}
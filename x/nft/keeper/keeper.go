@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// Keeper defines the nft module's keeper. It persists nft classes and the
+// nfts of each class along with their owners, and exposes the methods other
+// modules use to issue, burn and transfer nfts.
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.Marshaler
+}
+
+// NewKeeper constructs an nft Keeper.
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.Marshaler) Keeper {
+	return Keeper{
+		storeKey: storeKey,
+		cdc:      cdc,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
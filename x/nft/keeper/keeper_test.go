@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/cosmos/cosmos-sdk/x/nft/keeper"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+const (
+	testClassID = "kitty"
+	testNFTID   = "kitty1"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app   *simapp.SimApp
+	ctx   sdk.Context
+	addrs []sdk.AccAddress
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+
+	suite.Require().NoError(app.NFTKeeper.SaveClass(ctx, types.Class{ID: testClassID, Name: "Kitty", Symbol: "KIT"}))
+}
+
+func (suite *KeeperTestSuite) TestSaveClass() {
+	k, ctx := suite.app.NFTKeeper, suite.ctx
+
+	err := k.SaveClass(ctx, types.Class{ID: testClassID})
+	suite.Require().True(types.ErrClassExists.Is(err))
+
+	err = k.SaveClass(ctx, types.Class{ID: "1invalid"})
+	suite.Require().True(types.ErrInvalidClassID.Is(err))
+
+	err = k.UpdateClass(ctx, types.Class{ID: "puppy"})
+	suite.Require().True(types.ErrClassNotExists.Is(err))
+
+	updated := types.Class{ID: testClassID, Name: "Kitty", Symbol: "KIT", URI: "ipfs://kitty"}
+	suite.Require().NoError(k.UpdateClass(ctx, updated))
+
+	class, found := k.GetClass(ctx, testClassID)
+	suite.Require().True(found)
+	suite.Require().Equal(updated, class)
+
+	suite.Require().NoError(k.SaveClass(ctx, types.Class{ID: "puppy"}))
+	suite.Require().True(k.HasClass(ctx, "puppy"))
+	suite.Require().Equal([]types.Class{updated, {ID: "puppy"}}, k.GetClasses(ctx))
+}
+
+func (suite *KeeperTestSuite) TestMintAndBurn() {
+	k, ctx := suite.app.NFTKeeper, suite.ctx
+	owner := suite.addrs[0]
+	expNFT := types.NFT{ClassID: testClassID, ID: testNFTID, URI: "ipfs://kitty1"}
+
+	err := k.Mint(ctx, types.NFT{ClassID: "puppy", ID: testNFTID}, owner)
+	suite.Require().True(types.ErrClassNotExists.Is(err))
+
+	err = k.Mint(ctx, types.NFT{ClassID: testClassID, ID: "x"}, owner)
+	suite.Require().True(types.ErrInvalidID.Is(err))
+
+	suite.Require().NoError(k.Mint(ctx, expNFT, owner))
+
+	err = k.Mint(ctx, expNFT, suite.addrs[1])
+	suite.Require().True(types.ErrNFTExists.Is(err))
+
+	nft, found := k.GetNFT(ctx, testClassID, testNFTID)
+	suite.Require().True(found)
+	suite.Require().Equal(expNFT, nft)
+	suite.Require().Equal(owner, k.GetOwner(ctx, testClassID, testNFTID))
+	suite.Require().Equal(uint64(1), k.GetBalance(ctx, testClassID, owner))
+	suite.Require().Equal(uint64(1), k.GetTotalSupply(ctx, testClassID))
+	suite.Require().Equal([]types.NFT{expNFT}, k.GetNFTsOfClass(ctx, testClassID))
+	suite.Require().Equal([]types.NFT{expNFT}, k.GetNFTsOfClassByOwner(ctx, testClassID, owner))
+
+	expNFT.URI = "ipfs://kitty1/v2"
+	suite.Require().NoError(k.Update(ctx, expNFT))
+	nft, _ = k.GetNFT(ctx, testClassID, testNFTID)
+	suite.Require().Equal(expNFT, nft)
+
+	suite.Require().NoError(k.Burn(ctx, testClassID, testNFTID))
+
+	err = k.Burn(ctx, testClassID, testNFTID)
+	suite.Require().True(types.ErrNFTNotExists.Is(err))
+
+	err = k.Update(ctx, expNFT)
+	suite.Require().True(types.ErrNFTNotExists.Is(err))
+
+	suite.Require().False(k.HasNFT(ctx, testClassID, testNFTID))
+	suite.Require().Nil(k.GetOwner(ctx, testClassID, testNFTID))
+	suite.Require().Equal(uint64(0), k.GetBalance(ctx, testClassID, owner))
+	suite.Require().Equal(uint64(0), k.GetTotalSupply(ctx, testClassID))
+	suite.Require().Empty(k.GetNFTsOfClassByOwner(ctx, testClassID, owner))
+}
+
+func (suite *KeeperTestSuite) TestSend() {
+	k, ctx := suite.app.NFTKeeper, suite.ctx
+	owner, receiver := suite.addrs[0], suite.addrs[1]
+
+	suite.Require().NoError(k.Mint(ctx, types.NFT{ClassID: testClassID, ID: testNFTID}, owner))
+
+	msgServer := keeper.NewMsgServerImpl(k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	_, err := msgServer.Send(goCtx, types.NewMsgSend(testClassID, "kitty2", owner, receiver))
+	suite.Require().True(types.ErrNFTNotExists.Is(err))
+
+	_, err = msgServer.Send(goCtx, types.NewMsgSend(testClassID, testNFTID, receiver, receiver))
+	suite.Require().True(sdkerrors.ErrUnauthorized.Is(err))
+
+	_, err = msgServer.Send(goCtx, types.NewMsgSend(testClassID, testNFTID, owner, receiver))
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(receiver, k.GetOwner(ctx, testClassID, testNFTID))
+	suite.Require().Equal(uint64(0), k.GetBalance(ctx, testClassID, owner))
+	suite.Require().Equal(uint64(1), k.GetBalance(ctx, testClassID, receiver))
+	suite.Require().Equal(uint64(1), k.GetTotalSupply(ctx, testClassID))
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	k, ctx := suite.app.NFTKeeper, suite.ctx
+
+	suite.Require().NoError(k.SaveClass(ctx, types.Class{ID: "puppy"}))
+	suite.Require().NoError(k.Mint(ctx, types.NFT{ClassID: testClassID, ID: "kitty1"}, suite.addrs[0]))
+	suite.Require().NoError(k.Mint(ctx, types.NFT{ClassID: testClassID, ID: "kitty2"}, suite.addrs[1]))
+	suite.Require().NoError(k.Mint(ctx, types.NFT{ClassID: "puppy", ID: "puppy1"}, suite.addrs[0]))
+
+	gs := nft.ExportGenesis(ctx, k)
+	suite.Require().NoError(gs.Validate())
+	suite.Require().Len(gs.Classes, 2)
+	suite.Require().Len(gs.Entries, 2)
+
+	app := simapp.Setup(false)
+	ctx2 := app.BaseApp.NewContext(false, abci.Header{})
+	nft.InitGenesis(ctx2, app.NFTKeeper, gs)
+
+	suite.Require().Equal(gs, nft.ExportGenesis(ctx2, app.NFTKeeper))
+	suite.Require().Equal(uint64(2), app.NFTKeeper.GetTotalSupply(ctx2, testClassID))
+	suite.Require().Equal(uint64(1), app.NFTKeeper.GetBalance(ctx2, "puppy", suite.addrs[0]))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the nft MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// Send implements the Msg/Send method
+func (k msgServer) Send(goCtx context.Context, msg *types.MsgSend) (*types.MsgSendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner := k.GetOwner(ctx, msg.ClassID, msg.ID)
+	if owner.Empty() {
+		return nil, sdkerrors.Wrapf(types.ErrNFTNotExists, "%s/%s", msg.ClassID, msg.ID)
+	}
+	if !owner.Equals(msg.Sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s/%s", msg.Sender, msg.ClassID, msg.ID)
+	}
+
+	if err := k.Transfer(ctx, msg.ClassID, msg.ID, msg.Receiver); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSend,
+			sdk.NewAttribute(types.AttributeKeyClassID, msg.ClassID),
+			sdk.NewAttribute(types.AttributeKeyID, msg.ID),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgSendResponse{}, nil
+}
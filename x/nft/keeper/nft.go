@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// Mint issues a new nft of an existing class to the receiver.
+func (k Keeper) Mint(ctx sdk.Context, nft types.NFT, receiver sdk.AccAddress) error {
+	if receiver.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing receiver address")
+	}
+	if !k.HasClass(ctx, nft.ClassID) {
+		return sdkerrors.Wrap(types.ErrClassNotExists, nft.ClassID)
+	}
+	if err := types.ValidateNFTID(nft.ID); err != nil {
+		return err
+	}
+	if k.HasNFT(ctx, nft.ClassID, nft.ID) {
+		return sdkerrors.Wrapf(types.ErrNFTExists, "%s/%s", nft.ClassID, nft.ID)
+	}
+
+	k.setNFT(ctx, nft)
+	k.setOwner(ctx, nft.ClassID, nft.ID, receiver)
+	k.setTotalSupply(ctx, nft.ClassID, k.GetTotalSupply(ctx, nft.ClassID)+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMint,
+			sdk.NewAttribute(types.AttributeKeyClassID, nft.ClassID),
+			sdk.NewAttribute(types.AttributeKeyID, nft.ID),
+			sdk.NewAttribute(types.AttributeKeyOwner, receiver.String()),
+		),
+	)
+
+	return nil
+}
+
+// Burn destroys an existing nft.
+func (k Keeper) Burn(ctx sdk.Context, classID, nftID string) error {
+	owner := k.GetOwner(ctx, classID, nftID)
+	if owner.Empty() {
+		return sdkerrors.Wrapf(types.ErrNFTNotExists, "%s/%s", classID, nftID)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.NFTStoreKey(classID, nftID))
+	k.deleteOwner(ctx, classID, nftID, owner)
+	k.setTotalSupply(ctx, classID, k.GetTotalSupply(ctx, classID)-1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurn,
+			sdk.NewAttribute(types.AttributeKeyClassID, classID),
+			sdk.NewAttribute(types.AttributeKeyID, nftID),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+		),
+	)
+
+	return nil
+}
+
+// Update updates the metadata of an existing nft.
+func (k Keeper) Update(ctx sdk.Context, nft types.NFT) error {
+	if !k.HasNFT(ctx, nft.ClassID, nft.ID) {
+		return sdkerrors.Wrapf(types.ErrNFTNotExists, "%s/%s", nft.ClassID, nft.ID)
+	}
+
+	k.setNFT(ctx, nft)
+	return nil
+}
+
+// Transfer moves an existing nft to the receiver. Callers are responsible for
+// checking that the transfer is authorized.
+func (k Keeper) Transfer(ctx sdk.Context, classID, nftID string, receiver sdk.AccAddress) error {
+	owner := k.GetOwner(ctx, classID, nftID)
+	if owner.Empty() {
+		return sdkerrors.Wrapf(types.ErrNFTNotExists, "%s/%s", classID, nftID)
+	}
+
+	k.deleteOwner(ctx, classID, nftID, owner)
+	k.setOwner(ctx, classID, nftID, receiver)
+	return nil
+}
+
+// GetNFT returns the nft with the provided class and ID and whether it exists.
+func (k Keeper) GetNFT(ctx sdk.Context, classID, nftID string) (types.NFT, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.NFTStoreKey(classID, nftID))
+	if bz == nil {
+		return types.NFT{}, false
+	}
+
+	var nft types.NFT
+	k.cdc.MustUnmarshalBinaryBare(bz, &nft)
+	return nft, true
+}
+
+// HasNFT returns whether the nft with the provided class and ID exists.
+func (k Keeper) HasNFT(ctx sdk.Context, classID, nftID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.NFTStoreKey(classID, nftID))
+}
+
+// GetNFTsOfClass returns all nfts of a class ordered by ID.
+func (k Keeper) GetNFTsOfClass(ctx sdk.Context, classID string) []types.NFT {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.NFTOfClassStoreKey(classID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var nfts []types.NFT
+	for ; iterator.Valid(); iterator.Next() {
+		var nft types.NFT
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &nft)
+		nfts = append(nfts, nft)
+	}
+
+	return nfts
+}
+
+// GetNFTsOfClassByOwner returns the nfts of a class held by an owner ordered
+// by ID.
+func (k Keeper) GetNFTsOfClassByOwner(ctx sdk.Context, classID string, owner sdk.AccAddress) []types.NFT {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.NFTOfClassByOwnerStoreKey(owner, classID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var nfts []types.NFT
+	for ; iterator.Valid(); iterator.Next() {
+		nft, _ := k.GetNFT(ctx, classID, string(iterator.Key()))
+		nfts = append(nfts, nft)
+	}
+
+	return nfts
+}
+
+// GetOwner returns the owner of an nft, or nil if it does not exist.
+func (k Keeper) GetOwner(ctx sdk.Context, classID, nftID string) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get(types.OwnerStoreKey(classID, nftID))
+}
+
+// GetBalance returns the number of nfts of a class held by an owner.
+func (k Keeper) GetBalance(ctx sdk.Context, classID string, owner sdk.AccAddress) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.NFTOfClassByOwnerStoreKey(owner, classID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var balance uint64
+	for ; iterator.Valid(); iterator.Next() {
+		balance++
+	}
+
+	return balance
+}
+
+// GetTotalSupply returns the number of nfts of a class.
+func (k Keeper) GetTotalSupply(ctx sdk.Context, classID string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.ClassTotalSupplyStoreKey(classID))
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setNFT(ctx sdk.Context, nft types.NFT) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NFTStoreKey(nft.ClassID, nft.ID), k.cdc.MustMarshalBinaryBare(&nft))
+}
+
+// setOwner records the owner of an nft and indexes the nft by owner.
+func (k Keeper) setOwner(ctx sdk.Context, classID, nftID string, owner sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.OwnerStoreKey(classID, nftID), owner.Bytes())
+
+	ownerStore := prefix.NewStore(store, types.NFTOfClassByOwnerStoreKey(owner, classID))
+	ownerStore.Set([]byte(nftID), types.Placeholder)
+}
+
+// deleteOwner removes the owner of an nft and its owner index entry.
+func (k Keeper) deleteOwner(ctx sdk.Context, classID, nftID string, owner sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.OwnerStoreKey(classID, nftID))
+
+	ownerStore := prefix.NewStore(store, types.NFTOfClassByOwnerStoreKey(owner, classID))
+	ownerStore.Delete([]byte(nftID))
+}
+
+func (k Keeper) setTotalSupply(ctx sdk.Context, classID string, supply uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, supply)
+	store.Set(types.ClassTotalSupplyStoreKey(classID), bz)
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterCodec registers all the necessary types and interfaces for the
+// nft module.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(&MsgSend{}, "cosmos-sdk/nft/MsgSend", nil)
+}
+
+// RegisterInterfaces registers the nft module's interface types and
+// implementations with the provided registry.
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSend{},
+	)
+}
+
+var (
+	amino = codec.New()
+
+	// ModuleCdc references the global x/nft module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding as Amino is
+	// still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/nft and
+	// defined at the application level.
+	ModuleCdc = codec.NewHybridCodec(amino, types.NewInterfaceRegistry())
+)
+
+func init() {
+	RegisterCodec(amino)
+	amino.Seal()
+}
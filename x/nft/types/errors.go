@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/nft module sentinel errors
+var (
+	ErrInvalidClassID = sdkerrors.Register(ModuleName, 2, "invalid class id")
+	ErrClassExists    = sdkerrors.Register(ModuleName, 3, "nft class already exists")
+	ErrClassNotExists = sdkerrors.Register(ModuleName, 4, "nft class does not exist")
+	ErrInvalidID      = sdkerrors.Register(ModuleName, 5, "invalid nft id")
+	ErrNFTExists      = sdkerrors.Register(ModuleName, 6, "nft already exists")
+	ErrNFTNotExists   = sdkerrors.Register(ModuleName, 7, "nft does not exist")
+)
@@ -0,0 +1,15 @@
+package types
+
+// nft module events
+const (
+	EventTypeSend = "send"
+	EventTypeMint = "mint"
+	EventTypeBurn = "burn"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyClassID    = "class_id"
+	AttributeKeyID         = "id"
+	AttributeKeyOwner      = "owner"
+	AttributeKeySender     = "sender"
+	AttributeKeyReceiver   = "receiver"
+)
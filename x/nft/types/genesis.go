@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Entry groups the nfts held by an owner in the nft module's genesis state.
+type Entry struct {
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+	NFTs  []NFT          `json:"nfts" yaml:"nfts"`
+}
+
+// GenesisState defines the nft module's genesis state.
+type GenesisState struct {
+	Classes []Class `json:"classes" yaml:"classes"`
+	Entries []Entry `json:"entries" yaml:"entries"`
+}
+
+// NewGenesisState creates a new GenesisState object.
+func NewGenesisState(classes []Class, entries []Entry) GenesisState {
+	return GenesisState{
+		Classes: classes,
+		Entries: entries,
+	}
+}
+
+// DefaultGenesisState returns the nft module's default genesis state.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Classes: []Class{},
+		Entries: []Entry{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	classes := make(map[string]bool, len(gs.Classes))
+	for _, class := range gs.Classes {
+		if err := ValidateClassID(class.ID); err != nil {
+			return err
+		}
+		if classes[class.ID] {
+			return fmt.Errorf("duplicate class %s", class.ID)
+		}
+		classes[class.ID] = true
+	}
+
+	nfts := make(map[string]bool)
+	for _, entry := range gs.Entries {
+		if entry.Owner.Empty() {
+			return fmt.Errorf("nft owner cannot be empty")
+		}
+
+		for _, nft := range entry.NFTs {
+			if err := ValidateNFTID(nft.ID); err != nil {
+				return err
+			}
+			if !classes[nft.ClassID] {
+				return fmt.Errorf("nft %s: %w: %s", nft.ID, ErrClassNotExists, nft.ClassID)
+			}
+
+			key := string(NFTStoreKey(nft.ClassID, nft.ID))
+			if nfts[key] {
+				return fmt.Errorf("duplicate nft %s of class %s", nft.ID, nft.ClassID)
+			}
+			nfts[key] = true
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "nft"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+)
+
+// KVStore keys
+//
+// - 0x01<class_id>: Class
+// - 0x02<class_id>0x00<nft_id>: NFT
+// - 0x03<owner_address_bytes><class_id>0x00<nft_id>: []byte{0x01}
+// - 0x04<class_id>0x00<nft_id>: owner address
+// - 0x05<class_id>: big endian total supply of the class
+//
+// Class and nft IDs never contain a 0x00 byte, which is used as a delimiter so
+// that the keys of a class do not share a prefix with the keys of another class
+// whose ID starts with the same characters.
+var (
+	ClassKeyPrefix             = []byte{0x01}
+	NFTKeyPrefix               = []byte{0x02}
+	NFTOfClassByOwnerKeyPrefix = []byte{0x03}
+	OwnerKeyPrefix             = []byte{0x04}
+	ClassTotalSupplyKeyPrefix  = []byte{0x05}
+
+	// Delimiter separates a class ID from the nft ID that follows it.
+	Delimiter = []byte{0x00}
+
+	// Placeholder is the value stored under owner index keys.
+	Placeholder = []byte{0x01}
+)
+
+// ClassStoreKey returns the store key of a class.
+func ClassStoreKey(classID string) []byte {
+	return concat(ClassKeyPrefix, []byte(classID))
+}
+
+// NFTOfClassStoreKey returns the store prefix of the nfts of a class.
+func NFTOfClassStoreKey(classID string) []byte {
+	return concat(NFTKeyPrefix, []byte(classID), Delimiter)
+}
+
+// NFTStoreKey returns the store key of an nft.
+func NFTStoreKey(classID, nftID string) []byte {
+	return concat(NFTOfClassStoreKey(classID), []byte(nftID))
+}
+
+// NFTOfClassByOwnerStoreKey returns the store prefix of the nfts of a class
+// held by an owner.
+func NFTOfClassByOwnerStoreKey(owner sdk.AccAddress, classID string) []byte {
+	return concat(NFTOfClassByOwnerKeyPrefix, owner.Bytes(), []byte(classID), Delimiter)
+}
+
+// OwnerStoreKey returns the store key of the owner of an nft.
+func OwnerStoreKey(classID, nftID string) []byte {
+	return concat(OwnerKeyPrefix, []byte(classID), Delimiter, []byte(nftID))
+}
+
+// ClassTotalSupplyStoreKey returns the store key of the total supply of a class.
+func ClassTotalSupplyStoreKey(classID string) []byte {
+	return concat(ClassTotalSupplyKeyPrefix, []byte(classID))
+}
+
+// concat returns a new slice holding the concatenation of the provided slices.
+func concat(parts ...[]byte) []byte {
+	return bytes.Join(parts, nil)
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// nft message types
+const (
+	TypeMsgSend = "send"
+)
+
+var _ sdk.Msg = &MsgSend{}
+
+// NewMsgSend creates a new MsgSend.
+func NewMsgSend(classID, id string, sender, receiver sdk.AccAddress) *MsgSend {
+	return &MsgSend{
+		ClassID:  classID,
+		ID:       id,
+		Sender:   sender,
+		Receiver: receiver,
+	}
+}
+
+// Route returns the MsgSend's route.
+func (msg MsgSend) Route() string { return RouterKey }
+
+// Type returns the MsgSend's type.
+func (msg MsgSend) Type() string { return TypeMsgSend }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgSend.
+func (msg MsgSend) ValidateBasic() error {
+	if err := ValidateClassID(msg.ClassID); err != nil {
+		return err
+	}
+	if err := ValidateNFTID(msg.ID); err != nil {
+		return err
+	}
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+	if msg.Receiver.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing receiver address")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when
+// submitting a MsgSend message.
+func (msg MsgSend) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the addresses that must sign a MsgSend.
+func (msg MsgSend) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+func TestMsgSendValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress("sender______________")
+	receiver := sdk.AccAddress("receiver____________")
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgSend
+		expErr bool
+	}{
+		{"valid", types.NewMsgSend("kitty", "kitty1", sender, receiver), false},
+		{"class id with delimiters", types.NewMsgSend("ipfs:/kitty-1", "kitty1", sender, receiver), false},
+		{"invalid class id", types.NewMsgSend("1kitty", "kitty1", sender, receiver), true},
+		{"class id too short", types.NewMsgSend("ki", "kitty1", sender, receiver), true},
+		{"invalid nft id", types.NewMsgSend("kitty", "kitty\x001", sender, receiver), true},
+		{"missing sender", types.NewMsgSend("kitty", "kitty1", nil, receiver), true},
+		{"missing receiver", types.NewMsgSend("kitty", "kitty1", sender, nil), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{sender}, tc.msg.GetSigners())
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// reIDString is the pattern of class and nft IDs. IDs start with a letter and
+// never contain a 0x00 byte, which is used as a delimiter in store keys.
+const reIDString = `[a-zA-Z][a-zA-Z0-9/:-]{2,100}`
+
+var reID = regexp.MustCompile(fmt.Sprintf(`^%s$`, reIDString))
+
+// ValidateClassID returns an error if the provided class ID is invalid.
+func ValidateClassID(id string) error {
+	if !reID.MatchString(id) {
+		return sdkerrors.Wrapf(ErrInvalidClassID, "invalid class id: %s", id)
+	}
+	return nil
+}
+
+// ValidateNFTID returns an error if the provided nft ID is invalid.
+func ValidateNFTID(id string) error {
+	if !reID.MatchString(id) {
+		return sdkerrors.Wrapf(ErrInvalidID, "invalid nft id: %s", id)
+	}
+	return nil
+}
@@ -203,7 +203,20 @@ func ValidateGenesis(data types.GenesisState) error {
 		return err
 	}
 
-	return data.Params.Validate()
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, val := range data.Validators {
+		if val.Commission.Rate.LT(data.Params.MinCommissionRate) {
+			return fmt.Errorf(
+				"validator commission rate %s is less than the minimum commission rate %s, validator: %s",
+				val.Commission.Rate, data.Params.MinCommissionRate, val.OperatorAddress,
+			)
+		}
+	}
+
+	return nil
 }
 
 func validateGenesisStateValidators(validators []types.Validator) (err error) {
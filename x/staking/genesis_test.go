@@ -150,6 +150,16 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = sdk.Bonded
 		}, true},
+		{"commission below min rate", func(data *types.GenesisState) {
+			val := types.NewValidator(sdk.ValAddress(pk.Address()), pk, types.NewDescription("", "", "", "", ""))
+			val.DelegatorShares = sdk.OneDec()
+			data.Validators = []types.Validator{val}
+			data.Params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+		}, true},
+		// validate params
+		{"invalid min commission rate", func(data *types.GenesisState) {
+			data.Params.MinCommissionRate = sdk.NewDecWithPrec(15, 1)
+		}, true},
 	}
 
 	for _, tt := range tests {
@@ -77,6 +77,10 @@ func handleMsgCreateValidator(ctx sdk.Context, msg *types.MsgCreateValidator, k
 		}
 	}
 
+	if msg.Commission.Rate.LT(k.MinCommissionRate(ctx)) {
+		return nil, sdkerrors.Wrapf(types.ErrCommissionLTMinRate, "cannot set validator commission to less than minimum rate of %s", k.MinCommissionRate(ctx))
+	}
+
 	validator := types.NewValidator(msg.ValidatorAddress, pk, msg.Description)
 	commission := types.NewCommissionWithTime(
 		msg.Commission.Rate, msg.Commission.MaxRate,
@@ -118,6 +122,15 @@ func handleMsgCreateValidator(ctx sdk.Context, msg *types.MsgCreateValidator, k
 		),
 	})
 
+	err = ctx.EventManager().EmitTypedEvent(&types.EventCreateValidator{
+		ValidatorAddress: msg.ValidatorAddress.String(),
+		DelegatorAddress: msg.DelegatorAddress.String(),
+		Amount:           msg.Value,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
@@ -175,6 +188,15 @@ func handleMsgEditValidator(ctx sdk.Context, msg *types.MsgEditValidator, k keep
 		),
 	})
 
+	err = ctx.EventManager().EmitTypedEvent(&types.EventEditValidator{
+		ValidatorAddress:  msg.ValidatorAddress.String(),
+		CommissionRate:    validator.Commission.Rate.String(),
+		MinSelfDelegation: validator.MinSelfDelegation.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
@@ -207,6 +229,15 @@ func handleMsgDelegate(ctx sdk.Context, msg *types.MsgDelegate, k keeper.Keeper)
 		),
 	})
 
+	err = ctx.EventManager().EmitTypedEvent(&types.EventDelegate{
+		DelegatorAddress: msg.DelegatorAddress.String(),
+		ValidatorAddress: msg.ValidatorAddress.String(),
+		Amount:           msg.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
@@ -247,6 +278,16 @@ func handleMsgUndelegate(ctx sdk.Context, msg *types.MsgUndelegate, k keeper.Kee
 		),
 	})
 
+	err = ctx.EventManager().EmitTypedEvent(&types.EventUnbond{
+		DelegatorAddress: msg.DelegatorAddress.String(),
+		ValidatorAddress: msg.ValidatorAddress.String(),
+		Amount:           msg.Amount,
+		CompletionTime:   completionTime.Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &sdk.Result{Data: completionTimeBz, Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
@@ -290,5 +331,16 @@ func handleMsgBeginRedelegate(ctx sdk.Context, msg *types.MsgBeginRedelegate, k
 		),
 	})
 
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRedelegate{
+		DelegatorAddress:    msg.DelegatorAddress.String(),
+		ValidatorSrcAddress: msg.ValidatorSrcAddress.String(),
+		ValidatorDstAddress: msg.ValidatorDstAddress.String(),
+		Amount:              msg.Amount,
+		CompletionTime:      completionTime.Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &sdk.Result{Data: completionTimeBz, Events: ctx.EventManager().ABCIEvents()}, nil
 }
@@ -316,6 +316,37 @@ func TestLegacyValidatorDelegations(t *testing.T) {
 	require.Equal(t, bondAmount.MulRaw(3), validator.DelegatorShares.RoundInt())
 }
 
+func TestDelegateTypedEvents(t *testing.T) {
+	initPower := int64(1000)
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 2, 1000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	bondAmount := sdk.TokensFromConsensusPower(10)
+	validatorAddr, delegatorAddr := valAddrs[0], delAddrs[1]
+
+	res, err := handler(ctx, NewTestMsgCreateValidator(validatorAddr, PKs[0], bondAmount))
+	require.NoError(t, err)
+
+	event, err := sdk.ParseTypedEvent(res.Events[len(res.Events)-1])
+	require.NoError(t, err)
+	require.Equal(t, &types.EventCreateValidator{
+		ValidatorAddress: validatorAddr.String(),
+		DelegatorAddress: sdk.AccAddress(validatorAddr).String(),
+		Amount:           sdk.NewCoin(sdk.DefaultBondDenom, bondAmount),
+	}, event)
+
+	res, err = handler(ctx.WithEventManager(sdk.NewEventManager()), NewTestMsgDelegate(delegatorAddr, validatorAddr, bondAmount))
+	require.NoError(t, err)
+
+	event, err = sdk.ParseTypedEvent(res.Events[len(res.Events)-1])
+	require.NoError(t, err)
+	require.Equal(t, &types.EventDelegate{
+		DelegatorAddress: delegatorAddr.String(),
+		ValidatorAddress: validatorAddr.String(),
+		Amount:           sdk.NewCoin(sdk.DefaultBondDenom, bondAmount),
+	}, event)
+}
+
 func TestIncrementsMsgDelegate(t *testing.T) {
 	initPower := int64(1000)
 	initBond := sdk.TokensFromConsensusPower(initPower)
@@ -422,6 +453,41 @@ func TestEditValidatorDecreaseMinSelfDelegation(t *testing.T) {
 	require.Nil(t, res)
 }
 
+func TestMinCommissionRate(t *testing.T) {
+	initBond := sdk.TokensFromConsensusPower(100)
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, 100, 2, 1000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// creating a validator with a commission below the minimum rate fails
+	msgCreateValidator := NewTestMsgCreateValidator(valAddrs[0], PKs[0], initBond)
+	_, err := handler(ctx, msgCreateValidator)
+	require.True(t, types.ErrCommissionLTMinRate.Is(err), err)
+
+	msgCreateValidator.Commission = types.NewCommissionRates(
+		sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(5, 2),
+	)
+	_, err = handler(ctx, msgCreateValidator)
+	require.NoError(t, err)
+
+	// editing the commission to below the minimum rate fails
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(25 * time.Hour))
+	newRate := sdk.NewDecWithPrec(1, 2)
+	_, err = handler(ctx, types.NewMsgEditValidator(valAddrs[0], types.Description{}, &newRate, nil))
+	require.True(t, types.ErrCommissionLTMinRate.Is(err), err)
+
+	newRate = sdk.NewDecWithPrec(8, 2)
+	_, err = handler(ctx, types.NewMsgEditValidator(valAddrs[0], types.Description{}, &newRate, nil))
+	require.NoError(t, err)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, newRate, validator.Commission.Rate)
+}
+
 func TestEditValidatorIncreaseMinSelfDelegationBeyondCurrentBond(t *testing.T) {
 	initPower := int64(100)
 	initBond := sdk.TokensFromConsensusPower(100)
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Migrator performs the in-place store migrations of the staking module.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the staking state from consensus version 1 to 2. It
+// sets the MinCommissionRate param, introduced in version 2, to its default
+// value, as the param getters panic on missing params.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if !m.keeper.paramstore.Has(ctx, types.KeyMinCommissionRate) {
+		m.keeper.paramstore.Set(ctx, types.KeyMinCommissionRate, types.DefaultMinCommissionRate)
+	}
+
+	return nil
+}
+
+// Migrate2to3 migrates the staking state from consensus version 2 to 3. Before
+// version 3 the bank keeper did not persist the delegation tracking of vesting
+// accounts, so their DelegatedFree and DelegatedVesting coins did not match
+// their delegations. The tracking of every vesting account is rebuilt from the
+// tokens of its delegations and unbonding delegations, split between free and
+// vesting coins as of the migration block time.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	var vaccs []vestexported.VestingAccount
+	m.keeper.authKeeper.IterateAccounts(ctx, func(acc authtypes.AccountI) (stop bool) {
+		if vacc, ok := acc.(vestexported.VestingAccount); ok {
+			vaccs = append(vaccs, vacc)
+		}
+
+		return false
+	})
+
+	bondDenom := m.keeper.BondDenom(ctx)
+	for _, vacc := range vaccs {
+		addr := vacc.GetAddress()
+
+		// reset the tracking
+		tracked := vacc.GetDelegatedFree().Add(vacc.GetDelegatedVesting()...)
+		if !tracked.IsZero() {
+			vacc.TrackUndelegation(tracked)
+		}
+
+		delegated := sdk.ZeroInt()
+		for _, delegation := range m.keeper.GetAllDelegatorDelegations(ctx, addr) {
+			validator, found := m.keeper.GetValidator(ctx, delegation.ValidatorAddress)
+			if !found {
+				return types.ErrNoValidatorFound
+			}
+
+			delegated = delegated.Add(validator.TokensFromShares(delegation.Shares).TruncateInt())
+		}
+
+		// unbonding tokens are only untracked when the unbonding completes
+		for _, ubd := range m.keeper.GetAllUnbondingDelegations(ctx, addr) {
+			for _, entry := range ubd.Entries {
+				delegated = delegated.Add(entry.Balance)
+			}
+		}
+
+		if delegated.IsPositive() {
+			amt := sdk.NewCoins(sdk.NewCoin(bondDenom, delegated))
+			balance := amt.Add(m.keeper.bankKeeper.GetBalance(ctx, addr, bondDenom))
+			vacc.TrackDelegation(ctx.BlockTime(), balance, amt)
+		}
+
+		m.keeper.authKeeper.SetAccount(ctx, vacc)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	// drop the param, as in the state of a chain at consensus version 1
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	store.Delete(types.KeyMinCommissionRate)
+	require.Panics(t, func() { app.StakingKeeper.GetParams(ctx) })
+
+	require.NoError(t, keeper.NewMigrator(app.StakingKeeper).Migrate1to2(ctx))
+	require.True(t, types.DefaultMinCommissionRate.Equal(app.StakingKeeper.MinCommissionRate(ctx)))
+
+	// a param that is already set is left untouched
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	require.NoError(t, keeper.NewMigrator(app.StakingKeeper).Migrate1to2(ctx))
+	require.True(t, params.MinCommissionRate.Equal(app.StakingKeeper.MinCommissionRate(ctx)))
+}
+
+func TestMigrate2to3(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: now})
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.ZeroInt())
+	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
+
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator, shares := validator.AddTokensFromDel(sdk.NewInt(50))
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	// a vesting account with a delegation and an unbonding delegation whose
+	// tracking wasn't persisted, and stale tracking from its genesis
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))
+	bacc := authtypes.NewBaseAccountWithAddress(addrDels[1])
+	vacc := vesting.NewContinuousVestingAccount(bacc, origCoins, now.Unix(), now.Add(24*time.Hour).Unix())
+	vacc.DelegatedFree = sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 5))
+	app.AccountKeeper.SetAccount(ctx, vacc)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, addrDels[1], sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 40))))
+
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(addrDels[1], addrVals[0], shares))
+	app.StakingKeeper.SetUnbondingDelegation(ctx, types.NewUnbondingDelegation(addrDels[1], addrVals[0], 0, now, sdk.NewInt(10)))
+
+	// half of the original vesting coins are still vesting at the migration
+	ctx = ctx.WithBlockTime(now.Add(12 * time.Hour))
+	require.NoError(t, keeper.NewMigrator(app.StakingKeeper).Migrate2to3(ctx))
+
+	migrated := app.AccountKeeper.GetAccount(ctx, addrDels[1]).(*vesting.ContinuousVestingAccount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50)), migrated.GetDelegatedVesting())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10)), migrated.GetDelegatedFree())
+
+	// the migration is idempotent
+	require.NoError(t, keeper.NewMigrator(app.StakingKeeper).Migrate2to3(ctx))
+	require.Equal(t, migrated, app.AccountKeeper.GetAccount(ctx, addrDels[1]))
+}
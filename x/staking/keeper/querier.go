@@ -6,10 +6,11 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
-	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -73,20 +74,31 @@ func queryValidators(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	validators := k.GetAllValidators(ctx)
-	filteredVals := make([]types.Validator, 0, len(validators))
+	filteredVals := []types.Validator{}
 
-	for _, val := range validators {
-		if strings.EqualFold(val.GetStatus().String(), params.Status) {
-			filteredVals = append(filteredVals, val)
-		}
-	}
+	pageReq, ok := query.NewPageRequestFromPage(params.Page, params.Limit, int(k.GetParams(ctx).MaxValidators))
+	if ok {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorsKey)
+
+		_, err = query.FilteredPaginate(store, pageReq, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+			val, err := types.UnmarshalValidator(k.cdc, value)
+			if err != nil {
+				return false, err
+			}
+
+			if !strings.EqualFold(val.GetStatus().String(), params.Status) {
+				return false, nil
+			}
+
+			if accumulate {
+				filteredVals = append(filteredVals, val)
+			}
 
-	start, end := client.Paginate(len(filteredVals), params.Page, params.Limit, int(k.GetParams(ctx).MaxValidators))
-	if start < 0 || end < 0 {
-		filteredVals = []types.Validator{}
-	} else {
-		filteredVals = filteredVals[start:end]
+			return true, nil
+		})
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
 	}
 
 	res, err := codec.MarshalJSONIndent(types.ModuleCdc, filteredVals)
@@ -126,13 +138,31 @@ func queryValidatorDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	delegations := k.GetValidatorDelegations(ctx, params.ValidatorAddr)
+	delegations := []types.Delegation{}
+
+	pageReq, ok := query.NewPageRequestFromPage(params.Page, params.Limit, int(k.GetParams(ctx).MaxValidators))
+	if ok {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelegationKey)
+
+		_, err = query.FilteredPaginate(store, pageReq, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+			delegation, err := types.UnmarshalDelegation(k.cdc, value)
+			if err != nil {
+				return false, err
+			}
+
+			if !delegation.GetValidatorAddr().Equals(params.ValidatorAddr) {
+				return false, nil
+			}
 
-	start, end := client.Paginate(len(delegations), params.Page, params.Limit, int(k.GetParams(ctx).MaxValidators))
-	if start < 0 || end < 0 {
-		delegations = []types.Delegation{}
-	} else {
-		delegations = delegations[start:end]
+			if accumulate {
+				delegations = append(delegations, delegation)
+			}
+
+			return true, nil
+		})
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
 	}
 
 	delegationResps, err := delegationsToDelegationResponses(ctx, k, delegations)
@@ -160,16 +190,27 @@ func queryValidatorUnbondingDelegations(ctx sdk.Context, req abci.RequestQuery,
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	unbonds := k.GetUnbondingDelegationsFromValidator(ctx, params.ValidatorAddr)
-	if unbonds == nil {
-		unbonds = types.UnbondingDelegations{}
-	}
+	unbonds := types.UnbondingDelegations{}
+
+	pageReq, ok := query.NewPageRequestFromPage(params.Page, params.Limit, int(k.GetParams(ctx).MaxValidators))
+	if ok {
+		store := ctx.KVStore(k.storeKey)
+		indexPrefix := types.GetUBDsByValIndexKey(params.ValidatorAddr)
 
-	start, end := client.Paginate(len(unbonds), params.Page, params.Limit, int(k.GetParams(ctx).MaxValidators))
-	if start < 0 || end < 0 {
-		unbonds = types.UnbondingDelegations{}
-	} else {
-		unbonds = unbonds[start:end]
+		_, err = query.Paginate(prefix.NewStore(store, indexPrefix), pageReq, func(key []byte, _ []byte) error {
+			indexKey := append(append([]byte{}, indexPrefix...), key...)
+
+			ubd, err := types.UnmarshalUBD(k.cdc, store.Get(types.GetUBDKeyFromValIndexKey(indexKey)))
+			if err != nil {
+				return err
+			}
+
+			unbonds = append(unbonds, ubd)
+			return nil
+		})
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
 	}
 
 	res, err := codec.MarshalJSONIndent(types.ModuleCdc, unbonds)
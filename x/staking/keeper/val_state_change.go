@@ -45,6 +45,15 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 				sdk.NewAttribute(types.AttributeKeyDelegator, dvPair.DelegatorAddress.String()),
 			),
 		)
+
+		err = ctx.EventManager().EmitTypedEvent(&types.EventCompleteUnbonding{
+			DelegatorAddress: dvPair.DelegatorAddress.String(),
+			ValidatorAddress: dvPair.ValidatorAddress.String(),
+			Amount:           balances,
+		})
+		if err != nil {
+			panic(err)
+		}
 	}
 
 	// Remove all mature redelegations from the red queue.
@@ -69,6 +78,16 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 				sdk.NewAttribute(types.AttributeKeyDstValidator, dvvTriplet.ValidatorDstAddress.String()),
 			),
 		)
+
+		err = ctx.EventManager().EmitTypedEvent(&types.EventCompleteRedelegation{
+			DelegatorAddress:    dvvTriplet.DelegatorAddress.String(),
+			ValidatorSrcAddress: dvvTriplet.ValidatorSrcAddress.String(),
+			ValidatorDstAddress: dvvTriplet.ValidatorDstAddress.String(),
+			Amount:              balances,
+		})
+		if err != nil {
+			panic(err)
+		}
 	}
 
 	return validatorUpdates
@@ -32,6 +32,7 @@ var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
+	_ module.VersionedAppModule  = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the staking module.
@@ -151,6 +152,23 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 
 func (am AppModule) RegisterQueryService(grpc.Server) {}
 
+// ConsensusVersion implements module.VersionedAppModule. Version 2 introduced
+// the MinCommissionRate param and version 3 rebuilt the delegation tracking of
+// vesting accounts.
+func (AppModule) ConsensusVersion() uint64 { return 3 }
+
+// RegisterMigrations registers the staking module's in-place store migrations.
+func (am AppModule) RegisterMigrations(cfg module.Configurator) {
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(err)
+	}
+}
+
 // InitGenesis performs genesis initialization for the staking module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, data json.RawMessage) []abci.ValidatorUpdate {
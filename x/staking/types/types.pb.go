@@ -1147,6 +1147,9 @@ type Params struct {
 	MaxEntries        uint32        `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty" yaml:"max_entries"`
 	HistoricalEntries uint32        `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty" yaml:"historical_entries"`
 	BondDenom         string        `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty" yaml:"bond_denom"`
+	// min_commission_rate is the chain-wide minimum commission rate a validator
+	// can set
+	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -1242,113 +1245,114 @@ func init() {
 func init() { proto.RegisterFile("x/staking/types/types.proto", fileDescriptor_c669c0a3ee1b124c) }
 
 var fileDescriptor_c669c0a3ee1b124c = []byte{
-	// 1682 bytes of a gzipped FileDescriptorProto
+	// 1701 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0x23, 0x49,
-	0x15, 0x4f, 0xdb, 0x8e, 0x9d, 0x3c, 0x4f, 0xec, 0xa4, 0xa3, 0xc9, 0x78, 0xb2, 0xac, 0x3b, 0xf4,
+	0x15, 0x4f, 0xdb, 0x8e, 0x9d, 0x3c, 0x4f, 0xe2, 0xa4, 0xa3, 0xc9, 0x78, 0xb2, 0xac, 0x3b, 0xf4,
 	0xa2, 0x55, 0x84, 0x58, 0x5b, 0xd9, 0x45, 0x42, 0xca, 0x5e, 0x76, 0x1c, 0x27, 0x4a, 0x50, 0x82,
 	0x66, 0x3b, 0xb3, 0x39, 0xf0, 0x21, 0xab, 0xdc, 0x5d, 0x69, 0x17, 0x71, 0x77, 0x9b, 0xae, 0x72,
-	0xd6, 0x41, 0x5c, 0x91, 0x10, 0x12, 0x62, 0x2f, 0x48, 0x7b, 0x1c, 0xf1, 0x0f, 0xf0, 0x1f, 0xa0,
-	0xe1, 0x36, 0xdc, 0x46, 0x1c, 0x10, 0x70, 0x30, 0x68, 0xe6, 0x82, 0x38, 0x21, 0x1f, 0x40, 0xe2,
-	0x84, 0xba, 0xaa, 0xfa, 0x23, 0x6d, 0x7b, 0xc6, 0xc9, 0x30, 0xc3, 0x48, 0x93, 0xcb, 0x8c, 0xeb,
-	0xf5, 0x7b, 0xbf, 0x57, 0xf5, 0xbe, 0xab, 0x02, 0xef, 0x0c, 0xea, 0x94, 0xa1, 0x33, 0xe2, 0xda,
-	0x75, 0x76, 0xd1, 0xc3, 0x54, 0xfc, 0x5b, 0xeb, 0xf9, 0x1e, 0xf3, 0xd4, 0x3b, 0xa6, 0x47, 0x1d,
-	0x8f, 0xb6, 0xa8, 0x75, 0x56, 0x1b, 0xd4, 0x24, 0x5f, 0xed, 0x7c, 0x6b, 0xfd, 0x7d, 0xd6, 0x21,
-	0xbe, 0xd5, 0xea, 0x21, 0x9f, 0x5d, 0xd4, 0x39, 0x6f, 0xdd, 0xf6, 0x6c, 0x2f, 0xfe, 0x25, 0x00,
-	0xd6, 0x3f, 0x1a, 0xe7, 0x63, 0xd8, 0xb5, 0xb0, 0xef, 0x10, 0x97, 0xd5, 0x51, 0xdb, 0x24, 0xe3,
-	0x5a, 0xd7, 0x35, 0xdb, 0xf3, 0xec, 0x2e, 0x16, 0xfc, 0xed, 0xfe, 0x69, 0x9d, 0x11, 0x07, 0x53,
-	0x86, 0x9c, 0x9e, 0x64, 0xa8, 0xa6, 0x19, 0xac, 0xbe, 0x8f, 0x18, 0xf1, 0x5c, 0xf9, 0x7d, 0x65,
-	0x0c, 0x53, 0xff, 0x77, 0x0e, 0xd4, 0x23, 0x6a, 0xef, 0xf8, 0x18, 0x31, 0x7c, 0x82, 0xba, 0xc4,
-	0x42, 0xcc, 0xf3, 0xd5, 0x43, 0x28, 0x5a, 0x98, 0x9a, 0x3e, 0xe9, 0x05, 0xe2, 0x15, 0x65, 0x43,
-	0xd9, 0x2c, 0x7e, 0xf8, 0xb5, 0xda, 0x94, 0x63, 0xd7, 0x9a, 0x31, 0x6f, 0x23, 0xf7, 0x78, 0xa8,
-	0xcd, 0x19, 0x49, 0x71, 0xf5, 0x3b, 0x00, 0xa6, 0xe7, 0x38, 0x84, 0xd2, 0x00, 0x2c, 0xc3, 0xc1,
-	0x36, 0xa7, 0x82, 0xed, 0x44, 0xac, 0x06, 0x62, 0x98, 0x4a, 0xc0, 0x04, 0x82, 0xfa, 0x13, 0x58,
-	0x75, 0x88, 0xdb, 0xa2, 0xb8, 0x7b, 0xda, 0xb2, 0x70, 0x17, 0xdb, 0xfc, 0x90, 0x95, 0xec, 0x86,
-	0xb2, 0xb9, 0xd8, 0x38, 0x0c, 0xd8, 0xff, 0x32, 0xd4, 0xde, 0xb7, 0x09, 0xeb, 0xf4, 0xdb, 0x35,
-	0xd3, 0x73, 0xea, 0x42, 0x95, 0xfc, 0xef, 0x03, 0x6a, 0x9d, 0x49, 0x1b, 0x1c, 0xb8, 0x6c, 0x34,
-	0xd4, 0xd6, 0x2f, 0x90, 0xd3, 0xdd, 0xd6, 0x27, 0x40, 0xea, 0xc6, 0x8a, 0x43, 0xdc, 0x63, 0xdc,
-	0x3d, 0x6d, 0x46, 0x34, 0xf5, 0xc7, 0xb0, 0x22, 0x39, 0x3c, 0xbf, 0x85, 0x2c, 0xcb, 0xc7, 0x94,
-	0x56, 0x72, 0x1b, 0xca, 0xe6, 0xad, 0xc6, 0xd1, 0x68, 0xa8, 0x55, 0x04, 0xda, 0x18, 0x8b, 0xfe,
-	0x9f, 0xa1, 0xf6, 0xc1, 0x0c, 0x7b, 0xba, 0x67, 0x9a, 0xf7, 0x84, 0x84, 0xb1, 0x1c, 0x81, 0x48,
-	0x4a, 0xa0, 0xfb, 0x3c, 0x74, 0x52, 0xa4, 0x7b, 0x3e, 0xad, 0x7b, 0x8c, 0x65, 0x56, 0xdd, 0x27,
-	0xa8, 0x1b, 0xe9, 0x8e, 0x40, 0x42, 0xdd, 0x6b, 0x90, 0xef, 0xf5, 0xdb, 0x67, 0xf8, 0xa2, 0x92,
-	0x0f, 0x0c, 0x6d, 0xc8, 0x95, 0x5a, 0x87, 0xf9, 0x73, 0xd4, 0xed, 0xe3, 0x4a, 0x81, 0x3b, 0x76,
-	0x35, 0xe9, 0x58, 0xee, 0x4e, 0x12, 0x06, 0x85, 0xe0, 0xdb, 0xce, 0xfd, 0xfd, 0xa1, 0xa6, 0xe8,
-	0xbf, 0xcb, 0xc2, 0xf2, 0x11, 0xb5, 0x77, 0x2d, 0xc2, 0x5e, 0x55, 0xdc, 0xf5, 0x26, 0x59, 0x2b,
-	0xc3, 0xad, 0xb5, 0x33, 0x1a, 0x6a, 0x25, 0x61, 0xad, 0xff, 0xa5, 0x8d, 0x1c, 0x28, 0xc7, 0x71,
-	0xda, 0xf2, 0x11, 0xc3, 0x32, 0x2a, 0x9b, 0x33, 0x46, 0x64, 0x13, 0x9b, 0xa3, 0xa1, 0xb6, 0x26,
-	0x76, 0x96, 0x82, 0xd2, 0x8d, 0x92, 0x79, 0x29, 0x37, 0xd4, 0xc1, 0xe4, 0x44, 0xc8, 0x71, 0x95,
-	0xfb, 0xaf, 0x30, 0x09, 0xa4, 0x0f, 0x7f, 0x9b, 0x81, 0xe2, 0x11, 0xb5, 0x25, 0x1d, 0x4f, 0x4e,
-	0x0d, 0xe5, 0xff, 0x98, 0x1a, 0x99, 0xd7, 0x93, 0x1a, 0x5b, 0x90, 0x47, 0x8e, 0xd7, 0x77, 0x19,
-	0xf7, 0xf6, 0x73, 0x73, 0x40, 0x32, 0x4a, 0x03, 0xfe, 0x39, 0xcb, 0xcb, 0x6f, 0x03, 0xdb, 0xc4,
-	0x35, 0xb0, 0xf5, 0x26, 0xd8, 0xf1, 0xa7, 0x0a, 0xdc, 0x8e, 0xad, 0x44, 0x7d, 0x33, 0x65, 0xcc,
-	0x4f, 0x47, 0x43, 0xed, 0x2b, 0x69, 0x63, 0x26, 0xd8, 0xae, 0x61, 0xd0, 0xd5, 0x08, 0xe8, 0xd8,
-	0x37, 0x27, 0xef, 0xc3, 0xa2, 0x2c, 0xda, 0x47, 0x76, 0xfa, 0x3e, 0x12, 0x6c, 0x2f, 0xb5, 0x8f,
-	0x26, 0x65, 0xe3, 0xbe, 0xcd, 0x5d, 0xcd, 0xb7, 0x8f, 0x32, 0xb0, 0x74, 0x44, 0xed, 0xcf, 0x5c,
-	0xeb, 0x26, 0x3d, 0xae, 0x99, 0x1e, 0xbf, 0x52, 0xa0, 0xb4, 0x4f, 0x28, 0xf3, 0x7c, 0x62, 0xa2,
-	0xee, 0x81, 0x7b, 0xea, 0xa9, 0x1f, 0x43, 0xbe, 0x83, 0x91, 0x85, 0x7d, 0xd9, 0x1c, 0xde, 0xad,
-	0xc5, 0x83, 0x53, 0x2d, 0x18, 0x9c, 0x6a, 0x62, 0x43, 0xfb, 0x9c, 0x29, 0x44, 0x15, 0x22, 0xea,
-	0x27, 0x90, 0x3f, 0x47, 0x5d, 0x8a, 0x59, 0x25, 0xb3, 0x91, 0xdd, 0x2c, 0x7e, 0xa8, 0x4f, 0xed,
-	0x2c, 0x51, 0x4b, 0x0a, 0x11, 0x84, 0x9c, 0xdc, 0xd7, 0x6f, 0x32, 0x50, 0x4e, 0x8d, 0x29, 0x6a,
-	0x03, 0x72, 0xbc, 0xde, 0x2b, 0xbc, 0xf8, 0xd6, 0xae, 0x30, 0x85, 0x34, 0xb1, 0x69, 0x70, 0x59,
-	0xf5, 0xfb, 0xb0, 0xe0, 0xa0, 0x81, 0xe8, 0x1b, 0x19, 0x8e, 0x73, 0xef, 0x6a, 0x38, 0xa3, 0xa1,
-	0x56, 0x96, 0x85, 0x5c, 0xe2, 0xe8, 0x46, 0xc1, 0x41, 0x03, 0xde, 0x2d, 0x7a, 0x50, 0x0e, 0xa8,
-	0x66, 0x07, 0xb9, 0x36, 0x4e, 0x36, 0xa7, 0xfd, 0x2b, 0x2b, 0x59, 0x8b, 0x95, 0x24, 0xe0, 0x74,
-	0x63, 0xc9, 0x41, 0x83, 0x1d, 0x4e, 0x08, 0x34, 0x6e, 0x2f, 0x7c, 0xf9, 0x50, 0x9b, 0xe3, 0x16,
-	0xfb, 0x83, 0x02, 0x10, 0x5b, 0x4c, 0xfd, 0x01, 0x2c, 0xa7, 0x9a, 0x1b, 0x95, 0xfe, 0x9c, 0x7d,
-	0x2e, 0x5c, 0x08, 0x76, 0xfd, 0x64, 0xa8, 0x29, 0x46, 0xd9, 0x4c, 0xf9, 0xe2, 0x7b, 0x50, 0xec,
-	0xf7, 0x2c, 0xc4, 0x70, 0x2b, 0x18, 0x91, 0xe5, 0xc4, 0xb9, 0x5e, 0x13, 0xe3, 0x71, 0x2d, 0x1c,
-	0x8f, 0x6b, 0x0f, 0xc2, 0xf9, 0xb9, 0x51, 0x0d, 0xb0, 0x46, 0x43, 0x4d, 0x15, 0xe7, 0x4a, 0x08,
-	0xeb, 0x5f, 0xfc, 0x55, 0x53, 0x0c, 0x10, 0x94, 0x40, 0x20, 0x71, 0xa8, 0xdf, 0x2b, 0x50, 0x4c,
-	0x8c, 0x20, 0x6a, 0x05, 0x0a, 0x8e, 0xe7, 0x92, 0x33, 0x19, 0x9c, 0x8b, 0x46, 0xb8, 0x54, 0xd7,
-	0x61, 0x81, 0x58, 0xd8, 0x65, 0x84, 0x5d, 0x08, 0xc7, 0x1a, 0xd1, 0x3a, 0x90, 0xfa, 0x1c, 0xb7,
-	0x29, 0x09, 0xdd, 0x61, 0x84, 0x4b, 0x75, 0x0f, 0x96, 0x29, 0x36, 0xfb, 0x3e, 0x61, 0x17, 0x2d,
-	0xd3, 0x73, 0x19, 0x32, 0x99, 0xec, 0xed, 0xef, 0x8c, 0x86, 0xda, 0x1d, 0xb1, 0xd7, 0x34, 0x87,
-	0x6e, 0x94, 0x43, 0xd2, 0x8e, 0xa0, 0x04, 0x1a, 0x2c, 0xcc, 0x10, 0xe9, 0x8a, 0x59, 0x71, 0xd1,
-	0x08, 0x97, 0x89, 0xb3, 0x3c, 0x2a, 0xc0, 0x62, 0x3c, 0x87, 0x7d, 0x0e, 0xcb, 0x5e, 0x0f, 0xfb,
-	0x13, 0x0a, 0xd5, 0x61, 0xac, 0x39, 0xcd, 0x71, 0x8d, 0x5a, 0x51, 0x0e, 0x31, 0xc2, 0x52, 0xb1,
-	0x17, 0x04, 0x86, 0x4b, 0xb1, 0x4b, 0xfb, 0xb4, 0x25, 0xc7, 0xcd, 0x4c, 0xfa, 0xc8, 0x69, 0x0e,
-	0x3d, 0x88, 0x00, 0x49, 0xba, 0x2f, 0x86, 0xd2, 0x35, 0xc8, 0xff, 0x10, 0x91, 0x2e, 0xb6, 0xb8,
-	0x4d, 0x17, 0x0c, 0xb9, 0x52, 0x0f, 0x20, 0x4f, 0x19, 0x62, 0x7d, 0x31, 0xb1, 0xcf, 0x37, 0xb6,
-	0x66, 0xdc, 0x73, 0xc3, 0x73, 0xad, 0x63, 0x2e, 0x68, 0x48, 0x00, 0x75, 0x0f, 0xf2, 0xcc, 0x3b,
-	0xc3, 0xae, 0x34, 0xea, 0x95, 0x52, 0xfe, 0xc0, 0x65, 0x86, 0x94, 0x56, 0x19, 0xc4, 0xd5, 0xba,
-	0x45, 0x3b, 0xc8, 0xc7, 0x54, 0x4c, 0xd8, 0x8d, 0x83, 0x2b, 0xe7, 0xe5, 0x9d, 0x74, 0x0b, 0x11,
-	0x78, 0xba, 0x51, 0x8e, 0x48, 0xc7, 0x9c, 0x92, 0x9e, 0xb4, 0x0b, 0x2f, 0x37, 0x69, 0xef, 0xc1,
-	0x72, 0xdf, 0x6d, 0x7b, 0xae, 0x45, 0x5c, 0xbb, 0xd5, 0xc1, 0xc4, 0xee, 0xb0, 0xca, 0xc2, 0x86,
-	0xb2, 0x99, 0x4d, 0xba, 0x2d, 0xcd, 0xa1, 0x1b, 0xe5, 0x88, 0xb4, 0xcf, 0x29, 0xaa, 0x05, 0xa5,
-	0x98, 0x8b, 0xe7, 0xee, 0xe2, 0x0b, 0x73, 0xf7, 0xab, 0x32, 0x77, 0x6f, 0xa7, 0xb5, 0xc4, 0xe9,
-	0xbb, 0x14, 0x11, 0x03, 0x31, 0xf5, 0xe0, 0xd2, 0x7d, 0x14, 0xb8, 0x86, 0xf7, 0x66, 0xa8, 0x3b,
-	0xb3, 0x5f, 0x45, 0x8b, 0xaf, 0xe5, 0x2a, 0xba, 0x7d, 0xeb, 0x67, 0x0f, 0xb5, 0xb9, 0x28, 0x85,
-	0x7f, 0x9e, 0x81, 0x7c, 0xf3, 0xe4, 0x3e, 0x22, 0xfe, 0xdb, 0x3a, 0x69, 0x24, 0xea, 0xd9, 0x1e,
-	0x14, 0x84, 0x2d, 0xa8, 0xfa, 0x31, 0xcc, 0xf7, 0x82, 0x1f, 0x15, 0x85, 0x37, 0x7d, 0x6d, 0x7a,
-	0x90, 0x73, 0x81, 0xf0, 0xb2, 0xca, 0x65, 0xf4, 0x5f, 0x67, 0x01, 0x9a, 0x27, 0x27, 0x0f, 0x7c,
-	0xd2, 0xeb, 0x62, 0x76, 0x33, 0x99, 0xbf, 0x39, 0x93, 0x79, 0xc2, 0xd9, 0x0f, 0xa0, 0x18, 0xfb,
-	0x88, 0xaa, 0xbb, 0xb0, 0xc0, 0xe4, 0x6f, 0xe9, 0xf3, 0xf7, 0x9e, 0xe3, 0xf3, 0x50, 0x4e, 0xfa,
-	0x3d, 0x12, 0xd5, 0xff, 0x98, 0x01, 0x78, 0xd1, 0xbb, 0xcf, 0x5b, 0x30, 0xbd, 0xef, 0x41, 0x5e,
-	0x76, 0xa5, 0xec, 0xb5, 0x46, 0x5b, 0x29, 0x9d, 0x70, 0xd7, 0x3f, 0x32, 0xb0, 0xfa, 0x59, 0x58,
-	0x91, 0x6f, 0x2c, 0xac, 0x7e, 0x0a, 0x05, 0xec, 0x32, 0x9f, 0x70, 0x13, 0x07, 0xe1, 0xba, 0x35,
-	0x35, 0x5c, 0x27, 0x98, 0x6d, 0xd7, 0x65, 0xfe, 0x85, 0x0c, 0xde, 0x10, 0x27, 0x61, 0xec, 0x5f,
-	0x66, 0xa1, 0x32, 0x4d, 0x4a, 0xdd, 0x81, 0xb2, 0xe9, 0x63, 0x4e, 0x08, 0xdb, 0xb6, 0xc2, 0xdb,
-	0xf6, 0x7a, 0xe2, 0x15, 0xea, 0x32, 0x83, 0x6e, 0x94, 0x42, 0x8a, 0x6c, 0xda, 0x36, 0x7f, 0xf4,
-	0x0a, 0x72, 0x26, 0xe0, 0x9a, 0x71, 0xe2, 0xd6, 0x65, 0xd7, 0x8e, 0x9f, 0xba, 0x92, 0x00, 0xa2,
-	0x6d, 0x97, 0x62, 0x2a, 0xef, 0xdb, 0x3f, 0x82, 0x32, 0x71, 0x09, 0x23, 0xa8, 0xdb, 0x6a, 0xa3,
-	0x2e, 0x72, 0xcd, 0xeb, 0x5c, 0x60, 0x44, 0xa3, 0x95, 0x6a, 0x53, 0x70, 0xba, 0x51, 0x92, 0x94,
-	0x86, 0x20, 0xa8, 0xfb, 0x50, 0x08, 0x55, 0xe5, 0xae, 0x35, 0xe5, 0x85, 0xe2, 0x09, 0x8f, 0xfc,
-	0x22, 0x0b, 0x2b, 0xd1, 0x63, 0xcf, 0x8d, 0x2b, 0x66, 0x75, 0xc5, 0x11, 0x80, 0xa8, 0x24, 0x41,
-	0x2f, 0xb9, 0x86, 0x37, 0x82, 0x5a, 0xb4, 0x28, 0x10, 0x9a, 0x94, 0x25, 0xfc, 0xf1, 0xcf, 0x2c,
-	0xdc, 0x4a, 0xfa, 0xe3, 0xa6, 0xc9, 0xbf, 0x41, 0xcf, 0x6f, 0xdf, 0x8e, 0x6b, 0x63, 0x8e, 0xd7,
-	0xc6, 0xaf, 0x4f, 0xad, 0x8d, 0x63, 0x39, 0x35, 0xbd, 0x28, 0xfe, 0x2b, 0x03, 0xf9, 0xfb, 0xc8,
-	0x47, 0x0e, 0x55, 0xcd, 0xb1, 0x2b, 0x87, 0x78, 0x88, 0xb8, 0x3b, 0x96, 0x31, 0x4d, 0xf9, 0xd7,
-	0xb4, 0x17, 0xdc, 0x38, 0xbe, 0x9c, 0x70, 0xe3, 0xf8, 0x04, 0x4a, 0x0e, 0x1a, 0xb4, 0xa2, 0x03,
-	0x0a, 0x6f, 0x2e, 0x35, 0xee, 0xc6, 0x28, 0x97, 0xbf, 0x8b, 0xa7, 0x94, 0xe8, 0x42, 0x4e, 0xd5,
-	0x6f, 0x41, 0x31, 0xe0, 0x88, 0xfb, 0x44, 0x20, 0xbe, 0x16, 0x3f, 0x59, 0x24, 0x3e, 0xea, 0x06,
-	0x38, 0x68, 0xb0, 0x2b, 0x16, 0xea, 0x21, 0xa8, 0x9d, 0xe8, 0x09, 0xad, 0x15, 0xdb, 0x32, 0x90,
-	0x7f, 0x77, 0x34, 0xd4, 0xee, 0x0a, 0xf9, 0x71, 0x1e, 0xdd, 0x58, 0x89, 0x89, 0x21, 0xda, 0x37,
-	0x01, 0x82, 0x73, 0xb5, 0x2c, 0xec, 0x7a, 0x8e, 0xbc, 0xf8, 0xde, 0x1e, 0x0d, 0xb5, 0x15, 0x81,
-	0x12, 0x7f, 0xd3, 0x8d, 0xc5, 0x60, 0xd1, 0x0c, 0x7e, 0xc7, 0x86, 0x6f, 0xec, 0x3d, 0x7e, 0x5a,
-	0x55, 0x9e, 0x3c, 0xad, 0x2a, 0x7f, 0x7b, 0x5a, 0x55, 0xbe, 0x78, 0x56, 0x9d, 0x7b, 0xf2, 0xac,
-	0x3a, 0xf7, 0xa7, 0x67, 0xd5, 0xb9, 0xef, 0x7e, 0xe3, 0xb9, 0xc1, 0x92, 0xfa, 0x6b, 0x6c, 0x3b,
-	0xcf, 0xbd, 0xf2, 0xd1, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xa9, 0x93, 0x44, 0xcc, 0xa7, 0x1d,
-	0x00, 0x00,
+	0x36, 0x41, 0x5c, 0x91, 0x10, 0x12, 0x62, 0x2e, 0x48, 0x73, 0x1c, 0xf1, 0x0f, 0xf0, 0x1f, 0xa0,
+	0xe1, 0x36, 0xdc, 0x46, 0x1c, 0x10, 0x70, 0x30, 0x68, 0xe6, 0x82, 0x38, 0x21, 0x0b, 0x09, 0x89,
+	0x13, 0xea, 0xaa, 0xea, 0x8f, 0xb4, 0xed, 0x89, 0x9d, 0x61, 0x86, 0x91, 0x26, 0x97, 0xc4, 0xf5,
+	0xfc, 0xde, 0xef, 0x55, 0xbd, 0x57, 0xef, 0xab, 0x0c, 0xef, 0x9d, 0xd7, 0x28, 0x43, 0xa7, 0xc4,
+	0xb5, 0x6b, 0xec, 0xa2, 0x8b, 0xa9, 0xf8, 0x5b, 0xed, 0xfa, 0x1e, 0xf3, 0xd4, 0x3b, 0xa6, 0x47,
+	0x1d, 0x8f, 0x36, 0xa9, 0x75, 0x5a, 0x3d, 0xaf, 0x4a, 0xbe, 0xea, 0xd9, 0xe6, 0xda, 0x87, 0xac,
+	0x4d, 0x7c, 0xab, 0xd9, 0x45, 0x3e, 0xbb, 0xa8, 0x71, 0xde, 0x9a, 0xed, 0xd9, 0x5e, 0xfc, 0x49,
+	0x00, 0xac, 0x7d, 0x32, 0xcc, 0xc7, 0xb0, 0x6b, 0x61, 0xdf, 0x21, 0x2e, 0xab, 0xa1, 0x96, 0x49,
+	0x86, 0xb5, 0xae, 0x69, 0xb6, 0xe7, 0xd9, 0x1d, 0x2c, 0xf8, 0x5b, 0xbd, 0x93, 0x1a, 0x23, 0x0e,
+	0xa6, 0x0c, 0x39, 0x5d, 0xc9, 0x50, 0x49, 0x33, 0x58, 0x3d, 0x1f, 0x31, 0xe2, 0xb9, 0xf2, 0xfb,
+	0xe5, 0x21, 0x4c, 0xfd, 0xdf, 0x39, 0x50, 0x0f, 0xa9, 0xbd, 0xed, 0x63, 0xc4, 0xf0, 0x31, 0xea,
+	0x10, 0x0b, 0x31, 0xcf, 0x57, 0x0f, 0xa0, 0x68, 0x61, 0x6a, 0xfa, 0xa4, 0x1b, 0x88, 0x97, 0x95,
+	0x75, 0x65, 0xa3, 0xf8, 0xf1, 0xd7, 0xaa, 0x63, 0x8e, 0x5d, 0x6d, 0xc4, 0xbc, 0xf5, 0xdc, 0xd3,
+	0xbe, 0x36, 0x63, 0x24, 0xc5, 0xd5, 0xef, 0x00, 0x98, 0x9e, 0xe3, 0x10, 0x4a, 0x03, 0xb0, 0x0c,
+	0x07, 0xdb, 0x18, 0x0b, 0xb6, 0x1d, 0xb1, 0x1a, 0x88, 0x61, 0x2a, 0x01, 0x13, 0x08, 0xea, 0x4f,
+	0x60, 0xc5, 0x21, 0x6e, 0x93, 0xe2, 0xce, 0x49, 0xd3, 0xc2, 0x1d, 0x6c, 0xf3, 0x43, 0x96, 0xb3,
+	0xeb, 0xca, 0xc6, 0x7c, 0xfd, 0x20, 0x60, 0xff, 0x4b, 0x5f, 0xfb, 0xd0, 0x26, 0xac, 0xdd, 0x6b,
+	0x55, 0x4d, 0xcf, 0xa9, 0x09, 0x55, 0xf2, 0xdf, 0x47, 0xd4, 0x3a, 0x95, 0x36, 0xd8, 0x77, 0xd9,
+	0xa0, 0xaf, 0xad, 0x5d, 0x20, 0xa7, 0xb3, 0xa5, 0x8f, 0x80, 0xd4, 0x8d, 0x65, 0x87, 0xb8, 0x47,
+	0xb8, 0x73, 0xd2, 0x88, 0x68, 0xea, 0x8f, 0x61, 0x59, 0x72, 0x78, 0x7e, 0x13, 0x59, 0x96, 0x8f,
+	0x29, 0x2d, 0xe7, 0xd6, 0x95, 0x8d, 0x5b, 0xf5, 0xc3, 0x41, 0x5f, 0x2b, 0x0b, 0xb4, 0x21, 0x16,
+	0xfd, 0x3f, 0x7d, 0xed, 0xa3, 0x09, 0xf6, 0x74, 0xcf, 0x34, 0xef, 0x09, 0x09, 0x63, 0x29, 0x02,
+	0x91, 0x94, 0x40, 0xf7, 0x59, 0xe8, 0xa4, 0x48, 0xf7, 0x6c, 0x5a, 0xf7, 0x10, 0xcb, 0xa4, 0xba,
+	0x8f, 0x51, 0x27, 0xd2, 0x1d, 0x81, 0x84, 0xba, 0x57, 0x21, 0xdf, 0xed, 0xb5, 0x4e, 0xf1, 0x45,
+	0x39, 0x1f, 0x18, 0xda, 0x90, 0x2b, 0xb5, 0x06, 0xb3, 0x67, 0xa8, 0xd3, 0xc3, 0xe5, 0x02, 0x77,
+	0xec, 0x4a, 0xd2, 0xb1, 0xdc, 0x9d, 0x24, 0xbc, 0x14, 0x82, 0x6f, 0x2b, 0xf7, 0xf7, 0xc7, 0x9a,
+	0xa2, 0xff, 0x2e, 0x0b, 0x4b, 0x87, 0xd4, 0xde, 0xb1, 0x08, 0x7b, 0x5d, 0xf7, 0xae, 0x3b, 0xca,
+	0x5a, 0x19, 0x6e, 0xad, 0xed, 0x41, 0x5f, 0x5b, 0x14, 0xd6, 0xfa, 0x5f, 0xda, 0xc8, 0x81, 0x52,
+	0x7c, 0x4f, 0x9b, 0x3e, 0x62, 0x58, 0xde, 0xca, 0xc6, 0x84, 0x37, 0xb2, 0x81, 0xcd, 0x41, 0x5f,
+	0x5b, 0x15, 0x3b, 0x4b, 0x41, 0xe9, 0xc6, 0xa2, 0x79, 0x29, 0x36, 0xd4, 0xf3, 0xd1, 0x81, 0x90,
+	0xe3, 0x2a, 0xf7, 0x5e, 0x63, 0x10, 0x48, 0x1f, 0xfe, 0x36, 0x03, 0xc5, 0x43, 0x6a, 0x4b, 0x3a,
+	0x1e, 0x1d, 0x1a, 0xca, 0xff, 0x31, 0x34, 0x32, 0x6f, 0x26, 0x34, 0x36, 0x21, 0x8f, 0x1c, 0xaf,
+	0xe7, 0xb2, 0x72, 0xf6, 0xaa, 0x18, 0x90, 0x8c, 0xd2, 0x80, 0x7f, 0xce, 0xf2, 0xf4, 0x5b, 0xc7,
+	0x36, 0x71, 0x0d, 0x6c, 0xbd, 0x0d, 0x76, 0xfc, 0xa9, 0x02, 0xb7, 0x63, 0x2b, 0x51, 0xdf, 0x4c,
+	0x19, 0xf3, 0xf3, 0x41, 0x5f, 0xfb, 0x4a, 0xda, 0x98, 0x09, 0xb6, 0x6b, 0x18, 0x74, 0x25, 0x02,
+	0x3a, 0xf2, 0xcd, 0xd1, 0xfb, 0xb0, 0x28, 0x8b, 0xf6, 0x91, 0x1d, 0xbf, 0x8f, 0x04, 0xdb, 0x2b,
+	0xed, 0xa3, 0x41, 0xd9, 0xb0, 0x6f, 0x73, 0xd3, 0xf9, 0xf6, 0x49, 0x06, 0x16, 0x0e, 0xa9, 0xfd,
+	0x85, 0x6b, 0xdd, 0x84, 0xc7, 0x35, 0xc3, 0xe3, 0x57, 0x0a, 0x2c, 0xee, 0x11, 0xca, 0x3c, 0x9f,
+	0x98, 0xa8, 0xb3, 0xef, 0x9e, 0x78, 0xea, 0xa7, 0x90, 0x6f, 0x63, 0x64, 0x61, 0x5f, 0x16, 0x87,
+	0xf7, 0xab, 0x71, 0xe3, 0x54, 0x0d, 0x1a, 0xa7, 0xaa, 0xd8, 0xd0, 0x1e, 0x67, 0x0a, 0x51, 0x85,
+	0x88, 0xfa, 0x19, 0xe4, 0xcf, 0x50, 0x87, 0x62, 0x56, 0xce, 0xac, 0x67, 0x37, 0x8a, 0x1f, 0xeb,
+	0x63, 0x2b, 0x4b, 0x54, 0x92, 0x42, 0x04, 0x21, 0x27, 0xf7, 0xf5, 0x9b, 0x0c, 0x94, 0x52, 0x6d,
+	0x8a, 0x5a, 0x87, 0x1c, 0xcf, 0xf7, 0x0a, 0x4f, 0xbe, 0xd5, 0x29, 0xba, 0x90, 0x06, 0x36, 0x0d,
+	0x2e, 0xab, 0x7e, 0x1f, 0xe6, 0x1c, 0x74, 0x2e, 0xea, 0x46, 0x86, 0xe3, 0xdc, 0x9b, 0x0e, 0x67,
+	0xd0, 0xd7, 0x4a, 0x32, 0x91, 0x4b, 0x1c, 0xdd, 0x28, 0x38, 0xe8, 0x9c, 0x57, 0x8b, 0x2e, 0x94,
+	0x02, 0xaa, 0xd9, 0x46, 0xae, 0x8d, 0x93, 0xc5, 0x69, 0x6f, 0x6a, 0x25, 0xab, 0xb1, 0x92, 0x04,
+	0x9c, 0x6e, 0x2c, 0x38, 0xe8, 0x7c, 0x9b, 0x13, 0x02, 0x8d, 0x5b, 0x73, 0x8f, 0x1e, 0x6b, 0x33,
+	0xdc, 0x62, 0x7f, 0x50, 0x00, 0x62, 0x8b, 0xa9, 0x3f, 0x80, 0xa5, 0x54, 0x71, 0xa3, 0x65, 0x65,
+	0xca, 0xbe, 0x70, 0x2e, 0xd8, 0xf5, 0xb3, 0xbe, 0xa6, 0x18, 0x25, 0x33, 0xe5, 0x8b, 0xef, 0x41,
+	0xb1, 0xd7, 0xb5, 0x10, 0xc3, 0xcd, 0xa0, 0x45, 0x96, 0x1d, 0xe7, 0x5a, 0x55, 0xb4, 0xc7, 0xd5,
+	0xb0, 0x3d, 0xae, 0x3e, 0x08, 0xfb, 0xe7, 0x7a, 0x25, 0xc0, 0x1a, 0xf4, 0x35, 0x55, 0x9c, 0x2b,
+	0x21, 0xac, 0x3f, 0xfc, 0xab, 0xa6, 0x18, 0x20, 0x28, 0x81, 0x40, 0xe2, 0x50, 0xbf, 0x57, 0xa0,
+	0x98, 0x68, 0x41, 0xd4, 0x32, 0x14, 0x1c, 0xcf, 0x25, 0xa7, 0xf2, 0x72, 0xce, 0x1b, 0xe1, 0x52,
+	0x5d, 0x83, 0x39, 0x62, 0x61, 0x97, 0x11, 0x76, 0x21, 0x1c, 0x6b, 0x44, 0xeb, 0x40, 0xea, 0x4b,
+	0xdc, 0xa2, 0x24, 0x74, 0x87, 0x11, 0x2e, 0xd5, 0x5d, 0x58, 0xa2, 0xd8, 0xec, 0xf9, 0x84, 0x5d,
+	0x34, 0x4d, 0xcf, 0x65, 0xc8, 0x64, 0xb2, 0xb6, 0xbf, 0x37, 0xe8, 0x6b, 0x77, 0xc4, 0x5e, 0xd3,
+	0x1c, 0xba, 0x51, 0x0a, 0x49, 0xdb, 0x82, 0x12, 0x68, 0xb0, 0x30, 0x43, 0xa4, 0x23, 0x7a, 0xc5,
+	0x79, 0x23, 0x5c, 0x26, 0xce, 0xf2, 0xa4, 0x00, 0xf3, 0x71, 0x1f, 0xf6, 0x25, 0x2c, 0x79, 0x5d,
+	0xec, 0x8f, 0x48, 0x54, 0x07, 0xb1, 0xe6, 0x34, 0xc7, 0x35, 0x72, 0x45, 0x29, 0xc4, 0x08, 0x53,
+	0xc5, 0x6e, 0x70, 0x31, 0x5c, 0x8a, 0x5d, 0xda, 0xa3, 0x4d, 0xd9, 0x6e, 0x66, 0xd2, 0x47, 0x4e,
+	0x73, 0xe8, 0x46, 0x29, 0x22, 0xdd, 0xe7, 0x94, 0xa0, 0x59, 0xfd, 0x21, 0x22, 0x1d, 0x6c, 0x71,
+	0x9b, 0xce, 0x19, 0x72, 0xa5, 0xee, 0x43, 0x9e, 0x32, 0xc4, 0x7a, 0xa2, 0x63, 0x9f, 0xad, 0x6f,
+	0x4e, 0xb8, 0xe7, 0xba, 0xe7, 0x5a, 0x47, 0x5c, 0xd0, 0x90, 0x00, 0xea, 0x2e, 0xe4, 0x99, 0x77,
+	0x8a, 0x5d, 0x69, 0xd4, 0xa9, 0x42, 0x7e, 0xdf, 0x65, 0x86, 0x94, 0x56, 0x19, 0xc4, 0xd9, 0xba,
+	0x49, 0xdb, 0xc8, 0xc7, 0x54, 0x74, 0xd8, 0xf5, 0xfd, 0xa9, 0xe3, 0xf2, 0x4e, 0xba, 0x84, 0x08,
+	0x3c, 0xdd, 0x28, 0x45, 0xa4, 0x23, 0x4e, 0x49, 0x77, 0xda, 0x85, 0x57, 0xeb, 0xb4, 0x77, 0x61,
+	0xa9, 0xe7, 0xb6, 0x3c, 0xd7, 0x22, 0xae, 0xdd, 0x6c, 0x63, 0x62, 0xb7, 0x59, 0x79, 0x6e, 0x5d,
+	0xd9, 0xc8, 0x26, 0xdd, 0x96, 0xe6, 0xd0, 0x8d, 0x52, 0x44, 0xda, 0xe3, 0x14, 0xd5, 0x82, 0xc5,
+	0x98, 0x8b, 0xc7, 0xee, 0xfc, 0x95, 0xb1, 0xfb, 0x55, 0x19, 0xbb, 0xb7, 0xd3, 0x5a, 0xe2, 0xf0,
+	0x5d, 0x88, 0x88, 0x81, 0x98, 0xba, 0x7f, 0x69, 0x1e, 0x05, 0xae, 0xe1, 0x83, 0x09, 0xf2, 0xce,
+	0xe4, 0xa3, 0x68, 0xf1, 0x8d, 0x8c, 0xa2, 0x5b, 0xb7, 0x7e, 0xf6, 0x58, 0x9b, 0x89, 0x42, 0xf8,
+	0xe7, 0x19, 0xc8, 0x37, 0x8e, 0xef, 0x23, 0xe2, 0xbf, 0xab, 0x9d, 0x46, 0x22, 0x9f, 0xed, 0x42,
+	0x41, 0xd8, 0x82, 0xaa, 0x9f, 0xc2, 0x6c, 0x37, 0xf8, 0x50, 0x56, 0x78, 0xd1, 0xd7, 0xc6, 0x5f,
+	0x72, 0x2e, 0x10, 0x0e, 0xab, 0x5c, 0x46, 0xff, 0x75, 0x16, 0xa0, 0x71, 0x7c, 0xfc, 0xc0, 0x27,
+	0xdd, 0x0e, 0x66, 0x37, 0x9d, 0xf9, 0xdb, 0xd3, 0x99, 0x27, 0x9c, 0xfd, 0x00, 0x8a, 0xb1, 0x8f,
+	0xa8, 0xba, 0x03, 0x73, 0x4c, 0x7e, 0x96, 0x3e, 0xff, 0xe0, 0x25, 0x3e, 0x0f, 0xe5, 0xa4, 0xdf,
+	0x23, 0x51, 0xfd, 0x8f, 0x19, 0x80, 0xab, 0xde, 0x7d, 0xde, 0x81, 0xee, 0x7d, 0x17, 0xf2, 0xb2,
+	0x2a, 0x65, 0xaf, 0xd5, 0xda, 0x4a, 0xe9, 0x84, 0xbb, 0xfe, 0x91, 0x81, 0x95, 0x2f, 0xc2, 0x8c,
+	0x7c, 0x63, 0x61, 0xf5, 0x73, 0x28, 0x60, 0x97, 0xf9, 0x84, 0x9b, 0x38, 0xb8, 0xae, 0x9b, 0x63,
+	0xaf, 0xeb, 0x08, 0xb3, 0xed, 0xb8, 0xcc, 0xbf, 0x90, 0x97, 0x37, 0xc4, 0x49, 0x18, 0xfb, 0x97,
+	0x59, 0x28, 0x8f, 0x93, 0x52, 0xb7, 0xa1, 0x64, 0xfa, 0x98, 0x13, 0xc2, 0xb2, 0xad, 0xf0, 0xb2,
+	0xbd, 0x96, 0x78, 0x85, 0xba, 0xcc, 0x10, 0xbc, 0x42, 0x49, 0x8a, 0x2c, 0xda, 0x36, 0x7f, 0xf4,
+	0x0a, 0x62, 0x26, 0xe0, 0x9a, 0xb0, 0xe3, 0xd6, 0x65, 0xd5, 0x8e, 0x9f, 0xba, 0x92, 0x00, 0xa2,
+	0x6c, 0x2f, 0xc6, 0x54, 0x5e, 0xb7, 0x7f, 0x04, 0x25, 0xe2, 0x12, 0x46, 0x50, 0xa7, 0xd9, 0x42,
+	0x1d, 0xe4, 0x9a, 0xd7, 0x19, 0x60, 0x44, 0xa1, 0x95, 0x6a, 0x53, 0x70, 0xba, 0xb1, 0x28, 0x29,
+	0x75, 0x41, 0x50, 0xf7, 0xa0, 0x10, 0xaa, 0xca, 0x5d, 0xab, 0xcb, 0x0b, 0xc5, 0x13, 0x1e, 0xf9,
+	0x45, 0x16, 0x96, 0xa3, 0xc7, 0x9e, 0x1b, 0x57, 0x4c, 0xea, 0x8a, 0x43, 0x00, 0x91, 0x49, 0x82,
+	0x5a, 0x52, 0xce, 0x5d, 0x2b, 0x17, 0xcd, 0x0b, 0x84, 0x06, 0x65, 0x09, 0x7f, 0xfc, 0x33, 0x0b,
+	0xb7, 0x92, 0xfe, 0xb8, 0x29, 0xf2, 0x6f, 0xd1, 0xf3, 0xdb, 0xb7, 0xe3, 0xdc, 0x98, 0xe3, 0xb9,
+	0xf1, 0xeb, 0x63, 0x73, 0xe3, 0x50, 0x4c, 0x8d, 0x4f, 0x8a, 0xff, 0xca, 0x42, 0xfe, 0x3e, 0xf2,
+	0x91, 0x43, 0x55, 0x73, 0x68, 0xe4, 0x10, 0x0f, 0x11, 0x77, 0x87, 0x22, 0xa6, 0x21, 0x7f, 0x4d,
+	0xbb, 0x62, 0xe2, 0x78, 0x34, 0x62, 0xe2, 0xf8, 0x0c, 0x16, 0x83, 0xb7, 0x92, 0xe8, 0x80, 0xc2,
+	0x9b, 0x0b, 0xf5, 0xbb, 0x31, 0xca, 0xe5, 0xef, 0xc5, 0x53, 0x4a, 0x34, 0x90, 0x53, 0xf5, 0x5b,
+	0x50, 0x0c, 0x38, 0xe2, 0x3a, 0x11, 0x88, 0xaf, 0xc6, 0x4f, 0x16, 0x89, 0x2f, 0x75, 0x03, 0x1c,
+	0x74, 0xbe, 0x23, 0x16, 0xea, 0x01, 0xa8, 0xed, 0xe8, 0x09, 0xad, 0x19, 0xdb, 0x32, 0x90, 0x7f,
+	0x7f, 0xd0, 0xd7, 0xee, 0x0a, 0xf9, 0x61, 0x1e, 0xdd, 0x58, 0x8e, 0x89, 0x21, 0xda, 0x37, 0x01,
+	0x82, 0x73, 0x35, 0x2d, 0xec, 0x7a, 0x8e, 0x1c, 0x7c, 0x6f, 0x0f, 0xfa, 0xda, 0xb2, 0x40, 0x89,
+	0xbf, 0xd3, 0x8d, 0xf9, 0x60, 0xd1, 0x08, 0x3e, 0x87, 0x53, 0x52, 0xfa, 0xa7, 0x91, 0xfc, 0xd4,
+	0x53, 0x92, 0x98, 0x72, 0x13, 0x53, 0xd2, 0xd0, 0x4f, 0x24, 0xc1, 0x94, 0x74, 0xf9, 0xa5, 0x28,
+	0x76, 0x7b, 0x7d, 0xf7, 0xe9, 0xf3, 0x8a, 0xf2, 0xec, 0x79, 0x45, 0xf9, 0xdb, 0xf3, 0x8a, 0xf2,
+	0xf0, 0x45, 0x65, 0xe6, 0xd9, 0x8b, 0xca, 0xcc, 0x9f, 0x5e, 0x54, 0x66, 0xbe, 0xfb, 0x8d, 0x97,
+	0x2a, 0x4f, 0xfd, 0x16, 0xdc, 0xca, 0xf3, 0x3b, 0xf1, 0xc9, 0x7f, 0x07, 0x00, 0xad, 0x2d, 0x52,
+	0xf9, 0x25, 0x1e, 0x00, 0x00,
 }
 
 func (this *MsgCreateValidator) Equal(that interface{}) bool {
@@ -1966,6 +1970,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.BondDenom != that1.BondDenom {
 		return false
 	}
+	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
+		return false
+	}
 	return true
 }
 func (m *MsgCreateValidator) Marshal() (dAtA []byte, err error) {
@@ -3021,6 +3028,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.BondDenom) > 0 {
 		i -= len(m.BondDenom)
 		copy(dAtA[i:], m.BondDenom)
@@ -3484,6 +3501,8 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -6898,6 +6917,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -427,3 +427,24 @@ func TestDumpUpgradeInfoToFile(t *testing.T) {
 	err = os.Remove(upgradeInfoFilePath)
 	require.Nil(t, err)
 }
+
+func TestModuleVersionMap(t *testing.T) {
+	s := setupTest(10, map[int64]bool{})
+
+	t.Log("Verify that the consensus versions are stored at genesis")
+	vm := s.keeper.GetModuleVersionMap(s.ctx)
+	require.Equal(t, uint64(1), vm[types.ModuleName])
+	require.Equal(t, uint64(1), vm["bank"])
+
+	t.Log("Verify that an upgrade handler can record the migrated versions")
+	s.keeper.SetUpgradeHandler("migrate", func(ctx sdk.Context, plan types.Plan) {
+		fromVM := s.keeper.GetModuleVersionMap(ctx)
+		fromVM["bank"] = 2
+		s.keeper.SetModuleVersionMap(ctx, fromVM)
+	})
+	s.keeper.ApplyUpgrade(s.ctx, types.Plan{Name: "migrate", Height: s.ctx.BlockHeight()})
+
+	vm = s.keeper.GetModuleVersionMap(s.ctx)
+	require.Equal(t, uint64(2), vm["bank"])
+	require.Equal(t, uint64(1), vm[types.ModuleName])
+}
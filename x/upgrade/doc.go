@@ -89,6 +89,29 @@ Here is a sample code to set store migrations with an upgrade:
 		app.SetStoreLoader(upgrade.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
 	}
 
+In-Place Store Migrations
+
+Modules implementing module.VersionedAppModule declare a ConsensusVersion and register, with the app's
+module.Configurator, a migration for every version bump. The app stores the consensus version of every module at
+genesis with SetModuleVersionMap; an upgrade handler can then run all the pending migrations and record the new
+versions without a genesis export and import:
+
+	app.UpgradeKeeper.SetUpgradeHandler("my-fancy-upgrade", func(ctx sdk.Context, plan upgrade.Plan) {
+		vm, err := app.mm.RunMigrations(ctx, app.configurator, app.UpgradeKeeper.GetModuleVersionMap(ctx))
+		if err != nil {
+			panic(err)
+		}
+
+		app.UpgradeKeeper.SetModuleVersionMap(ctx, vm)
+	})
+
+RunMigrations initializes the modules missing from the version map with their default genesis, and fails on an
+empty version map. A chain that did not store a version map at genesis must therefore seed it, in its first upgrade
+handler, with the consensus versions its existing modules had before the upgrade:
+
+	fromVM := module.VersionMap{"auth": 1, "bank": 1, "staking": 1} // and every other existing module
+	vm, err := app.mm.RunMigrations(ctx, app.configurator, fromVM)
+
 Halt Behavior
 
 Before halting the ABCI state machine in the BeginBlocker method, the upgrade module will log an error
@@ -19,6 +19,7 @@ import (
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
 // UpgradeInfoFileName file to store upgrade information
@@ -88,6 +89,32 @@ func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) int64 {
 	return int64(binary.BigEndian.Uint64(bz))
 }
 
+// SetModuleVersionMap stores the consensus version of every module. It must be
+// set at genesis and after running the store migrations of an upgrade, so that
+// the next upgrade knows which migrations to run.
+func (k Keeper) SetModuleVersionMap(ctx sdk.Context, vm module.VersionMap) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.VersionMapByte})
+	for moduleName, version := range vm {
+		bz := make([]byte, 8)
+		binary.BigEndian.PutUint64(bz, version)
+		store.Set([]byte(moduleName), bz)
+	}
+}
+
+// GetModuleVersionMap returns the stored consensus version of every module
+func (k Keeper) GetModuleVersionMap(ctx sdk.Context) module.VersionMap {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.VersionMapByte})
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	vm := make(module.VersionMap)
+	for ; iterator.Valid(); iterator.Next() {
+		vm[string(iterator.Key())] = binary.BigEndian.Uint64(iterator.Value())
+	}
+
+	return vm
+}
+
 // ClearUpgradePlan clears any schedule upgrade
 func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
@@ -19,6 +19,8 @@ const (
 	PlanByte = 0x0
 	// DoneByte is a prefix for to look up completed upgrade plan by name
 	DoneByte = 0x1
+	// VersionMapByte is a prefix to look up the consensus version of a module by name
+	VersionMapByte = 0x2
 )
 
 // PlanKey is the key under which the current plan is saved